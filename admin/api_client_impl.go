@@ -5,8 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
@@ -48,6 +47,9 @@ func New(httpClient common.HTTPClient, config *model.ClientConfig) (*Client, err
 		MaxRetries:        config.MaxRetries,
 		InitialRetryDelay: config.InitialRetryDelay,
 		MaxRetryDelay:     config.MaxRetryDelay,
+		RateLimiter:       common.RateLimiterFromConfig(u, config),
+		Logger:            config.Logger,
+		Debug:             config.Debug,
 	}
 
 	// Initialize the Authentication service.
@@ -87,6 +89,16 @@ type Client struct {
 	InitialRetryDelay time.Duration
 	// MaxRetryDelay is the maximum delay between retries.
 	MaxRetryDelay time.Duration
+	// RetryPolicy overrides the retry policy built from MaxRetries, InitialRetryDelay and MaxRetryDelay.
+	RetryPolicy common.RetryPolicy
+	// RateLimiter paces the requests and follows the rate limit headers of the responses, no pacing happens when nil.
+	RateLimiter *common.RateLimiter
+	// Instrumentation observes every call, for example to record metrics or traces.
+	Instrumentation common.Instrumentation
+	// Logger receives the retries and the debug summaries, nothing is logged when nil.
+	Logger *slog.Logger
+	// Debug logs a summary of every request and response, with the credentials redacted, at the debug level.
+	Debug bool
 	// Auth is the authentication service.
 	Auth common.Authentication
 	// Organization is the service for organization-related operations.
@@ -147,103 +159,30 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 	return req, nil
 }
 
-// Call sends an HTTP request and processes the response.
-// It takes an *http.Request and a structure to unmarshal the response into.
-// It returns a pointer to model.ResponseScheme and an error.
+// Call executes an API request through the shared transport and returns the response.
 func (c *Client) Call(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
-	retryCount := 0
-	ctx := request.Context()
 
-	for {
-		response, err := c.HTTP.Do(request)
-		if err != nil {
-			return nil, err
-		}
-
-		// If rate limit exceeded, sleep with exponential backoff
-		if response.StatusCode == http.StatusTooManyRequests {
-			delay := c.InitialRetryDelay
-			// Use bit shifting for exponential backoff (1 << retryCount)
-			delay = delay * (1 << uint(retryCount))
-			if delay > c.MaxRetryDelay {
-				delay = c.MaxRetryDelay
-			}
-			log.Printf("Rate limit exceeded, sleeping for %v request %v", delay, request.URL.String())
-
-			// Get timer
-			timer := time.NewTimer(delay)
-			defer timer.Stop()
-
-			// Wait for either context cancellation or timer
-			select {
-			case <-ctx.Done():
-				timer.Stop()
-				return nil, ctx.Err()
-			case <-timer.C:
-				log.Printf("Timer completed successfully")
-				// Timer completed successfully
-			}
-
-			retryCount++
-			if retryCount > c.MaxRetries {
-				return c.processResponse(response, structure)
-			}
-			continue
-		}
-
-		return c.processResponse(response, structure)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
 	}
-}
-
-func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
-
-	defer response.Body.Close()
 
-	res := &model.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "admin"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
 
-	responseAsBytes, err := io.ReadAll(response.Body)
+	response, err := transport.Do(request)
 	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, model.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, model.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, model.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, model.ErrBadRequest
-
-		case http.StatusTooManyRequests:
-			return res, model.ErrRateLimited
-
-		default:
-			return res, model.ErrInvalidStatusCode
-		}
+		return nil, err
 	}
 
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
+	return c.processResponse(response, structure)
+}
 
-	return res, nil
+func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
+	return common.ProcessResponse(response, structure)
 }
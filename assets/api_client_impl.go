@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/assets/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -35,10 +36,13 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
-	// Initialize the Client struct with the provided HTTP client and parsed URL.
+	// Initialize the Client struct with the provided HTTP client, parsed URL and the default retry settings.
 	client := &Client{
-		HTTP: httpClient,
-		Site: u,
+		HTTP:              httpClient,
+		Site:              u,
+		MaxRetries:        5,
+		InitialRetryDelay: time.Duration(1) * time.Minute,
+		MaxRetryDelay:     time.Duration(10) * time.Minute,
 	}
 
 	// Initialize the Authentication service.
@@ -61,6 +65,22 @@ type Client struct {
 	HTTP common.HTTPClient
 	// Site is the base URL for the API.
 	Site *url.URL
+	// MaxRetries is the maximum number of retries for rate limit handling.
+	MaxRetries int
+	// InitialRetryDelay is the initial delay between retries.
+	InitialRetryDelay time.Duration
+	// MaxRetryDelay is the maximum delay between retries.
+	MaxRetryDelay time.Duration
+	// RetryPolicy overrides the retry policy built from MaxRetries, InitialRetryDelay and MaxRetryDelay.
+	RetryPolicy common.RetryPolicy
+	// RateLimiter paces the requests and follows the rate limit headers of the responses, no pacing happens when nil.
+	RateLimiter *common.RateLimiter
+	// Instrumentation observes every call, for example to record metrics or traces.
+	Instrumentation common.Instrumentation
+	// Logger receives the retries and the debug summaries, nothing is logged when nil.
+	Logger *slog.Logger
+	// Debug logs a summary of every request and response, with the credentials redacted, at the debug level.
+	Debug bool
 	// Auth is the authentication service.
 	Auth common.Authentication
 	// AQL is the service for AQL-related operations.
@@ -127,67 +147,30 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 	return req, nil
 }
 
-// Call sends an HTTP request and processes the response.
-// It takes an *http.Request and a structure to unmarshal the response into.
-// It returns a pointer to model.ResponseScheme and an error.
+// Call executes an API request through the shared transport and returns the response.
 func (c *Client) Call(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
 
-	// Perform the HTTP request.
-	response, err := c.HTTP.Do(request)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
+	}
+
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "assets"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
+
+	response, err := transport.Do(request)
 	if err != nil {
 		return nil, err
 	}
 
-	// Process the HTTP response.
 	return c.processResponse(response, structure)
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
-
-	defer response.Body.Close()
-
-	res := &model.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
-
-	responseAsBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, model.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, model.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, model.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, model.ErrBadRequest
-
-		default:
-			return res, model.ErrInvalidStatusCode
-		}
-	}
-
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
-
-	return res, nil
+	return common.ProcessResponse(response, structure)
 }
@@ -5,8 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -54,6 +53,9 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 		MaxRetries:        config.MaxRetries,
 		InitialRetryDelay: config.InitialRetryDelay,
 		MaxRetryDelay:     config.MaxRetryDelay,
+		RateLimiter:       common.RateLimiterFromConfig(u, config),
+		Logger:            config.Logger,
+		Debug:             config.Debug,
 	}
 
 	client.Auth = internal.NewAuthenticationService(client)
@@ -61,10 +63,28 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 	client.Workspace = internal.NewWorkspaceService(client,
 		internal.NewWorkspaceHookService(client),
 		internal.NewWorkspacePermissionService(client),
-		internal.NewRepositoryService(client),
+		internal.NewRepositoryService(client,
+			internal.NewRepositoryForkService(client),
+			internal.NewRepositoryHookService(client),
+			internal.NewRepositorySettingService(client),
+			internal.NewRepositoryGroupPermissionService(client),
+			internal.NewRepositoryUserPermissionService(client),
+		),
 		internal.NewProjectService(client),
 	)
 
+	client.PullRequest = internal.NewPullRequestService(client, internal.NewPullRequestCommentService(client))
+	client.Branch = internal.NewBranchService(client)
+	client.Tag = internal.NewTagService(client)
+	client.Commit = internal.NewCommitService(client)
+	client.Source = internal.NewSourceService(client)
+	client.Pipeline = internal.NewPipelineService(client,
+		internal.NewPipelineVariableService(client),
+		internal.NewPipelineDeploymentVariableService(client),
+		internal.NewPipelineWorkspaceVariableService(client),
+		internal.NewPipelineScheduleService(client),
+	)
+
 	return client, nil
 }
 
@@ -75,8 +95,19 @@ type Client struct {
 	MaxRetries        int
 	InitialRetryDelay time.Duration
 	MaxRetryDelay     time.Duration
+	RetryPolicy       common.RetryPolicy
+	RateLimiter       *common.RateLimiter
+	Instrumentation   common.Instrumentation
+	Logger            *slog.Logger
+	Debug             bool
 	Auth              common.Authentication
 	Workspace         *internal.WorkspaceService
+	PullRequest       *internal.PullRequestService
+	Branch            *internal.BranchService
+	Tag               *internal.TagService
+	Commit            *internal.CommitService
+	Source            *internal.SourceService
+	Pipeline          *internal.PipelineService
 }
 
 // NewRequest creates an API request.
@@ -134,100 +165,30 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, typ string, bod
 	return req, nil
 }
 
-// Call executes an API request and returns the response.
+// Call executes an API request through the shared transport and returns the response.
 func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
-	retryCount := 0
-	ctx := request.Context()
-
-	for {
-		response, err := c.HTTP.Do(request)
-		if err != nil {
-			return nil, err
-		}
-
-		// If rate limit exceeded, sleep with exponential backoff
-		if response.StatusCode == http.StatusTooManyRequests {
-			delay := c.InitialRetryDelay
-			// Use bit shifting for exponential backoff (1 << retryCount)
-			delay = delay * (1 << uint(retryCount))
-			if delay > c.MaxRetryDelay {
-				delay = c.MaxRetryDelay
-			}
-			log.Printf("Rate limit exceeded, sleeping for %v request %v", delay, request.URL.String())
-
-			// Get timer
-			timer := time.NewTimer(delay)
-			defer timer.Stop()
-
-			// Wait for either context cancellation or timer
-			select {
-			case <-ctx.Done():
-				timer.Stop()
-				return nil, ctx.Err()
-			case <-timer.C:
-				// Timer completed successfully
-			}
-
-			retryCount++
-			if retryCount > c.MaxRetries {
-				return c.processResponse(response, structure)
-			}
-			continue
-		}
 
-		return c.processResponse(response, structure)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
 	}
-}
 
-func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
-
-	defer response.Body.Close()
-
-	res := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "bitbucket"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
 
-	responseAsBytes, err := io.ReadAll(response.Body)
+	response, err := transport.Do(request)
 	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, models.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, models.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, models.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, models.ErrBadRequest
-
-		case http.StatusTooManyRequests:
-			return res, models.ErrRateLimited
-
-		default:
-			return res, models.ErrInvalidStatusCode
-		}
+		return nil, err
 	}
 
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
+	return c.processResponse(response, structure)
+}
 
-	return res, nil
+func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
+	return common.ProcessResponse(response, structure)
 }
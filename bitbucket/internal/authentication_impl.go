@@ -19,6 +19,9 @@ type AuthenticationService struct {
 
 	userAgentProvided bool
 	agent             string
+
+	source common.TokenSource
+	signer common.RequestSigner
 }
 
 // SetBearerToken sets the token to be used in the Authorization header.
@@ -72,3 +75,23 @@ func (a *AuthenticationService) GetUserAgent() string {
 func (a *AuthenticationService) HasUserAgent() bool {
 	return a.userAgentProvided
 }
+
+// SetTokenSource sets the source of the bearer token of the requests, it takes precedence over the other credentials.
+func (a *AuthenticationService) SetTokenSource(source common.TokenSource) {
+	a.source = source
+}
+
+// GetTokenSource returns the source of the bearer token of the requests.
+func (a *AuthenticationService) GetTokenSource() common.TokenSource {
+	return a.source
+}
+
+// SetRequestSigner sets the signer of the requests, e.g. a Connect JWT signer, it runs after the other credentials are set.
+func (a *AuthenticationService) SetRequestSigner(signer common.RequestSigner) {
+	a.signer = signer
+}
+
+// GetRequestSigner returns the signer of the requests.
+func (a *AuthenticationService) GetRequestSigner() common.RequestSigner {
+	return a.signer
+}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewBranchService creates a new BranchService.
+func NewBranchService(client service.Connector) *BranchService {
+	return &BranchService{
+		internalClient: &internalBranchServiceImpl{c: client},
+	}
+}
+
+// BranchService handles communication with the branch related methods of the Bitbucket API.
+type BranchService struct {
+	internalClient bitbucket.BranchConnector
+}
+
+// Gets returns a paginated list of the branches of the repository, ordered by sort, e.g. -target.date.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-get
+func (r *BranchService) Gets(ctx context.Context, workspace, repoSlug, sort string, opts *model.PageOptions) (*model.RefPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, sort, opts)
+}
+
+// Get returns the specified branch.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-name-get
+func (r *BranchService) Get(ctx context.Context, workspace, repoSlug, name string) (*model.RefScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, name)
+}
+
+// Create creates a new branch pointing to the target of the payload.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (r *BranchService) Create(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.RefScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Delete deletes the specified branch, the main branch cannot be deleted.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-name-delete
+func (r *BranchService) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, name)
+}
+
+type internalBranchServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalBranchServiceImpl) Gets(ctx context.Context, workspace, repoSlug, sort string, opts *model.PageOptions) (*model.RefPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	query := ""
+	if sort != "" {
+
+		params := url.Values{}
+		params.Add("sort", sort)
+
+		query = "?" + params.Encode()
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/refs/branches%v", workspace, repoSlug, query), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RefPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalBranchServiceImpl) Get(ctx context.Context, workspace, repoSlug, name string) (*model.RefScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, nil, model.ErrNoRefName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.RefScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+func (i *internalBranchServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.RefScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.RefScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+func (i *internalBranchServiceImpl) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, model.ErrNoRefName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
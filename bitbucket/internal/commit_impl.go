@@ -0,0 +1,278 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewCommitService creates a new CommitService.
+func NewCommitService(client service.Connector) *CommitService {
+	return &CommitService{
+		internalClient: &internalCommitServiceImpl{c: client},
+	}
+}
+
+// CommitService handles communication with the commit related methods of the Bitbucket API.
+type CommitService struct {
+	internalClient bitbucket.CommitConnector
+}
+
+// Gets returns a paginated list of the commits of the repository, in reverse chronological order.
+//
+// The commits reachable from options.Include and not from options.Exclude are returned,
+// e.g. the commits of a feature branch not merged yet are listed with Include: feature and Exclude: main.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commits
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-commits-get
+func (r *CommitService) Gets(ctx context.Context, workspace, repoSlug string, options *model.CommitListOptionsScheme, opts *model.PageOptions) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, options, opts)
+}
+
+// Get returns the specified commit.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-commit-commit-get
+func (r *CommitService) Get(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, commit)
+}
+
+// Diff returns the raw diff between two revisions, in the unified diff format.
+//
+// The spec is either a single commit, diffed against its first parent, or two revisions separated by "..".
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/diff/{spec}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-diff-spec-get
+func (r *CommitService) Diff(ctx context.Context, workspace, repoSlug, spec string, options *model.DiffOptionsScheme) (string, *model.ResponseScheme, error) {
+	return r.internalClient.Diff(ctx, workspace, repoSlug, spec, options)
+}
+
+// DiffStat returns a paginated list of the files changed between two revisions.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/diffstat/{spec}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-diffstat-spec-get
+func (r *CommitService) DiffStat(ctx context.Context, workspace, repoSlug, spec string, opts *model.PageOptions) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.DiffStat(ctx, workspace, repoSlug, spec, opts)
+}
+
+// Patch returns the raw patch between two revisions, as a series of git format-patch messages.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/patch/{spec}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-patch-spec-get
+func (r *CommitService) Patch(ctx context.Context, workspace, repoSlug, spec string) (string, *model.ResponseScheme, error) {
+	return r.internalClient.Patch(ctx, workspace, repoSlug, spec)
+}
+
+type internalCommitServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalCommitServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.CommitListOptionsScheme, opts *model.PageOptions) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	params := url.Values{}
+	if options != nil {
+
+		for _, include := range options.Include {
+			params.Add("include", include)
+		}
+
+		for _, exclude := range options.Exclude {
+			params.Add("exclude", exclude)
+		}
+
+		if options.Path != "" {
+			params.Add("path", options.Path)
+		}
+	}
+
+	query := ""
+	if len(params) != 0 {
+		query = "?" + params.Encode()
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/commits%v", workspace, repoSlug, query), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalCommitServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit string) (*model.CommitScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if commit == "" {
+		return nil, nil, model.ErrNoCommit
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/commit/%v", workspace, repoSlug, commit)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.CommitScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalCommitServiceImpl) Diff(ctx context.Context, workspace, repoSlug, spec string, options *model.DiffOptionsScheme) (string, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return "", nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return "", nil, model.ErrNoRepository
+	}
+
+	if spec == "" {
+		return "", nil, model.ErrNoDiffSpec
+	}
+
+	params := url.Values{}
+	if options != nil {
+
+		if options.Context > 0 {
+			params.Add("context", strconv.Itoa(options.Context))
+		}
+
+		for _, path := range options.Paths {
+			params.Add("path", path)
+		}
+
+		if options.IgnoreWhitespace {
+			params.Add("ignore_whitespace", "true")
+		}
+
+		if options.Binary {
+			params.Add("binary", "true")
+		}
+	}
+
+	query := ""
+	if len(params) != 0 {
+		query = "?" + params.Encode()
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/diff/%v%v", workspace, repoSlug, spec, query)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The diff is plain text, it is read from the response instead of being decoded.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return "", response, err
+	}
+
+	return response.Bytes.String(), response, nil
+}
+
+func (i *internalCommitServiceImpl) DiffStat(ctx context.Context, workspace, repoSlug, spec string, opts *model.PageOptions) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if spec == "" {
+		return nil, nil, model.ErrNoDiffSpec
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/diffstat/%v", workspace, repoSlug, spec), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DiffStatPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalCommitServiceImpl) Patch(ctx context.Context, workspace, repoSlug, spec string) (string, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return "", nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return "", nil, model.ErrNoRepository
+	}
+
+	if spec == "" {
+		return "", nil, model.ErrNoDiffSpec
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/patch/%v", workspace, repoSlug, spec)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The patch is plain text, it is read from the response instead of being decoded.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return "", response, err
+	}
+
+	return response.Bytes.String(), response, nil
+}
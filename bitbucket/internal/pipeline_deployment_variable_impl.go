@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineDeploymentVariableService creates a new PipelineDeploymentVariableService.
+func NewPipelineDeploymentVariableService(client service.Connector) *PipelineDeploymentVariableService {
+	return &PipelineDeploymentVariableService{
+		internalClient: &internalPipelineDeploymentVariableServiceImpl{c: client},
+	}
+}
+
+// PipelineDeploymentVariableService handles communication with the deployment environment variable related methods of the Bitbucket API.
+type PipelineDeploymentVariableService struct {
+	internalClient bitbucket.PipelineDeploymentVariableConnector
+}
+
+// Gets returns a paginated list of the variables of the deployment environment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-get
+func (r *PipelineDeploymentVariableService) Gets(ctx context.Context, workspace, repoSlug, environmentUUID string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, environmentUUID, opts)
+}
+
+// Create creates a variable on the deployment environment, it overrides the repository variable with the same key.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-post
+func (r *PipelineDeploymentVariableService) Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, environmentUUID, payload)
+}
+
+// Update updates the specified variable of the deployment environment.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-variable-uuid-put
+func (r *PipelineDeploymentVariableService) Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, environmentUUID, variableUUID, payload)
+}
+
+// Delete deletes the specified variable of the deployment environment.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-deployments-config-environments-environment-uuid-variables-variable-uuid-delete
+func (r *PipelineDeploymentVariableService) Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, environmentUUID, variableUUID)
+}
+
+type internalPipelineDeploymentVariableServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPipelineDeploymentVariableServiceImpl) Gets(ctx context.Context, workspace, repoSlug, environmentUUID string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, model.ErrNoEnvironmentUUID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables", workspace, repoSlug, environmentUUID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPipelineVariablesPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPipelineDeploymentVariableServiceImpl) Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, model.ErrNoEnvironmentUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables", workspace, repoSlug, environmentUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineDeploymentVariableServiceImpl) Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if environmentUUID == "" {
+		return nil, nil, model.ErrNoEnvironmentUUID
+	}
+
+	if variableUUID == "" {
+		return nil, nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables/%v", workspace, repoSlug, environmentUUID, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineDeploymentVariableServiceImpl) Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if environmentUUID == "" {
+		return nil, model.ErrNoEnvironmentUUID
+	}
+
+	if variableUUID == "" {
+		return nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/deployments_config/environments/%v/variables/%v", workspace, repoSlug, environmentUUID, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,347 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineService creates a new PipelineService.
+func NewPipelineService(client service.Connector, variable *PipelineVariableService, deploymentVariable *PipelineDeploymentVariableService,
+	workspaceVariable *PipelineWorkspaceVariableService, schedule *PipelineScheduleService) *PipelineService {
+
+	return &PipelineService{
+		internalClient:     &internalPipelineServiceImpl{c: client},
+		Variable:           variable,
+		DeploymentVariable: deploymentVariable,
+		WorkspaceVariable:  workspaceVariable,
+		Schedule:           schedule,
+	}
+}
+
+// PipelineService handles communication with the pipeline related methods of the Bitbucket API.
+type PipelineService struct {
+	internalClient     bitbucket.PipelineConnector
+	Variable           *PipelineVariableService
+	DeploymentVariable *PipelineDeploymentVariableService
+	WorkspaceVariable  *PipelineWorkspaceVariableService
+	Schedule           *PipelineScheduleService
+}
+
+// Gets returns a paginated list of the pipelines of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-get
+func (r *PipelineService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPipelineRunsPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Trigger runs a new pipeline on the target of the payload: a branch, a tag, a commit or a custom pipeline of either.
+//
+// The variables of the payload are only applied to custom pipelines.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-post
+func (r *PipelineService) Trigger(ctx context.Context, workspace, repoSlug string, payload *model.PipelineTriggerPayloadScheme) (*model.RepositoryPipelineRun, *model.ResponseScheme, error) {
+	return r.internalClient.Trigger(ctx, workspace, repoSlug, payload)
+}
+
+// Get returns the specified pipeline.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-get
+func (r *PipelineService) Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.RepositoryPipelineRun, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, pipelineUUID)
+}
+
+// Stop signals the specified pipeline to stop, the steps already completed are not affected.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/stopPipeline
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-stoppipeline-post
+func (r *PipelineService) Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Stop(ctx, workspace, repoSlug, pipelineUUID)
+}
+
+// Step returns the specified step of a pipeline.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-get
+func (r *PipelineService) Step(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*model.RepositoryPipelineRunStep, *model.ResponseScheme, error) {
+	return r.internalClient.Step(ctx, workspace, repoSlug, pipelineUUID, stepUUID)
+}
+
+// Log returns the log of the specified step starting at offset, the whole log is returned when offset is 0.
+//
+// An empty log is returned when the log has no byte past offset yet, use StreamLog to follow the log of a running step.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}/log
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-pipeline-uuid-steps-step-uuid-log-get
+func (r *PipelineService) Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string, offset int64) ([]byte, *model.ResponseScheme, error) {
+	return r.internalClient.Log(ctx, workspace, repoSlug, pipelineUUID, stepUUID, offset)
+}
+
+// StreamLog writes the log of the specified step to w as it is produced, polling the step every interval.
+//
+// It returns once the step is completed and its whole log was written, or when ctx is done. The interval must be positive.
+func (r *PipelineService) StreamLog(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string, interval time.Duration, w io.Writer) error {
+
+	if interval <= 0 {
+		return model.ErrInvalidPollInterval
+	}
+
+	var offset int64
+	for {
+
+		// The state is read before the log, so the log read after the completion of the step is the last one.
+		step, _, err := r.internalClient.Step(ctx, workspace, repoSlug, pipelineUUID, stepUUID)
+		if err != nil {
+			return err
+		}
+
+		chunk, _, err := r.internalClient.Log(ctx, workspace, repoSlug, pipelineUUID, stepUUID, offset)
+		if err != nil {
+			return err
+		}
+
+		if len(chunk) != 0 {
+
+			written, err := w.Write(chunk)
+			offset += int64(written)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if step.State != nil && step.State.Name == "COMPLETED" {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+type internalPipelineServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPipelineServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPipelineRunsPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pipelines", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPipelineRunsPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPipelineServiceImpl) Trigger(ctx context.Context, workspace, repoSlug string, payload *model.PipelineTriggerPayloadScheme) (*model.RepositoryPipelineRun, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if payload == nil || payload.Target == nil {
+		return nil, nil, model.ErrNoPipelineTarget
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline := new(model.RepositoryPipelineRun)
+	response, err := i.c.Call(request, pipeline)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pipeline, response, nil
+}
+
+func (i *internalPipelineServiceImpl) Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.RepositoryPipelineRun, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, model.ErrNoPipelineUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v", workspace, repoSlug, pipelineUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline := new(model.RepositoryPipelineRun)
+	response, err := i.c.Call(request, pipeline)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pipeline, response, nil
+}
+
+func (i *internalPipelineServiceImpl) Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if pipelineUUID == "" {
+		return nil, model.ErrNoPipelineUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/stopPipeline", workspace, repoSlug, pipelineUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPipelineServiceImpl) Step(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*model.RepositoryPipelineRunStep, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, model.ErrNoPipelineUUID
+	}
+
+	if stepUUID == "" {
+		return nil, nil, model.ErrNoPipelineStepUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps/%v", workspace, repoSlug, pipelineUUID, stepUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	step := new(model.RepositoryPipelineRunStep)
+	response, err := i.c.Call(request, step)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return step, response, nil
+}
+
+func (i *internalPipelineServiceImpl) Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string, offset int64) ([]byte, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pipelineUUID == "" {
+		return nil, nil, model.ErrNoPipelineUUID
+	}
+
+	if stepUUID == "" {
+		return nil, nil, model.ErrNoPipelineStepUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines/%v/steps/%v/log", workspace, repoSlug, pipelineUUID, stepUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%v-", offset))
+	}
+
+	// The log is plain text, it is read from the response instead of being decoded.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+
+		// The range cannot be satisfied while nothing was written past offset yet.
+		if response != nil && response.Code == http.StatusRequestedRangeNotSatisfiable {
+			return []byte{}, response, nil
+		}
+
+		return nil, response, err
+	}
+
+	// The whole log is returned when the server ignores the range, the bytes before offset were already read.
+	log := response.Bytes.Bytes()
+	if offset > 0 && response.Code != http.StatusPartialContent {
+
+		if int64(len(log)) <= offset {
+			return []byte{}, response, nil
+		}
+
+		log = log[offset:]
+	}
+
+	return log, response, nil
+}
@@ -0,0 +1,1000 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalPipelineServiceImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		opts      *model.PageOptions
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				opts:      &model.PageOptions{Page: 2, PageLen: 50},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines?page=2&pagelen=50",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryPipelineRunsPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				opts:      &model.PageOptions{Page: 2, PageLen: 50},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines?page=2&pagelen=50",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.opts)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Trigger(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		workspace string
+		repoSlug  string
+		payload   *model.PipelineTriggerPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   &model.PipelineTriggerPayloadScheme{Target: &model.PipelineTriggerTargetScheme{Type: model.PipelineRefTarget, RefType: "branch", RefName: "main", Selector: &model.PipelineSelector{Type: "custom", Pattern: "deploy"}}, Variables: []*model.PipelineVariablePayloadScheme{{Key: "ENV", Value: "staging"}}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines",
+					"", &model.PipelineTriggerPayloadScheme{Target: &model.PipelineTriggerTargetScheme{Type: model.PipelineRefTarget, RefType: "branch", RefName: "main", Selector: &model.PipelineSelector{Type: "custom", Pattern: "deploy"}}, Variables: []*model.PipelineVariablePayloadScheme{{Key: "ENV", Value: "staging"}}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryPipelineRun{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+				payload:   &model.PipelineTriggerPayloadScheme{Target: &model.PipelineTriggerTargetScheme{Type: model.PipelineRefTarget, RefType: "branch", RefName: "main", Selector: &model.PipelineSelector{Type: "custom", Pattern: "deploy"}}, Variables: []*model.PipelineVariablePayloadScheme{{Key: "ENV", Value: "staging"}}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines",
+					"", &model.PipelineTriggerPayloadScheme{Target: &model.PipelineTriggerTargetScheme{Type: model.PipelineRefTarget, RefType: "branch", RefName: "main", Selector: &model.PipelineSelector{Type: "custom", Pattern: "deploy"}}, Variables: []*model.PipelineVariablePayloadScheme{{Key: "ENV", Value: "staging"}}}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline target is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineTarget,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Trigger(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryPipelineRun{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Stop(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/stopPipeline",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/stopPipeline",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResponse, err := newService.Stop(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Step(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+		stepUUID     string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/steps/{step-uuid}",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RepositoryPipelineRunStep{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/steps/{step-uuid}",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the workspace is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoWorkspace,
+		},
+
+		{
+			name: "when the repository is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoRepository,
+		},
+
+		{
+			name: "when the pipeline uuid is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				repoSlug:  "repository-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineUUID,
+		},
+
+		{
+			name: "when the step uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineStepUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Step(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug, testCase.args.pipelineUUID, testCase.args.stepUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalPipelineServiceImpl_Log(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		workspace    string
+		repoSlug     string
+		pipelineUUID string
+		stepUUID     string
+		offset       int64
+	}
+
+	endpoint := "2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/steps/{step-uuid}/log"
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []byte
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+				offset:       128,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					endpoint,
+					"", nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				response := &model.ResponseScheme{Code: http.StatusPartialContent}
+				response.Bytes.WriteString("+ go test ./...")
+
+				client.On("Call",
+					&http.Request{Header: http.Header{"Range": []string{"bytes=128-"}}},
+					nil).
+					Return(response, nil)
+
+				fields.c = client
+			},
+			want: []byte("+ go test ./..."),
+		},
+
+		{
+			name: "when the range is ignored by the server",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+				offset:       11,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					endpoint,
+					"", nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				response := &model.ResponseScheme{Code: http.StatusOK}
+				response.Bytes.WriteString("+ go build\n+ go test ./...")
+
+				client.On("Call",
+					&http.Request{Header: http.Header{"Range": []string{"bytes=11-"}}},
+					nil).
+					Return(response, nil)
+
+				fields.c = client
+			},
+			want: []byte("+ go test ./..."),
+		},
+
+		{
+			name: "when the log has no byte past the offset",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+				offset:       128,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					endpoint,
+					"", nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				client.On("Call",
+					&http.Request{Header: http.Header{"Range": []string{"bytes=128-"}}},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusRequestedRangeNotSatisfiable}, model.ErrInvalidStatusCode)
+
+				fields.c = client
+			},
+			want: []byte{},
+		},
+
+		{
+			name: "when the log cannot be read",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					endpoint,
+					"", nil).
+					Return(&http.Request{Header: http.Header{}}, nil)
+
+				client.On("Call",
+					&http.Request{Header: http.Header{}},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusNotFound}, model.ErrInvalidStatusCode)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidStatusCode,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+				stepUUID:     "{step-uuid}",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					endpoint,
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the step uuid is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspace:    "work-space-name-sample",
+				repoSlug:     "repository-sample",
+				pipelineUUID: "{pipeline-uuid}",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPipelineStepUUID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewPipelineService(testCase.fields.c, nil, nil, nil, nil)
+
+			gotResult, gotResponse, err := newService.Log(testCase.args.ctx, testCase.args.workspace, testCase.args.repoSlug,
+				testCase.args.pipelineUUID, testCase.args.stepUUID, testCase.args.offset)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+
+		})
+	}
+}
+
+func Test_PipelineService_StreamLog(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	stepEndpoint := "2.0/repositories/work-space-name-sample/repository-sample/pipelines/{pipeline-uuid}/steps/{step-uuid}"
+
+	// The step is in progress on the first poll and completed on the second one.
+	states := []string{"IN_PROGRESS", "COMPLETED"}
+
+	client.On("NewRequest", context.Background(), http.MethodGet, stepEndpoint, "", nil).
+		Return(&http.Request{Header: http.Header{}}, nil)
+
+	client.On("Call", mock.Anything, &model.RepositoryPipelineRunStep{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.RepositoryPipelineRunStep).State = &model.PipelineStepState{Name: states[0]}
+			states = states[1:]
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	// Each log read returns the next chunk, starting where the previous one ended.
+	chunks := []string{"+ go build\n", "+ go test\n"}
+	ranges := []string{"", "bytes=11-"}
+
+	client.On("NewRequest", context.Background(), http.MethodGet, stepEndpoint+"/log", "", nil).
+		Return(&http.Request{Header: http.Header{}}, nil)
+
+	client.On("Call", mock.Anything, nil).
+		Return(func(request *http.Request, _ interface{}) *model.ResponseScheme {
+
+			assert.Equal(t, ranges[0], request.Header.Get("Range"))
+
+			response := &model.ResponseScheme{Code: http.StatusOK}
+			if ranges[0] != "" {
+				response.Code = http.StatusPartialContent
+			}
+
+			response.Bytes.WriteString(chunks[0])
+			chunks, ranges = chunks[1:], ranges[1:]
+
+			return response
+		}, nil)
+
+	service := NewPipelineService(client, nil, nil, nil, nil)
+
+	var buffer bytes.Buffer
+	err := service.StreamLog(context.Background(), "work-space-name-sample", "repository-sample", "{pipeline-uuid}", "{step-uuid}", time.Millisecond, &buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+ go build\n+ go test\n", buffer.String())
+}
+
+func Test_PipelineService_StreamLog_Canceled(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest", mock.Anything, http.MethodGet, mock.Anything, "", nil).
+		Return(&http.Request{Header: http.Header{}}, nil)
+
+	client.On("Call", mock.Anything, &model.RepositoryPipelineRunStep{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.RepositoryPipelineRunStep).State = &model.PipelineStepState{Name: "IN_PROGRESS"}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("Call", mock.Anything, nil).
+		Return(&model.ResponseScheme{Code: http.StatusRequestedRangeNotSatisfiable}, model.ErrInvalidStatusCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	service := NewPipelineService(client, nil, nil, nil, nil)
+
+	err := service.StreamLog(ctx, "work-space-name-sample", "repository-sample", "{pipeline-uuid}", "{step-uuid}", time.Millisecond, io.Discard)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_PipelineService_StreamLog_Interval(t *testing.T) {
+
+	service := NewPipelineService(mocks.NewConnector(t), nil, nil, nil, nil)
+
+	err := service.StreamLog(context.Background(), "work-space-name-sample", "repository-sample", "{pipeline-uuid}", "{step-uuid}", 0, io.Discard)
+	assert.ErrorIs(t, err, model.ErrInvalidPollInterval)
+}
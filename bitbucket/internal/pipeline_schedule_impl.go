@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineScheduleService creates a new PipelineScheduleService.
+func NewPipelineScheduleService(client service.Connector) *PipelineScheduleService {
+	return &PipelineScheduleService{
+		internalClient: &internalPipelineScheduleServiceImpl{c: client},
+	}
+}
+
+// PipelineScheduleService handles communication with the pipeline schedule related methods of the Bitbucket API.
+type PipelineScheduleService struct {
+	internalClient bitbucket.PipelineScheduleConnector
+}
+
+// Gets returns a paginated list of the pipeline schedules of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-get
+func (r *PipelineScheduleService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.PipelineSchedulePageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Get returns the specified pipeline schedule.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-get
+func (r *PipelineScheduleService) Get(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, scheduleUUID)
+}
+
+// Create creates a pipeline schedule running the branch of the payload following its cron pattern.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-post
+func (r *PipelineScheduleService) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineSchedulePayloadScheme) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update enables or disables the specified pipeline schedule, the other attributes of a schedule cannot be updated.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-put
+func (r *PipelineScheduleService) Update(ctx context.Context, workspace, repoSlug, scheduleUUID string, enabled bool) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, scheduleUUID, enabled)
+}
+
+// Delete deletes the specified pipeline schedule.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-schedules-schedule-uuid-delete
+func (r *PipelineScheduleService) Delete(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, scheduleUUID)
+}
+
+type internalPipelineScheduleServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPipelineScheduleServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.PipelineSchedulePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/schedules", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PipelineSchedulePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPipelineScheduleServiceImpl) Get(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if scheduleUUID == "" {
+		return nil, nil, model.ErrNoPipelineScheduleUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/schedules/%v", workspace, repoSlug, scheduleUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schedule := new(model.PipelineScheduleScheme)
+	response, err := i.c.Call(request, schedule)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return schedule, response, nil
+}
+
+func (i *internalPipelineScheduleServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineSchedulePayloadScheme) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/schedules", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schedule := new(model.PipelineScheduleScheme)
+	response, err := i.c.Call(request, schedule)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return schedule, response, nil
+}
+
+func (i *internalPipelineScheduleServiceImpl) Update(ctx context.Context, workspace, repoSlug, scheduleUUID string, enabled bool) (*model.PipelineScheduleScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if scheduleUUID == "" {
+		return nil, nil, model.ErrNoPipelineScheduleUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/schedules/%v", workspace, repoSlug, scheduleUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", map[string]interface{}{"enabled": enabled})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schedule := new(model.PipelineScheduleScheme)
+	response, err := i.c.Call(request, schedule)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return schedule, response, nil
+}
+
+func (i *internalPipelineScheduleServiceImpl) Delete(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if scheduleUUID == "" {
+		return nil, model.ErrNoPipelineScheduleUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/schedules/%v", workspace, repoSlug, scheduleUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
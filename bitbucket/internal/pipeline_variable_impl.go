@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineVariableService creates a new PipelineVariableService.
+func NewPipelineVariableService(client service.Connector) *PipelineVariableService {
+	return &PipelineVariableService{
+		internalClient: &internalPipelineVariableServiceImpl{c: client},
+	}
+}
+
+// PipelineVariableService handles communication with the repository pipeline variable related methods of the Bitbucket API.
+type PipelineVariableService struct {
+	internalClient bitbucket.PipelineVariableConnector
+}
+
+// Gets returns a paginated list of the pipeline variables of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-get
+func (r *PipelineVariableService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Get returns the specified repository pipeline variable, the value of a secured variable is not returned.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-get
+func (r *PipelineVariableService) Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, variableUUID)
+}
+
+// Create creates a repository pipeline variable.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-post
+func (r *PipelineVariableService) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the key, the value or the secured flag of the specified repository pipeline variable.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-put
+func (r *PipelineVariableService) Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, variableUUID, payload)
+}
+
+// Delete deletes the specified repository pipeline variable.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-variable-uuid-delete
+func (r *PipelineVariableService) Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, variableUUID)
+}
+
+type internalPipelineVariableServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPipelineVariableServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPipelineVariablesPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPipelineVariableServiceImpl) Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if variableUUID == "" {
+		return nil, nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineVariableServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineVariableServiceImpl) Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if variableUUID == "" {
+		return nil, nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineVariableServiceImpl) Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if variableUUID == "" {
+		return nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pipelines_config/variables/%v", workspace, repoSlug, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
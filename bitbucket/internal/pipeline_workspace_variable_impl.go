@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPipelineWorkspaceVariableService creates a new PipelineWorkspaceVariableService.
+func NewPipelineWorkspaceVariableService(client service.Connector) *PipelineWorkspaceVariableService {
+	return &PipelineWorkspaceVariableService{
+		internalClient: &internalPipelineWorkspaceVariableServiceImpl{c: client},
+	}
+}
+
+// PipelineWorkspaceVariableService handles communication with the workspace pipeline variable related methods of the Bitbucket API.
+type PipelineWorkspaceVariableService struct {
+	internalClient bitbucket.PipelineWorkspaceVariableConnector
+}
+
+// Gets returns a paginated list of the pipeline variables of the workspace.
+//
+// GET /2.0/workspaces/{workspace}/pipelines-config/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-get
+func (r *PipelineWorkspaceVariableService) Gets(ctx context.Context, workspace string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, opts)
+}
+
+// Get returns the specified workspace pipeline variable, the value of a secured variable is not returned.
+//
+// GET /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-get
+func (r *PipelineWorkspaceVariableService) Get(ctx context.Context, workspace, variableUUID string) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, variableUUID)
+}
+
+// Create creates a workspace pipeline variable, available to the pipelines of every repository of the workspace.
+//
+// POST /2.0/workspaces/{workspace}/pipelines-config/variables
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-post
+func (r *PipelineWorkspaceVariableService) Create(ctx context.Context, workspace string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, payload)
+}
+
+// Update updates the specified workspace pipeline variable.
+//
+// PUT /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-put
+func (r *PipelineWorkspaceVariableService) Update(ctx context.Context, workspace, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, variableUUID, payload)
+}
+
+// Delete deletes the specified workspace pipeline variable.
+//
+// DELETE /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-workspaces-workspace-pipelines-config-variables-variable-uuid-delete
+func (r *PipelineWorkspaceVariableService) Delete(ctx context.Context, workspace, variableUUID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, variableUUID)
+}
+
+type internalPipelineWorkspaceVariableServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPipelineWorkspaceVariableServiceImpl) Gets(ctx context.Context, workspace string, opts *model.PageOptions) (*model.RepositoryPipelineVariablesPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables", workspace), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPipelineVariablesPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPipelineWorkspaceVariableServiceImpl) Get(ctx context.Context, workspace, variableUUID string) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if variableUUID == "" {
+		return nil, nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineWorkspaceVariableServiceImpl) Create(ctx context.Context, workspace string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables", workspace)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineWorkspaceVariableServiceImpl) Update(ctx context.Context, workspace, variableUUID string, payload *model.PipelineVariablePayloadScheme) (*model.RepositoryPipelineVariable, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if variableUUID == "" {
+		return nil, nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(model.RepositoryPipelineVariable)
+	response, err := i.c.Call(request, variable)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variable, response, nil
+}
+
+func (i *internalPipelineWorkspaceVariableServiceImpl) Delete(ctx context.Context, workspace, variableUUID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if variableUUID == "" {
+		return nil, model.ErrNoPipelineVariableUUID
+	}
+
+	endpoint := fmt.Sprintf("2.0/workspaces/%v/pipelines-config/variables/%v", workspace, variableUUID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPullRequestCommentService creates a new PullRequestCommentService.
+func NewPullRequestCommentService(client service.Connector) *PullRequestCommentService {
+	return &PullRequestCommentService{
+		internalClient: &internalPullRequestCommentServiceImpl{c: client},
+	}
+}
+
+// PullRequestCommentService handles communication with the pull request comment related methods of the Bitbucket API.
+type PullRequestCommentService struct {
+	internalClient bitbucket.PullRequestCommentConnector
+}
+
+// Gets returns a paginated list of the comments of the specified pull request, including the inline comments.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-get
+func (r *PullRequestCommentService) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.PullRequestCommentPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, pullRequestID, opts)
+}
+
+// Get returns the specified pull request comment.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-get
+func (r *PullRequestCommentService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+// Add adds a comment to the specified pull request.
+//
+// The comment is anchored to a file and line when the payload carries an inline anchor,
+// and is added as a reply when the payload carries a parent.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (r *PullRequestCommentService) Add(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Add(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Resolve resolves the specified comment thread.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-resolve-post
+func (r *PullRequestCommentService) Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentResolutionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Resolve(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+// Reopen reopens the specified resolved comment thread.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-resolve-delete
+func (r *PullRequestCommentService) Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+	return r.internalClient.Reopen(ctx, workspace, repoSlug, pullRequestID, commentID)
+}
+
+type internalPullRequestCommentServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPullRequestCommentServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.PullRequestCommentPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments", workspace, repoSlug, pullRequestID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestCommentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPullRequestCommentServiceImpl) Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	if commentID == 0 {
+		return nil, nil, model.ErrNoPullRequestCommentID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.PullRequestCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalPullRequestCommentServiceImpl) Add(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestCommentPayloadScheme) (*model.PullRequestCommentScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(model.PullRequestCommentScheme)
+	response, err := i.c.Call(request, comment)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return comment, response, nil
+}
+
+func (i *internalPullRequestCommentServiceImpl) Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.PullRequestCommentResolutionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	if commentID == 0 {
+		return nil, nil, model.ErrNoPullRequestCommentID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v/resolve", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolution := new(model.PullRequestCommentResolutionScheme)
+	response, err := i.c.Call(request, resolution)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return resolution, response, nil
+}
+
+func (i *internalPullRequestCommentServiceImpl) Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, model.ErrNoPullRequestID
+	}
+
+	if commentID == 0 {
+		return nil, model.ErrNoPullRequestCommentID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/comments/%v/resolve", workspace, repoSlug, pullRequestID, commentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
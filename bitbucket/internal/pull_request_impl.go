@@ -0,0 +1,599 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewPullRequestService creates a new PullRequestService.
+func NewPullRequestService(client service.Connector, comment *PullRequestCommentService) *PullRequestService {
+	return &PullRequestService{
+		internalClient: &internalPullRequestServiceImpl{c: client},
+		Comment:        comment,
+	}
+}
+
+// PullRequestService handles communication with the pull request related methods of the Bitbucket API.
+type PullRequestService struct {
+	internalClient bitbucket.PullRequestConnector
+	Comment        *PullRequestCommentService
+}
+
+// Gets returns a paginated list of the pull requests of the repository,
+// filtered by states (OPEN, MERGED, DECLINED or SUPERSEDED), only the open ones are returned when no state is provided.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-get
+func (r *PullRequestService) Gets(ctx context.Context, workspace, repoSlug string, states []string, opts *model.PageOptions) (*model.PullRequestsResponse, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, states, opts)
+}
+
+// Create creates a new pull request, the source branch is required.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (r *PullRequestService) Create(ctx context.Context, workspace, repoSlug string, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Get returns the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-get
+func (r *PullRequestService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Update updates the specified open pull request.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-put
+func (r *PullRequestService) Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Decline declines the specified pull request.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-decline-post
+func (r *PullRequestService) Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Decline(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Merge merges the specified pull request.
+//
+// The merge strategy and whether the source branch is closed are taken from the payload.
+// Long merges are completed asynchronously, Bitbucket then replies with a 202 pointing to the merge task status in the Location header.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-merge-post
+func (r *PullRequestService) Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestMergePayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Merge(ctx, workspace, repoSlug, pullRequestID, payload)
+}
+
+// Approve approves the specified pull request as the authenticated user.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-approve-post
+func (r *PullRequestService) Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Approve(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Unapprove removes the approval of the authenticated user from the specified pull request.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-approve-delete
+func (r *PullRequestService) Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+	return r.internalClient.Unapprove(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// RequestChanges requests changes on the specified pull request as the authenticated user.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-request-changes-post
+func (r *PullRequestService) RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+	return r.internalClient.RequestChanges(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// Activity returns a paginated list of the activity of the specified pull request:
+// the updates, approvals, change requests and comments.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/activity
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-activity-get
+func (r *PullRequestService) Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.PullRequestActivityPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Activity(ctx, workspace, repoSlug, pullRequestID, opts)
+}
+
+// Commits returns a paginated list of the commits of the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/commits
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-commits-get
+func (r *PullRequestService) Commits(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Commits(ctx, workspace, repoSlug, pullRequestID, opts)
+}
+
+// Statuses returns a paginated list of the statuses of the last commit of the specified pull request.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/statuses
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-statuses-get
+func (r *PullRequestService) Statuses(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.CommitStatusPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Statuses(ctx, workspace, repoSlug, pullRequestID, opts)
+}
+
+// Diff returns the diff of the specified pull request, in the unified diff format.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-diff-get
+func (r *PullRequestService) Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (string, *model.ResponseScheme, error) {
+	return r.internalClient.Diff(ctx, workspace, repoSlug, pullRequestID)
+}
+
+// DiffStat returns a paginated list of the files changed by the specified pull request, with the number of lines added and removed.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-diffstat-get
+func (r *PullRequestService) DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.DiffStat(ctx, workspace, repoSlug, pullRequestID, opts)
+}
+
+type internalPullRequestServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalPullRequestServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, states []string, opts *model.PageOptions) (*model.PullRequestsResponse, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	query := ""
+	if len(states) != 0 {
+
+		params := url.Values{}
+		for _, state := range states {
+			params.Add("state", state)
+		}
+
+		query = "?" + params.Encode()
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests%v", workspace, repoSlug, query), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestsResponse)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestPayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/decline", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *model.PullRequestMergePayloadScheme) (*model.PullRequestScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	// The merge is performed with the default message and strategy of the destination branch when no payload is provided.
+	if payload == nil {
+		payload = &model.PullRequestMergePayloadScheme{}
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/merge", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pullRequest := new(model.PullRequestScheme)
+	response, err := i.c.Call(request, pullRequest)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return pullRequest, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/approve", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participant := new(model.PullRequestParticipantScheme)
+	response, err := i.c.Call(request, participant)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return participant, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/approve", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalPullRequestServiceImpl) RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*model.PullRequestParticipantScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/request-changes", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participant := new(model.PullRequestParticipantScheme)
+	response, err := i.c.Call(request, participant)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return participant, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.PullRequestActivityPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/activity", workspace, repoSlug, pullRequestID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.PullRequestActivityPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Commits(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.CommitPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/commits", workspace, repoSlug, pullRequestID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Statuses(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.CommitStatusPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/statuses", workspace, repoSlug, pullRequestID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.CommitStatusPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalPullRequestServiceImpl) Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (string, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return "", nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return "", nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return "", nil, model.ErrNoPullRequestID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/diff", workspace, repoSlug, pullRequestID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The diff is plain text, it is read from the response instead of being decoded.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return "", response, err
+	}
+
+	return response.Bytes.String(), response, nil
+}
+
+func (i *internalPullRequestServiceImpl) DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *model.PageOptions) (*model.DiffStatPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if pullRequestID == 0 {
+		return nil, nil, model.ErrNoPullRequestID
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/pullrequests/%v/diffstat", workspace, repoSlug, pullRequestID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.DiffStatPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
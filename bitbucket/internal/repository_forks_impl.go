@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryForkService creates a new RepositoryForkService.
+func NewRepositoryForkService(client service.Connector) *RepositoryForkService {
+	return &RepositoryForkService{
+		internalClient: &internalRepositoryForkServiceImpl{c: client},
+	}
+}
+
+// RepositoryForkService handles communication with the repository fork related methods of the Bitbucket API.
+type RepositoryForkService struct {
+	internalClient bitbucket.RepositoryForkConnector
+}
+
+// Gets returns a paginated list of all the forks of the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/forks
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-forks-get
+func (r *RepositoryForkService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Execute creates a new fork of the specified repository.
+//
+// The fork is created in the workspace of the payload, or in the workspace of the authenticated user when it is not set.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/forks
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-forks-post
+func (r *RepositoryForkService) Execute(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryForkPayloadScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Execute(ctx, workspace, repoSlug, payload)
+}
+
+type internalRepositoryForkServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalRepositoryForkServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/forks", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalRepositoryForkServiceImpl) Execute(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryForkPayloadScheme) (*model.RepositoryScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	// The parent repository settings are used when no payload is provided.
+	if payload == nil {
+		payload = &model.RepositoryForkPayloadScheme{}
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/forks", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(model.RepositoryScheme)
+	response, err := i.c.Call(request, fork)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return fork, response, nil
+}
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryGroupPermissionService creates a new RepositoryGroupPermissionService.
+func NewRepositoryGroupPermissionService(client service.Connector) *RepositoryGroupPermissionService {
+	return &RepositoryGroupPermissionService{
+		internalClient: &internalRepositoryGroupPermissionServiceImpl{c: client},
+	}
+}
+
+// RepositoryGroupPermissionService handles communication with the repository group permission related methods of the Bitbucket API.
+type RepositoryGroupPermissionService struct {
+	internalClient bitbucket.RepositoryGroupPermissionConnector
+}
+
+// Gets returns a paginated list of the explicit group permissions of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-groups-get
+func (r *RepositoryGroupPermissionService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryGroupPermissionsPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Get returns the explicit permission of the group on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-groups-group-slug-get
+func (r *RepositoryGroupPermissionService) Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.RepositoryGroupPermissionsScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, groupSlug)
+}
+
+// Update sets the explicit permission of the group on the repository, the permission can be read, write or admin.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-groups-group-slug-put
+func (r *RepositoryGroupPermissionService) Update(ctx context.Context, workspace, repoSlug, groupSlug, permission string) (*model.RepositoryGroupPermissionsScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, groupSlug, permission)
+}
+
+// Delete removes the explicit permission of the group on the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-groups-group-slug-delete
+func (r *RepositoryGroupPermissionService) Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, groupSlug)
+}
+
+type internalRepositoryGroupPermissionServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalRepositoryGroupPermissionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryGroupPermissionsPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryGroupPermissionsPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalRepositoryGroupPermissionServiceImpl) Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.RepositoryGroupPermissionsScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if groupSlug == "" {
+		return nil, nil, model.ErrNoGroupSlug
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryGroupPermissionsScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+func (i *internalRepositoryGroupPermissionServiceImpl) Update(ctx context.Context, workspace, repoSlug, groupSlug, permission string) (*model.RepositoryGroupPermissionsScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if groupSlug == "" {
+		return nil, nil, model.ErrNoGroupSlug
+	}
+
+	if permission == "" {
+		return nil, nil, model.ErrNoPermissionLevel
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", map[string]interface{}{"permission": permission})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupPermission := new(model.RepositoryGroupPermissionsScheme)
+	response, err := i.c.Call(request, groupPermission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return groupPermission, response, nil
+}
+
+func (i *internalRepositoryGroupPermissionServiceImpl) Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if groupSlug == "" {
+		return nil, model.ErrNoGroupSlug
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/groups/%v", workspace, repoSlug, groupSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
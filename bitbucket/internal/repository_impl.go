@@ -14,15 +14,27 @@ import (
 )
 
 // NewRepositoryService creates a new instance of the repository service
-func NewRepositoryService(client service.Connector) *RepositoryService {
+func NewRepositoryService(client service.Connector, fork *RepositoryForkService, webhook *RepositoryHookService, setting *RepositorySettingService,
+	groupPermission *RepositoryGroupPermissionService, userPermission *RepositoryUserPermissionService) *RepositoryService {
+
 	return &RepositoryService{
-		internalClient: &internalRepositoryServiceImpl{c: client},
+		internalClient:  &internalRepositoryServiceImpl{c: client},
+		Fork:            fork,
+		Webhook:         webhook,
+		Setting:         setting,
+		GroupPermission: groupPermission,
+		UserPermission:  userPermission,
 	}
 }
 
 // RepositoryService handles communication with the repository related methods
 type RepositoryService struct {
-	internalClient bitbucket.RepositoryConnector
+	internalClient  bitbucket.RepositoryConnector
+	Fork            *RepositoryForkService
+	Webhook         *RepositoryHookService
+	Setting         *RepositorySettingService
+	GroupPermission *RepositoryGroupPermissionService
+	UserPermission  *RepositoryUserPermissionService
 }
 
 // List returns a paginated list of all repositories owned by the specified workspace
@@ -33,7 +33,7 @@ func TestRepositoryService_List(t *testing.T) {
 				workspace: "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -53,7 +53,7 @@ func TestRepositoryService_List(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -73,7 +73,7 @@ func TestRepositoryService_List(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -100,7 +100,7 @@ func TestRepositoryService_List(t *testing.T) {
 						assert.Equal(t, "2.0/repositories/workspace-uuid?page=1&pagelen=20", path)
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -154,7 +154,7 @@ func TestRepositoryService_ListBranchRestrictions(t *testing.T) {
 				repoSlug:  "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -168,7 +168,7 @@ func TestRepositoryService_ListBranchRestrictions(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -189,7 +189,7 @@ func TestRepositoryService_ListBranchRestrictions(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -210,7 +210,7 @@ func TestRepositoryService_ListBranchRestrictions(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -238,7 +238,7 @@ func TestRepositoryService_ListBranchRestrictions(t *testing.T) {
 						assert.Equal(t, "2.0/repositories/workspace-uuid/repo-slug/branch-restrictions?page=1&pagelen=20", path)
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -292,7 +292,7 @@ func TestRepositoryService_ListDefaultReviewers(t *testing.T) {
 				repoSlug:  "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -306,7 +306,7 @@ func TestRepositoryService_ListDefaultReviewers(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -327,7 +327,7 @@ func TestRepositoryService_ListDefaultReviewers(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -348,7 +348,7 @@ func TestRepositoryService_ListDefaultReviewers(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -376,7 +376,7 @@ func TestRepositoryService_ListDefaultReviewers(t *testing.T) {
 						assert.Equal(t, "2.0/repositories/workspace-uuid/repo-slug/default-reviewers?page=1&pagelen=20", path)
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -430,7 +430,7 @@ func TestRepositoryService_ListPullRequests(t *testing.T) {
 				repoSlug:  "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -444,7 +444,7 @@ func TestRepositoryService_ListPullRequests(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -465,7 +465,7 @@ func TestRepositoryService_ListPullRequests(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -486,7 +486,7 @@ func TestRepositoryService_ListPullRequests(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -520,7 +520,7 @@ func TestRepositoryService_ListPullRequests(t *testing.T) {
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -574,7 +574,7 @@ func TestRepositoryService_ListDeployKeys(t *testing.T) {
 				repoSlug:  "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -588,7 +588,7 @@ func TestRepositoryService_ListDeployKeys(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -609,7 +609,7 @@ func TestRepositoryService_ListDeployKeys(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -630,7 +630,7 @@ func TestRepositoryService_ListDeployKeys(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -659,7 +659,7 @@ func TestRepositoryService_ListDeployKeys(t *testing.T) {
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -713,7 +713,7 @@ func TestRepositoryService_ListRepositoryExplicitGroupPermissions(t *testing.T)
 				repoSlug:  "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -727,7 +727,7 @@ func TestRepositoryService_ListRepositoryExplicitGroupPermissions(t *testing.T)
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -748,7 +748,7 @@ func TestRepositoryService_ListRepositoryExplicitGroupPermissions(t *testing.T)
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return nil, fmt.Errorf("error creating request")
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -769,7 +769,7 @@ func TestRepositoryService_ListRepositoryExplicitGroupPermissions(t *testing.T)
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -798,7 +798,7 @@ func TestRepositoryService_ListRepositoryExplicitGroupPermissions(t *testing.T)
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -851,7 +851,7 @@ func TestRepositoryService_ListRepositoryPipelineVariables(t *testing.T) {
 				repoSlug: "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -865,7 +865,7 @@ func TestRepositoryService_ListRepositoryPipelineVariables(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -886,7 +886,7 @@ func TestRepositoryService_ListRepositoryPipelineVariables(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -915,7 +915,7 @@ func TestRepositoryService_ListRepositoryPipelineVariables(t *testing.T) {
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -968,7 +968,7 @@ func TestRepositoryService_ListRepositoryPipelineRuns(t *testing.T) {
 				repoSlug: "repo-slug",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -982,7 +982,7 @@ func TestRepositoryService_ListRepositoryPipelineRuns(t *testing.T) {
 				repoSlug:  "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1003,7 +1003,7 @@ func TestRepositoryService_ListRepositoryPipelineRuns(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1032,7 +1032,7 @@ func TestRepositoryService_ListRepositoryPipelineRuns(t *testing.T) {
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -1087,7 +1087,7 @@ func TestRepositoryService_ListRepositoryPipelineRunSteps(t *testing.T) {
 				pipelineUUID: "pipeline-uuid",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1102,7 +1102,7 @@ func TestRepositoryService_ListRepositoryPipelineRunSteps(t *testing.T) {
 				pipelineUUID: "pipeline-uuid",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1117,7 +1117,7 @@ func TestRepositoryService_ListRepositoryPipelineRunSteps(t *testing.T) {
 				pipelineUUID: "",
 			},
 			on: func(t *testing.T) (*RepositoryService, *models.ResponseScheme) {
-				client := NewRepositoryService(nil)
+				client := NewRepositoryService(nil, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1139,7 +1139,7 @@ func TestRepositoryService_ListRepositoryPipelineRunSteps(t *testing.T) {
 					requestMaker: func(ctx context.Context, method, path, query string, body interface{}) (*http.Request, error) {
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 				return client, nil
 			},
 			wantErr: true,
@@ -1169,7 +1169,7 @@ func TestRepositoryService_ListRepositoryPipelineRunSteps(t *testing.T) {
 
 						return &http.Request{}, nil
 					},
-				})
+				}, nil, nil, nil, nil, nil)
 
 				return client, &models.ResponseScheme{
 					Response: &http.Response{
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositorySettingService creates a new RepositorySettingService.
+func NewRepositorySettingService(client service.Connector) *RepositorySettingService {
+	return &RepositorySettingService{
+		internalClient: &internalRepositorySettingServiceImpl{c: client},
+	}
+}
+
+// RepositorySettingService handles communication with the repository settings related methods of the Bitbucket API.
+type RepositorySettingService struct {
+	internalClient bitbucket.RepositorySettingConnector
+}
+
+// Gets returns the settings inheritance state of the repository,
+// i.e. whether it overrides the merge strategy and branching model of its project.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/override-settings
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-override-settings-get
+func (r *RepositorySettingService) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryInheritanceStateScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug)
+}
+
+// Set updates the settings inheritance state of the repository.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/override-settings
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-override-settings-put
+func (r *RepositorySettingService) Set(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryInheritanceStateScheme) (*model.ResponseScheme, error) {
+	return r.internalClient.Set(ctx, workspace, repoSlug, payload)
+}
+
+type internalRepositorySettingServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalRepositorySettingServiceImpl) Gets(ctx context.Context, workspace, repoSlug string) (*model.RepositoryInheritanceStateScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/override-settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := new(model.RepositoryInheritanceStateScheme)
+	response, err := i.c.Call(request, state)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return state, response, nil
+}
+
+func (i *internalRepositorySettingServiceImpl) Set(ctx context.Context, workspace, repoSlug string, payload *model.RepositoryInheritanceStateScheme) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/override-settings", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
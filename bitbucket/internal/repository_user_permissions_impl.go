@@ -0,0 +1,220 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryUserPermissionService creates a new RepositoryUserPermissionService.
+func NewRepositoryUserPermissionService(client service.Connector) *RepositoryUserPermissionService {
+	return &RepositoryUserPermissionService{
+		internalClient: &internalRepositoryUserPermissionServiceImpl{c: client},
+	}
+}
+
+// RepositoryUserPermissionService handles communication with the repository user permission related methods of the Bitbucket API.
+type RepositoryUserPermissionService struct {
+	internalClient bitbucket.RepositoryUserPermissionConnector
+}
+
+// Gets returns a paginated list of the explicit user permissions of the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-users-get
+func (r *RepositoryUserPermissionService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Get returns the explicit permission of the user on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-users-selected-user-id-get
+func (r *RepositoryUserPermissionService) Get(ctx context.Context, workspace, repoSlug, userID string) (*model.RepositoryPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, userID)
+}
+
+// Update sets the explicit permission of the user on the repository, the permission can be read, write or admin.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-users-selected-user-id-put
+func (r *RepositoryUserPermissionService) Update(ctx context.Context, workspace, repoSlug, userID, permission string) (*model.RepositoryPermissionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, userID, permission)
+}
+
+// Delete removes the explicit permission of the user on the repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-permissions-config-users-selected-user-id-delete
+func (r *RepositoryUserPermissionService) Delete(ctx context.Context, workspace, repoSlug, userID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, userID)
+}
+
+// Check returns the permission the authenticated user has on the repository.
+//
+// GET /2.0/user/permissions/repositories
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-user-permissions-repositories-get
+func (r *RepositoryUserPermissionService) Check(ctx context.Context, workspace, repoSlug string) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Check(ctx, workspace, repoSlug)
+}
+
+type internalRepositoryUserPermissionServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalRepositoryUserPermissionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalRepositoryUserPermissionServiceImpl) Get(ctx context.Context, workspace, repoSlug, userID string) (*model.RepositoryPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if userID == "" {
+		return nil, nil, model.ErrNoUserID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, userID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(model.RepositoryPermissionScheme)
+	response, err := i.c.Call(request, permission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return permission, response, nil
+}
+
+func (i *internalRepositoryUserPermissionServiceImpl) Update(ctx context.Context, workspace, repoSlug, userID, permission string) (*model.RepositoryPermissionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if userID == "" {
+		return nil, nil, model.ErrNoUserID
+	}
+
+	if permission == "" {
+		return nil, nil, model.ErrNoPermissionLevel
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, userID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", map[string]interface{}{"permission": permission})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userPermission := new(model.RepositoryPermissionScheme)
+	response, err := i.c.Call(request, userPermission)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return userPermission, response, nil
+}
+
+func (i *internalRepositoryUserPermissionServiceImpl) Delete(ctx context.Context, workspace, repoSlug, userID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if userID == "" {
+		return nil, model.ErrNoUserID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/permissions-config/users/%v", workspace, repoSlug, userID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalRepositoryUserPermissionServiceImpl) Check(ctx context.Context, workspace, repoSlug string) (*model.RepositoryPermissionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	params := url.Values{}
+	params.Add("q", fmt.Sprintf("repository.full_name=\"%v/%v\"", workspace, repoSlug))
+
+	endpoint := fmt.Sprintf("2.0/user/permissions/repositories?%v", params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RepositoryPermissionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
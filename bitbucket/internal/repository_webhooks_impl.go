@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewRepositoryHookService creates a new RepositoryHookService.
+func NewRepositoryHookService(client service.Connector) *RepositoryHookService {
+	return &RepositoryHookService{
+		internalClient: &internalRepositoryHookServiceImpl{c: client},
+	}
+}
+
+// RepositoryHookService handles communication with the repository hook related methods of the Bitbucket API.
+type RepositoryHookService struct {
+	internalClient bitbucket.RepositoryWebhookConnector
+}
+
+// Gets returns a paginated list of webhooks installed on this repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/hooks
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-get
+func (r *RepositoryHookService) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.WebhookSubscriptionPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, opts)
+}
+
+// Get returns the webhook with the specified id installed on the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-uid-get
+func (r *RepositoryHookService) Get(ctx context.Context, workspace, repoSlug, webhookID string) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, webhookID)
+}
+
+// Create creates a new webhook on the specified repository.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/hooks
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-post
+func (r *RepositoryHookService) Create(ctx context.Context, workspace, repoSlug string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Update updates the specified webhook subscription.
+//
+// PUT /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-uid-put
+func (r *RepositoryHookService) Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Update(ctx, workspace, repoSlug, webhookID, payload)
+}
+
+// Delete deletes the specified webhook subscription from the given repository.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-uid-delete
+func (r *RepositoryHookService) Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, webhookID)
+}
+
+type internalRepositoryHookServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalRepositoryHookServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, opts *model.PageOptions) (*model.WebhookSubscriptionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/hooks", workspace, repoSlug), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.WebhookSubscriptionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalRepositoryHookServiceImpl) Get(ctx context.Context, workspace, repoSlug, webhookID string) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if webhookID == "" {
+		return nil, nil, model.ErrNoWebhookID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+func (i *internalRepositoryHookServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+func (i *internalRepositoryHookServiceImpl) Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *model.WebhookSubscriptionPayloadScheme) (*model.WebhookSubscriptionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if webhookID == "" {
+		return nil, nil, model.ErrNoWebhookID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(model.WebhookSubscriptionScheme)
+	response, err := i.c.Call(request, webhook)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhook, response, nil
+}
+
+func (i *internalRepositoryHookServiceImpl) Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if webhookID == "" {
+		return nil, model.ErrNoWebhookID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/hooks/%v", workspace, repoSlug, webhookID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
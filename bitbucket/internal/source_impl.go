@@ -0,0 +1,254 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewSourceService creates a new SourceService.
+func NewSourceService(client service.Connector) *SourceService {
+	return &SourceService{
+		internalClient: &internalSourceServiceImpl{c: client},
+	}
+}
+
+// SourceService handles communication with the source related methods of the Bitbucket API.
+type SourceService struct {
+	internalClient bitbucket.SourceConnector
+}
+
+// Gets returns a paginated listing of the directory at the given commit, branch or tag, the root directory is listed when path is empty.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (s *SourceService) Gets(ctx context.Context, workspace, repoSlug, commit, path string, opts *model.PageOptions) (*model.SourcePageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Gets(ctx, workspace, repoSlug, commit, path, opts)
+}
+
+// Get returns the raw content of the file at the given commit, branch or tag.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (s *SourceService) Get(ctx context.Context, workspace, repoSlug, commit, path string) ([]byte, *model.ResponseScheme, error) {
+	return s.internalClient.Get(ctx, workspace, repoSlug, commit, path)
+}
+
+// Metadata returns the metadata of the file or directory at the given commit, branch or tag.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (s *SourceService) Metadata(ctx context.Context, workspace, repoSlug, commit, path string) (*model.SourceScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Metadata(ctx, workspace, repoSlug, commit, path)
+}
+
+// Commit creates a new commit adding, updating and deleting the files of the payload.
+//
+// The files are posted as multipart/form-data, the new commit is referenced by the Location header of the response.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/src
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (s *SourceService) Commit(ctx context.Context, workspace, repoSlug string, payload *model.SourceCommitPayloadScheme) (*model.ResponseScheme, error) {
+	return s.internalClient.Commit(ctx, workspace, repoSlug, payload)
+}
+
+type internalSourceServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalSourceServiceImpl) Gets(ctx context.Context, workspace, repoSlug, commit, path string, opts *model.PageOptions) (*model.SourcePageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if commit == "" {
+		return nil, nil, model.ErrNoCommit
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v", workspace, repoSlug, url.PathEscape(commit), escapePath(path)), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.SourcePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalSourceServiceImpl) Get(ctx context.Context, workspace, repoSlug, commit, path string) ([]byte, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if commit == "" {
+		return nil, nil, model.ErrNoCommit
+	}
+
+	if path == "" {
+		return nil, nil, model.ErrNoSourcePath
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v", workspace, repoSlug, url.PathEscape(commit), escapePath(path))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The file is returned as is, it is read from the response instead of being decoded.
+	response, err := i.c.Call(request, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return response.Bytes.Bytes(), response, nil
+}
+
+func (i *internalSourceServiceImpl) Metadata(ctx context.Context, workspace, repoSlug, commit, path string) (*model.SourceScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if commit == "" {
+		return nil, nil, model.ErrNoCommit
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/src/%v/%v?format=meta", workspace, repoSlug, url.PathEscape(commit), escapePath(path))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := new(model.SourceScheme)
+	response, err := i.c.Call(request, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
+func (i *internalSourceServiceImpl) Commit(ctx context.Context, workspace, repoSlug string, payload *model.SourceCommitPayloadScheme) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if payload == nil {
+		return nil, model.ErrNoSourcePayload
+	}
+
+	if len(payload.Files) == 0 && len(payload.Deletes) == 0 {
+		return nil, model.ErrNoSourceFiles
+	}
+
+	reader := &bytes.Buffer{}
+	writer := multipart.NewWriter(reader)
+
+	fields := [][2]string{{"message", payload.Message}, {"author", payload.Author}, {"branch", payload.Branch}}
+
+	for _, parent := range payload.Parents {
+		fields = append(fields, [2]string{"parents", parent})
+	}
+
+	// The deleted files are listed in the files field, without content.
+	for _, deleted := range payload.Deletes {
+		fields = append(fields, [2]string{"files", deleted})
+	}
+
+	for _, field := range fields {
+
+		if field[1] == "" {
+			continue
+		}
+
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	// The added and updated files are sent as form files named after their path.
+	for _, file := range payload.Files {
+
+		if file.Path == "" || file.Content == nil {
+			return nil, model.ErrNoSourceFiles
+		}
+
+		part, err := writer.CreateFormFile(file.Path, path.Base(file.Path))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = io.Copy(part, file.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/src", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, writer.FormDataContentType(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// escapePath escapes each segment of the path of a file, the separators between the directories are kept.
+func escapePath(filePath string) string {
+
+	segments := strings.Split(strings.TrimPrefix(filePath, "/"), "/")
+	for index, segment := range segments {
+		segments[index] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/utils"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewTagService creates a new TagService.
+func NewTagService(client service.Connector) *TagService {
+	return &TagService{
+		internalClient: &internalTagServiceImpl{c: client},
+	}
+}
+
+// TagService handles communication with the tag related methods of the Bitbucket API.
+type TagService struct {
+	internalClient bitbucket.TagConnector
+}
+
+// Gets returns a paginated list of the tags of the repository, ordered by sort, e.g. -target.date.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-get
+func (r *TagService) Gets(ctx context.Context, workspace, repoSlug, sort string, opts *model.PageOptions) (*model.RefPageScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, workspace, repoSlug, sort, opts)
+}
+
+// Get returns the specified tag.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-name-get
+func (r *TagService) Get(ctx context.Context, workspace, repoSlug, name string) (*model.RefScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Get(ctx, workspace, repoSlug, name)
+}
+
+// Create creates a new tag pointing to the target of the payload, the tag is annotated when the payload has a message.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-post
+func (r *TagService) Create(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.RefScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Delete deletes the specified tag.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-tags-name-delete
+func (r *TagService) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+	return r.internalClient.Delete(ctx, workspace, repoSlug, name)
+}
+
+type internalTagServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalTagServiceImpl) Gets(ctx context.Context, workspace, repoSlug, sort string, opts *model.PageOptions) (*model.RefPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	query := ""
+	if sort != "" {
+
+		params := url.Values{}
+		params.Add("sort", sort)
+
+		query = "?" + params.Encode()
+	}
+
+	endpoint, err := utils.AddPaginationParams(fmt.Sprintf("2.0/repositories/%v/%v/refs/tags%v", workspace, repoSlug, query), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.RefPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalTagServiceImpl) Get(ctx context.Context, workspace, repoSlug, name string) (*model.RefScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, nil, model.ErrNoRefName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(model.RefScheme)
+	response, err := i.c.Call(request, tag)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tag, response, nil
+}
+
+func (i *internalTagServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.RefPayloadScheme) (*model.RefScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(model.RefScheme)
+	response, err := i.c.Call(request, tag)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tag, response, nil
+}
+
+func (i *internalTagServiceImpl) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, model.ErrNoRefName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/tags/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -0,0 +1,82 @@
+// Package webhook verifies and decodes the webhooks sent by Bitbucket Cloud and dispatches them to typed callbacks.
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The webhook event keys decoded into typed events, as sent in the X-Event-Key header.
+const (
+	RepoPush                    = "repo:push"
+	RepoCommitStatusCreated     = "repo:commit_status_created"
+	RepoCommitStatusUpdated     = "repo:commit_status_updated"
+	PullRequestCreated          = "pullrequest:created"
+	PullRequestUpdated          = "pullrequest:updated"
+	PullRequestApproved         = "pullrequest:approved"
+	PullRequestUnapproved       = "pullrequest:unapproved"
+	PullRequestChangesRequested = "pullrequest:changes_request_created"
+	PullRequestFulfilled        = "pullrequest:fulfilled"
+	PullRequestRejected         = "pullrequest:rejected"
+)
+
+var (
+	// PullRequestEvents lists the event keys decoded as a PullRequestEvent.
+	PullRequestEvents = []string{
+		PullRequestCreated, PullRequestUpdated, PullRequestApproved, PullRequestUnapproved,
+		PullRequestChangesRequested, PullRequestFulfilled, PullRequestRejected,
+	}
+	// CommitStatusEvents lists the event keys decoded as a CommitStatusEvent.
+	CommitStatusEvents = []string{RepoCommitStatusCreated, RepoCommitStatusUpdated}
+)
+
+// Event is implemented by every decoded webhook event.
+type Event interface {
+	// Key returns the event key, e.g. repo:push.
+	Key() string
+}
+
+// Header holds the delivery attributes shared by every webhook.
+type Header struct {
+	EventKey    string          `json:"-"` // The event key, from the X-Event-Key header.
+	RequestUUID string          `json:"-"` // The unique identifier of the delivery, from the X-Request-UUID header.
+	HookUUID    string          `json:"-"` // The unique identifier of the webhook subscription, from the X-Hook-UUID header.
+	Raw         json.RawMessage `json:"-"` // The payload as sent by Bitbucket.
+}
+
+// Key returns the event key.
+func (h *Header) Key() string {
+	return h.EventKey
+}
+
+// PushEvent is sent when commits, branches or tags are pushed to a repository.
+type PushEvent struct {
+	Header
+	Actor      *models.BitbucketAccountScheme `json:"actor,omitempty"`      // The user who pushed.
+	Repository *models.RepositoryScheme       `json:"repository,omitempty"` // The repository.
+	Push       *models.RepositoryPushScheme   `json:"push,omitempty"`       // The changes of the push.
+}
+
+// PullRequestEvent is sent when a pull request is created, updated, approved, unapproved, merged or declined,
+// or when changes are requested on it.
+type PullRequestEvent struct {
+	Header
+	Actor       *models.BitbucketAccountScheme    `json:"actor,omitempty"`       // The user who triggered the event.
+	Repository  *models.RepositoryScheme          `json:"repository,omitempty"`  // The repository.
+	PullRequest *models.PullRequestScheme         `json:"pullrequest,omitempty"` // The pull request.
+	Approval    *models.PullRequestApprovalScheme `json:"approval,omitempty"`    // The approval, only set on pullrequest:approved and pullrequest:unapproved.
+}
+
+// CommitStatusEvent is sent when the build status of a commit is created or updated.
+type CommitStatusEvent struct {
+	Header
+	Actor        *models.BitbucketAccountScheme `json:"actor,omitempty"`         // The user who reported the status.
+	Repository   *models.RepositoryScheme       `json:"repository,omitempty"`    // The repository.
+	CommitStatus *models.CommitStatusScheme     `json:"commit_status,omitempty"` // The status.
+}
+
+// UnknownEvent is returned for the events without a typed representation, the payload is available through Header.Raw.
+type UnknownEvent struct {
+	Header
+}
@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/internal/dispatch"
+)
+
+// DefaultMaxBodyBytes is the maximum size of the payloads accepted by the Handler when MaxBodyBytes is not set.
+const DefaultMaxBodyBytes = dispatch.DefaultMaxBodyBytes
+
+// Callback is called with the decoded event, an error makes the Handler reply with a 500 so Bitbucket retries the delivery.
+type Callback func(ctx context.Context, event Event) error
+
+// Handler is an http.Handler verifying the Bitbucket webhooks and dispatching them to the registered callbacks.
+//
+// When the handler has a secret, the deliveries without a valid X-Hub-Signature are rejected before being decoded.
+// The callbacks of an event are called sequentially, in registration order, and the first error stops the dispatch.
+// The handler replies with:
+//
+//  1. 200 once the callbacks succeeded, or when no callback is registered for the event.
+//  2. 400 when the X-Event-Key header is missing or the payload cannot be decoded.
+//  3. 401 when the signature is missing or invalid.
+//  4. 405 when the request is not a POST.
+//  5. 413 when the payload is larger than MaxBodyBytes.
+//  6. 500 when a callback fails, the error is logged and not sent back to Bitbucket.
+type Handler struct {
+	// MaxBodyBytes limits the size of the payloads, DefaultMaxBodyBytes is used when zero.
+	MaxBodyBytes int64
+	// Logger receives the errors of the callbacks, they are discarded when nil.
+	Logger *slog.Logger
+
+	secret     string
+	dispatcher dispatch.Dispatcher[Event]
+}
+
+// NewHandler creates a Handler verifying the deliveries with the secret of the webhook subscription.
+// An empty secret disables the verification, which should only be the case for subscriptions without a secret.
+func NewHandler(secret string) *Handler {
+	return &Handler{secret: secret}
+}
+
+// On registers a callback for the events with the given keys.
+func (h *Handler) On(callback Callback, keys ...string) {
+	h.dispatcher.On(callback, keys...)
+}
+
+// OnAny registers a callback for every event, including the events without a typed representation.
+func (h *Handler) OnAny(callback Callback) {
+	h.dispatcher.OnAny(callback)
+}
+
+// OnPush registers a callback for the repo:push events.
+func (h *Handler) OnPush(callback func(ctx context.Context, event *PushEvent) error) {
+	h.On(dispatch.Typed[Event](callback), RepoPush)
+}
+
+// OnPullRequest registers a callback for the given pull request events, or for all of them when no key is provided.
+func (h *Handler) OnPullRequest(callback func(ctx context.Context, event *PullRequestEvent) error, keys ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(keys, PullRequestEvents)...)
+}
+
+// OnCommitStatus registers a callback for the given commit status events, or for all of them when no key is provided.
+func (h *Handler) OnCommitStatus(callback func(ctx context.Context, event *CommitStatusEvent) error, keys ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(keys, CommitStatusEvents)...)
+}
+
+// ServeHTTP verifies and decodes the webhook, then dispatches it to the callbacks registered for its event key.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	dispatch.Serve(w, r, h.MaxBodyBytes, h.Logger, h.decode, h.Dispatch)
+}
+
+// decode verifies the signature of the delivery when the handler has a secret, then decodes its event.
+func (h *Handler) decode(r *http.Request, payload []byte) (Event, error) {
+
+	if h.secret != "" {
+		if err := VerifySignature(h.secret, r.Header.Get("X-Hub-Signature"), payload); err != nil {
+			return nil, err
+		}
+	}
+
+	event, err := Parse(r.Header.Get("X-Event-Key"), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	setDelivery(event, r.Header.Get("X-Request-UUID"), r.Header.Get("X-Hook-UUID"))
+
+	return event, nil
+}
+
+// Dispatch calls the callbacks registered for the event, followed by the callbacks registered with OnAny.
+func (h *Handler) Dispatch(ctx context.Context, event Event) error {
+	return h.dispatcher.Dispatch(ctx, event.Key(), event)
+}
+
+// setDelivery fills the delivery attributes of the event header.
+func setDelivery(event Event, requestUUID, hookUUID string) {
+
+	var header *Header
+	switch typed := event.(type) {
+	case *PushEvent:
+		header = &typed.Header
+	case *PullRequestEvent:
+		header = &typed.Header
+	case *CommitStatusEvent:
+		header = &typed.Header
+	case *UnknownEvent:
+		header = &typed.Header
+	default:
+		return
+	}
+
+	header.RequestUUID, header.HookUUID = requestUUID, hookUUID
+}
@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		method     string
+		secret     string
+		eventKey   string
+		signature  string
+		body       string
+		register   func(*Handler, *[]string)
+		maxBytes   int64
+		wantStatus int
+		wantCalls  []string
+	}{
+		{
+			name:      "when the event is dispatched to the typed callbacks",
+			method:    http.MethodPost,
+			secret:    "secret",
+			eventKey:  PullRequestApproved,
+			signature: Sign("secret", []byte(pullRequestPayload)),
+			body:      pullRequestPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnPullRequest(func(_ context.Context, event *PullRequestEvent) error {
+					*calls = append(*calls, "approved:"+event.PullRequest.Title+":"+event.RequestUUID+":"+event.HookUUID)
+					return nil
+				}, PullRequestApproved)
+
+				handler.OnPullRequest(func(_ context.Context, event *PullRequestEvent) error {
+					*calls = append(*calls, "merged")
+					return nil
+				}, PullRequestFulfilled)
+
+				handler.OnPush(func(_ context.Context, event *PushEvent) error {
+					*calls = append(*calls, "push")
+					return nil
+				})
+
+				handler.OnAny(func(_ context.Context, event Event) error {
+					*calls = append(*calls, "any:"+event.Key())
+					return nil
+				})
+			},
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"approved:Add the webhook receiver:request-uuid:hook-uuid", "any:pullrequest:approved"},
+		},
+		{
+			name:     "when the typed callback is registered for every event of its kind",
+			method:   http.MethodPost,
+			eventKey: RepoCommitStatusCreated,
+			body:     commitStatusPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnCommitStatus(func(_ context.Context, event *CommitStatusEvent) error {
+					*calls = append(*calls, "status:"+event.CommitStatus.State)
+					return nil
+				})
+			},
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"status:SUCCESSFUL"},
+		},
+		{
+			name:       "when no callback is registered for the event",
+			method:     http.MethodPost,
+			eventKey:   "repo:fork",
+			body:       `{"fork": {}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:     "when a callback fails",
+			method:   http.MethodPost,
+			eventKey: RepoPush,
+			body:     pushPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnPush(func(_ context.Context, event *PushEvent) error {
+					*calls = append(*calls, "failing")
+					return errors.New("unable to trigger the build")
+				})
+
+				handler.OnPush(func(_ context.Context, event *PushEvent) error {
+					*calls = append(*calls, "skipped")
+					return nil
+				})
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  []string{"failing"},
+		},
+		{
+			name:      "when the signature is invalid",
+			method:    http.MethodPost,
+			secret:    "secret",
+			eventKey:  RepoPush,
+			signature: Sign("another-secret", []byte(pushPayload)),
+			body:      pushPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnPush(func(_ context.Context, event *PushEvent) error {
+					*calls = append(*calls, "push")
+					return nil
+				})
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "when the signature is not provided",
+			method:     http.MethodPost,
+			secret:     "secret",
+			eventKey:   RepoPush,
+			body:       pushPayload,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "when the event key is not provided",
+			method:     http.MethodPost,
+			body:       pushPayload,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "when the payload cannot be decoded",
+			method:     http.MethodPost,
+			eventKey:   RepoPush,
+			body:       `{"push": [`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "when the payload is too large",
+			method:     http.MethodPost,
+			eventKey:   RepoPush,
+			body:       pushPayload,
+			maxBytes:   64,
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "when the method is not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var calls []string
+
+			handler := NewHandler(testCase.secret)
+			handler.MaxBodyBytes = testCase.maxBytes
+
+			if testCase.register != nil {
+				testCase.register(handler, &calls)
+			}
+
+			request := httptest.NewRequest(testCase.method, "/webhooks/bitbucket", strings.NewReader(testCase.body))
+			request.Header.Set("X-Event-Key", testCase.eventKey)
+			request.Header.Set("X-Request-UUID", "request-uuid")
+			request.Header.Set("X-Hook-UUID", "hook-uuid")
+
+			if testCase.signature != "" {
+				request.Header.Set("X-Hub-Signature", testCase.signature)
+			}
+
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.wantStatus, recorder.Code)
+			assert.Equal(t, testCase.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// signaturePrefix is the algorithm prefix of the X-Hub-Signature header.
+const signaturePrefix = "sha256="
+
+// Parse decodes the payload of the event identified by eventKey, the value of the X-Event-Key header.
+//
+// The events without a typed representation are returned as an *UnknownEvent.
+func Parse(eventKey string, payload []byte) (Event, error) {
+
+	if eventKey == "" {
+		return nil, models.ErrNoWebhookEventKey
+	}
+
+	header := Header{EventKey: eventKey, Raw: append(json.RawMessage(nil), payload...)}
+
+	var event Event
+	switch {
+	case eventKey == RepoPush:
+		event = &PushEvent{Header: header}
+	case slices.Contains(PullRequestEvents, eventKey):
+		event = &PullRequestEvent{Header: header}
+	case slices.Contains(CommitStatusEvents, eventKey):
+		event = &CommitStatusEvent{Header: header}
+	default:
+		var raw json.RawMessage
+		if err := json.Unmarshal(payload, &raw); err != nil {
+			return nil, err
+		}
+
+		return &UnknownEvent{Header: header}, nil
+	}
+
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// Sign returns the X-Hub-Signature value of the payload, the HMAC-SHA256 of the payload keyed with the secret.
+func Sign(secret string, payload []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the X-Hub-Signature value of the payload in constant time.
+func VerifySignature(secret, signature string, payload []byte) error {
+
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return models.ErrInvalidWebhookSignature
+	}
+
+	if !hmac.Equal([]byte(Sign(secret, payload)), []byte(strings.ToLower(signature))) {
+		return models.ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
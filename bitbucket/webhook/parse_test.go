@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const pushPayload = `{
+	"actor": {"display_name": "Carlos Treminio", "uuid": "{a1b2}"},
+	"repository": {"full_name": "work-space/repository", "name": "repository"},
+	"push": {
+		"changes": [
+			{
+				"new": {"type": "branch", "name": "main", "target": {"hash": "f1e2d3"}},
+				"old": {"type": "branch", "name": "main", "target": {"hash": "a1b2c3"}},
+				"created": false,
+				"forced": false,
+				"commits": [{"hash": "f1e2d3", "message": "fix the build"}]
+			}
+		]
+	}
+}`
+
+const pullRequestPayload = `{
+	"actor": {"display_name": "Carlos Treminio"},
+	"repository": {"full_name": "work-space/repository"},
+	"pullrequest": {"id": 42, "title": "Add the webhook receiver", "state": "OPEN"},
+	"approval": {"date": "2024-03-01T10:00:00+00:00", "user": {"display_name": "Carlos Treminio"}}
+}`
+
+const commitStatusPayload = `{
+	"repository": {"full_name": "work-space/repository"},
+	"commit_status": {"key": "build", "state": "SUCCESSFUL", "refname": "main", "url": "https://ci.example.com/1"}
+}`
+
+func TestParse(t *testing.T) {
+
+	t.Run("when the event is a push", func(t *testing.T) {
+
+		event, err := Parse(RepoPush, []byte(pushPayload))
+		assert.NoError(t, err)
+
+		push, ok := event.(*PushEvent)
+		assert.True(t, ok)
+		assert.Equal(t, RepoPush, push.Key())
+		assert.Equal(t, "Carlos Treminio", push.Actor.DisplayName)
+		assert.Equal(t, "work-space/repository", push.Repository.FullName)
+		assert.Len(t, push.Push.Changes, 1)
+		assert.Equal(t, "main", push.Push.Changes[0].New.Name)
+		assert.Equal(t, "f1e2d3", push.Push.Changes[0].New.Target.Hash)
+		assert.Equal(t, "a1b2c3", push.Push.Changes[0].Old.Target.Hash)
+		assert.Equal(t, "fix the build", push.Push.Changes[0].Commits[0].Message)
+		assert.JSONEq(t, pushPayload, string(push.Raw))
+	})
+
+	t.Run("when the event is a pull request approval", func(t *testing.T) {
+
+		event, err := Parse(PullRequestApproved, []byte(pullRequestPayload))
+		assert.NoError(t, err)
+
+		pullRequest, ok := event.(*PullRequestEvent)
+		assert.True(t, ok)
+		assert.Equal(t, PullRequestApproved, pullRequest.Key())
+		assert.Equal(t, 42, pullRequest.PullRequest.ID)
+		assert.Equal(t, "Carlos Treminio", pullRequest.Approval.User.DisplayName)
+	})
+
+	t.Run("when the event is a commit status", func(t *testing.T) {
+
+		event, err := Parse(RepoCommitStatusUpdated, []byte(commitStatusPayload))
+		assert.NoError(t, err)
+
+		status, ok := event.(*CommitStatusEvent)
+		assert.True(t, ok)
+		assert.Equal(t, "SUCCESSFUL", status.CommitStatus.State)
+		assert.Equal(t, "main", status.CommitStatus.RefName)
+	})
+
+	t.Run("when the event has no typed representation", func(t *testing.T) {
+
+		event, err := Parse("repo:fork", []byte(`{"fork": {"full_name": "work-space/fork"}}`))
+		assert.NoError(t, err)
+
+		unknown, ok := event.(*UnknownEvent)
+		assert.True(t, ok)
+		assert.Equal(t, "repo:fork", unknown.Key())
+		assert.JSONEq(t, `{"fork": {"full_name": "work-space/fork"}}`, string(unknown.Raw))
+	})
+
+	t.Run("when the event key is not provided", func(t *testing.T) {
+
+		_, err := Parse("", []byte(pushPayload))
+		assert.ErrorIs(t, err, models.ErrNoWebhookEventKey)
+	})
+
+	t.Run("when the payload is not valid json", func(t *testing.T) {
+
+		var syntaxErr *json.SyntaxError
+
+		_, err := Parse(RepoPush, []byte(`{`))
+		assert.Error(t, err)
+
+		_, err = Parse("repo:fork", []byte(`{"fork":`))
+		assert.ErrorAs(t, err, &syntaxErr)
+	})
+}
+
+func TestVerifySignature(t *testing.T) {
+
+	payload := []byte(`{"push": {}}`)
+
+	testCases := []struct {
+		name      string
+		signature string
+		wantErr   bool
+	}{
+		{
+			name:      "when the signature matches",
+			signature: Sign("secret", payload),
+		},
+
+		{
+			name:      "when the signature is upper case",
+			signature: "sha256=" + upper(Sign("secret", payload)[len("sha256="):]),
+		},
+
+		{
+			name:      "when the signature is computed with another secret",
+			signature: Sign("another-secret", payload),
+			wantErr:   true,
+		},
+
+		{
+			name:      "when the signature has no algorithm prefix",
+			signature: Sign("secret", payload)[len("sha256="):],
+			wantErr:   true,
+		},
+
+		{
+			name:    "when the signature is not provided",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := VerifySignature("secret", testCase.signature, payload)
+
+			if testCase.wantErr {
+				assert.ErrorIs(t, err, models.ErrInvalidWebhookSignature)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 of "payload" keyed with "secret".
+	assert.Equal(t, "sha256=b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4", Sign("secret", []byte("payload")))
+}
+
+func upper(value string) string {
+
+	out := []byte(value)
+	for index, char := range out {
+		if char >= 'a' && char <= 'z' {
+			out[index] = char - 'a' + 'A'
+		}
+	}
+
+	return string(out)
+}
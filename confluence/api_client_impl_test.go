@@ -9,10 +9,13 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ctreminiom/go-atlassian/v2/confluence/internal"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/connect"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
@@ -551,3 +554,56 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Call_RequestSigner(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+
+	client, err := New(httpClient, "https://your-domain.atlassian.net")
+	assert.NoError(t, err)
+
+	client.RetryPolicy = &common.DefaultRetryPolicy{MaxRetries: 1, InitialDelay: time.Microsecond, MaxDelay: time.Millisecond}
+
+	signer, err := connect.NewSigner("app-key", "shared-secret", "https://your-domain.atlassian.net/wiki")
+	assert.NoError(t, err)
+
+	// Every signature is issued a minute after the previous one, the retry carries a token of its own.
+	issuedAt := time.Unix(1700000000, 0)
+	signer.Now = func() time.Time {
+		issuedAt = issuedAt.Add(time.Minute)
+		return issuedAt
+	}
+
+	client.Auth.SetBasicAuth("mail", "token")
+	client.Auth.(common.RequestSignerAuthentication).SetRequestSigner(signer)
+
+	request, err := client.NewRequest(context.Background(), http.MethodGet, "wiki/rest/api/space?limit=10", "", nil)
+	assert.NoError(t, err)
+
+	token := func(at time.Time) string {
+		signer := *signer
+		signer.Now = func() time.Time { return at }
+
+		token, err := signer.Token(http.MethodGet, request.URL)
+		assert.NoError(t, err)
+
+		return "JWT " + token
+	}
+
+	first, second := token(time.Unix(1700000060, 0)), token(time.Unix(1700000120, 0))
+
+	httpClient.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("Authorization") == first
+	})).
+		Return(&http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("")), Request: request}, nil).
+		Once()
+
+	httpClient.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("Authorization") == second
+	})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Request: request}, nil).
+		Once()
+
+	_, err = client.Call(request, nil)
+	assert.NoError(t, err)
+}
@@ -5,10 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ctreminiom/go-atlassian/v2/confluence/internal"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -35,8 +36,11 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 	}
 
 	client := &Client{
-		HTTP: httpClient,
-		Site: u,
+		HTTP:              httpClient,
+		Site:              u,
+		MaxRetries:        5,
+		InitialRetryDelay: time.Duration(1) * time.Minute,
+		MaxRetryDelay:     time.Duration(10) * time.Minute,
 	}
 
 	client.Auth = internal.NewAuthenticationService(client)
@@ -49,13 +53,21 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 }
 
 type Client struct {
-	HTTP          common.HTTPClient
-	Site          *url.URL
-	Auth          common.Authentication
-	Page          *internal.PageService
-	Space         *internal.SpaceV2Service
-	Attachment    *internal.AttachmentService
-	CustomContent *internal.CustomContentService
+	HTTP              common.HTTPClient
+	Site              *url.URL
+	MaxRetries        int
+	InitialRetryDelay time.Duration
+	MaxRetryDelay     time.Duration
+	RetryPolicy       common.RetryPolicy
+	RateLimiter       *common.RateLimiter
+	Instrumentation   common.Instrumentation
+	Logger            *slog.Logger
+	Debug             bool
+	Auth              common.Authentication
+	Page              *internal.PageService
+	Space             *internal.SpaceV2Service
+	Attachment        *internal.AttachmentService
+	CustomContent     *internal.CustomContentService
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error) {
@@ -65,7 +77,13 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		return nil, err
 	}
 
-	u := c.Site.ResolveReference(rel)
+	// The requests authorized by an OAuth 2.0 token source are routed through the API gateway.
+	site, err := common.ResolveSite(ctx, c.Auth, c.Site, "confluence")
+	if err != nil {
+		return nil, err
+	}
+
+	u := site.ResolveReference(rel)
 
 	buf := new(bytes.Buffer)
 	if body != nil {
@@ -112,9 +130,23 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 	return req, nil
 }
 
+// Call executes an API request through the shared transport and returns the response.
 func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
 
-	response, err := c.HTTP.Do(request)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
+	}
+
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "confluence"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
+
+	response, err := transport.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -123,51 +155,5 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
-
-	defer response.Body.Close()
-
-	res := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
-
-	responseAsBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, models.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, models.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, models.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, models.ErrBadRequest
-
-		default:
-			return res, models.ErrInvalidStatusCode
-		}
-	}
-
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
-
-	return res, nil
+	return common.ProcessResponse(response, structure)
 }
@@ -0,0 +1,148 @@
+// Package dispatch holds the webhook receiver shared by the Jira and Bitbucket webhook handlers:
+// the reading of the deliveries and the dispatch of their events to the registered callbacks.
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DefaultMaxBodyBytes is the maximum size of the payloads when the handler does not set one.
+const DefaultMaxBodyBytes = 10 << 20
+
+// Dispatcher calls the callbacks registered for the name of an event, it is safe for concurrent use.
+type Dispatcher[E any] struct {
+	mu        sync.RWMutex
+	callbacks map[string][]func(ctx context.Context, event E) error
+	any       []func(ctx context.Context, event E) error
+}
+
+// On registers a callback for the events with the given names.
+func (d *Dispatcher[E]) On(callback func(ctx context.Context, event E) error, names ...string) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.callbacks == nil {
+		d.callbacks = make(map[string][]func(ctx context.Context, event E) error)
+	}
+
+	for _, name := range names {
+		d.callbacks[name] = append(d.callbacks[name], callback)
+	}
+}
+
+// OnAny registers a callback for every event.
+func (d *Dispatcher[E]) OnAny(callback func(ctx context.Context, event E) error) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.any = append(d.any, callback)
+}
+
+// Dispatch calls the callbacks registered for the name, followed by the callbacks registered with OnAny.
+// The callbacks are called sequentially, in registration order, and the first error stops the dispatch.
+func (d *Dispatcher[E]) Dispatch(ctx context.Context, name string, event E) error {
+
+	d.mu.RLock()
+	callbacks := append(append([]func(ctx context.Context, event E) error(nil), d.callbacks[name]...), d.any...)
+	d.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		if err := callback(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Typed adapts a callback receiving a concrete event, the events of another type are ignored.
+func Typed[E any, T any](callback func(ctx context.Context, event T) error) func(ctx context.Context, event E) error {
+
+	return func(ctx context.Context, event E) error {
+
+		concrete, ok := any(event).(T)
+		if !ok {
+			return nil
+		}
+
+		return callback(ctx, concrete)
+	}
+}
+
+// Names returns the names, or the defaults when no name is provided.
+func Names(names, defaults []string) []string {
+
+	if len(names) == 0 {
+		return defaults
+	}
+
+	return names
+}
+
+// Serve reads the payload of the delivery, decodes it and dispatches the event. It replies with:
+//
+//  1. 200 once the event is dispatched.
+//  2. 400 when the payload cannot be decoded.
+//  3. 401 when the decoding fails with models.ErrInvalidWebhookSignature.
+//  4. 405 when the request is not a POST.
+//  5. 413 when the payload is larger than maxBodyBytes, DefaultMaxBodyBytes when zero.
+//  6. 500 when the dispatch fails, the error is sent to the logger instead of the sender, it is discarded when nil.
+func Serve[E any](w http.ResponseWriter, r *http.Request, maxBodyBytes int64, logger *slog.Logger, decode func(r *http.Request, payload []byte) (E, error),
+	dispatch func(ctx context.Context, event E) error) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = DefaultMaxBodyBytes
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := decode(r, payload)
+	if err != nil {
+
+		if errors.Is(err, models.ErrInvalidWebhookSignature) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = dispatch(r.Context(), event); err != nil {
+
+		if logger != nil {
+			logger.ErrorContext(r.Context(), "webhook: the callback failed", slog.String("error", err.Error()))
+		}
+
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
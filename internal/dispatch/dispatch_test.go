@@ -0,0 +1,97 @@
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type created struct{ id int }
+
+func TestDispatcher_Dispatch(t *testing.T) {
+
+	var (
+		dispatcher Dispatcher[interface{}]
+		calls      []string
+	)
+
+	dispatcher.On(Typed[interface{}](func(_ context.Context, event *created) error {
+		calls = append(calls, fmt.Sprintf("created:%v", event.id))
+		return nil
+	}), Names(nil, []string{"created", "imported"})...)
+
+	dispatcher.On(Typed[interface{}](func(_ context.Context, event string) error {
+		calls = append(calls, "string:"+event)
+		return nil
+	}), "created")
+
+	dispatcher.OnAny(func(_ context.Context, event interface{}) error {
+		calls = append(calls, "any")
+		return errors.New("unable to store the event")
+	})
+
+	dispatcher.OnAny(func(_ context.Context, event interface{}) error {
+		calls = append(calls, "skipped")
+		return nil
+	})
+
+	// The callbacks of another event type are skipped, the first error stops the dispatch.
+	err := dispatcher.Dispatch(context.Background(), "created", &created{id: 1})
+	assert.EqualError(t, err, "unable to store the event")
+	assert.Equal(t, []string{"created:1", "any"}, calls)
+}
+
+func TestServe(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		method     string
+		body       string
+		decodeErr  error
+		dispatched error
+		wantStatus int
+	}{
+		{name: "when the event is dispatched", method: http.MethodPost, body: "{}", wantStatus: http.StatusOK},
+		{name: "when the request is not a POST", method: http.MethodGet, wantStatus: http.StatusMethodNotAllowed},
+		{name: "when the payload is too large", method: http.MethodPost, body: strings.Repeat("x", 11), wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "when the payload cannot be decoded", method: http.MethodPost, decodeErr: errors.New("invalid payload"), wantStatus: http.StatusBadRequest},
+		{name: "when the signature is invalid", method: http.MethodPost, decodeErr: models.ErrInvalidWebhookSignature, wantStatus: http.StatusUnauthorized},
+		{name: "when the dispatch fails", method: http.MethodPost, dispatched: errors.New("unable to store the event"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			decode := func(_ *http.Request, payload []byte) (string, error) {
+				return string(payload), testCase.decodeErr
+			}
+
+			dispatch := func(_ context.Context, _ string) error {
+				return testCase.dispatched
+			}
+
+			var logs bytes.Buffer
+			recorder := httptest.NewRecorder()
+			Serve(recorder, httptest.NewRequest(testCase.method, "/webhook", strings.NewReader(testCase.body)), 10,
+				slog.New(slog.NewTextHandler(&logs, nil)), decode, dispatch)
+
+			assert.Equal(t, testCase.wantStatus, recorder.Code)
+
+			// The errors of the callbacks are logged, the sender only gets the status.
+			if testCase.dispatched != nil {
+				assert.Equal(t, "Internal Server Error\n", recorder.Body.String())
+				assert.Contains(t, logs.String(), testCase.dispatched.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+)
+
+type commentPayload struct {
+	Body       interface{} `json:"body"`
+	Visibility interface{} `json:"visibility"`
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+//
+// The comments are ordered by creation, the -created order returns the newest first.
+func (s *Server) listComments(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	comments := slices.Clone(i.Comments)
+	if r.param("orderBy") == "-created" {
+		slices.Reverse(comments)
+	}
+
+	startAt, maxResults, end := r.page(len(comments), 5000)
+
+	rendered := []object{}
+	for _, c := range slice(comments, startAt, end) {
+		rendered = append(rendered, r.renderComment(c))
+	}
+
+	r.json(http.StatusOK, object{"startAt": startAt, "maxResults": maxResults, "total": len(comments), "comments": rendered})
+}
+
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/comment
+func (s *Server) addComment(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	payload := new(commentPayload)
+	if !r.decode(payload) || !r.validComment(payload) {
+		return
+	}
+
+	now := s.Now()
+
+	c := &comment{ID: s.newID(), Author: r.user.AccountID, Body: payload.Body, Visibility: payload.Visibility, Created: now, Updated: now}
+	i.Comments = append(i.Comments, c)
+	i.Updated = now
+
+	r.json(http.StatusCreated, r.renderComment(c))
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+func (s *Server) getComment(r *request) {
+
+	_, c := r.findComment()
+	if c == nil {
+		return
+	}
+
+	r.json(http.StatusOK, r.renderComment(c))
+}
+
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+func (s *Server) updateComment(r *request) {
+
+	_, c := r.findComment()
+	if c == nil {
+		return
+	}
+
+	payload := new(commentPayload)
+	if !r.decode(payload) || !r.validComment(payload) {
+		return
+	}
+
+	c.Body = payload.Body
+	c.Updated = s.Now()
+
+	if payload.Visibility != nil {
+		c.Visibility = payload.Visibility
+	}
+
+	r.json(http.StatusOK, r.renderComment(c))
+}
+
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/comment/{id}
+func (s *Server) deleteComment(r *request) {
+
+	i, c := r.findComment()
+	if c == nil {
+		return
+	}
+
+	i.Comments = slices.DeleteFunc(i.Comments, func(other *comment) bool { return other == c })
+	r.noContent()
+}
+
+// validComment reports whether the comment has a body, it writes the error response otherwise.
+func (r *request) validComment(payload *commentPayload) bool {
+
+	if plainText(payload.Body) == "" {
+		r.fieldErrors(map[string]string{"comment": "Comment body can not be empty!"})
+		return false
+	}
+
+	return true
+}
+
+// findComment returns the comment of the path with its issue, it writes the error response and returns nil when it does not exist.
+func (r *request) findComment() (*issue, *comment) {
+
+	i := r.findIssue()
+	if i == nil {
+		return nil, nil
+	}
+
+	id := r.r.PathValue("id")
+	for _, c := range i.Comments {
+		if c.ID == id {
+			return i, c
+		}
+	}
+
+	r.error(http.StatusNotFound, "Can not find a comment for the id: "+id+".")
+
+	return nil, nil
+}
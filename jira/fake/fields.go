@@ -0,0 +1,91 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// GET /rest/api/{2-3}/field
+func (s *Server) listFields(r *request) {
+
+	fields := make([]object, 0, len(s.fields))
+	for _, f := range s.fields {
+		fields = append(fields, r.renderField(f))
+	}
+
+	r.json(http.StatusOK, fields)
+}
+
+type fieldPayload struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+// POST /rest/api/{2-3}/field
+func (s *Server) createField(r *request) {
+
+	payload := new(fieldPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	errors := make(map[string]string)
+
+	if payload.Name == "" {
+		errors["name"] = "The custom field name must be specified."
+	}
+
+	if payload.Type == "" {
+		errors["type"] = "The custom field type must be specified."
+	}
+
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	f := s.addField(payload.Name, payload.Type, schemaTypeOf(payload.Type))
+	f.Description = payload.Description
+
+	r.json(http.StatusCreated, r.renderField(f))
+}
+
+// GET /rest/api/{2-3}/field/search
+//
+// The fields are filtered by their type, custom or system, by IDs and by the query matched against their name.
+func (s *Server) searchFields(r *request) {
+
+	query := strings.ToLower(r.param("query"))
+	types := r.listParam("type")
+	ids := r.listParam("id")
+
+	var fields []object
+	for _, f := range s.fields {
+
+		switch {
+		case query != "" && !strings.Contains(strings.ToLower(f.Name), query):
+			continue
+		case len(ids) != 0 && !slices.Contains(ids, f.ID):
+			continue
+		case len(types) != 0 && f.Custom && !slices.Contains(types, "custom"):
+			continue
+		case len(types) != 0 && !f.Custom && !slices.Contains(types, "system"):
+			continue
+		}
+
+		fields = append(fields, r.renderField(f))
+	}
+
+	startAt, maxResults, end := r.page(len(fields), 50)
+
+	r.json(http.StatusOK, object{
+		"self":       r.self("field", "search"),
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(fields),
+		"isLast":     end >= len(fields),
+		"values":     slice(fields, startAt, end),
+	})
+}
@@ -0,0 +1,488 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+type issuePayload struct {
+	Fields     map[string]interface{}              `json:"fields"`
+	Update     map[string][]map[string]interface{} `json:"update"`
+	Transition map[string]interface{}              `json:"transition"`
+}
+
+// POST /rest/api/{2-3}/issue
+func (s *Server) createIssue(r *request) {
+
+	payload := new(issuePayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	i, errors := s.newIssue(r, payload)
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	r.json(http.StatusCreated, object{"id": i.ID, "key": i.Key, "self": r.self("issue", i.ID)})
+}
+
+// newIssue creates an issue from the payload, the issue is only stored when the payload is valid.
+func (s *Server) newIssue(r *request, payload *issuePayload) (*issue, map[string]string) {
+
+	errors := make(map[string]string)
+
+	p := s.project(reference(payload.Fields["project"]))
+	if p == nil {
+		errors["project"] = "Specify a valid project ID or key"
+	}
+
+	if reference(payload.Fields["issuetype"]) == "" {
+		errors["issuetype"] = "Specify an issue type"
+	}
+
+	if summary, _ := payload.Fields["summary"].(string); strings.TrimSpace(summary) == "" {
+		errors["summary"] = "You must specify a summary of the issue."
+	}
+
+	now := s.Now()
+
+	i := &issue{
+		Status:   statuses[0],
+		Priority: defaultPriority,
+		Reporter: r.user.AccountID,
+		Creator:  r.user.AccountID,
+		Created:  now,
+		Updated:  now,
+		Custom:   make(map[string]interface{}),
+	}
+
+	for id, value := range payload.Fields {
+		if id != "project" {
+			s.setField(i, id, value, errors)
+		}
+	}
+
+	s.applyOperations(r, i, payload.Update, errors)
+
+	if i.Type != nil && i.Type.Subtask && i.Parent == nil {
+		errors["parent"] = "Given parent issue does not belong to appropriate hierarchy."
+	}
+
+	if len(errors) != 0 {
+		return nil, errors
+	}
+
+	p.issues++
+
+	i.ID = s.newID()
+	i.Project = p
+	i.Number = p.issues
+	i.Key = fmt.Sprintf("%v-%d", p.Key, p.issues)
+
+	s.issues = append(s.issues, i)
+
+	return i, nil
+}
+
+// POST /rest/api/{2-3}/issue/bulk
+func (s *Server) createIssues(r *request) {
+
+	payload := new(struct {
+		IssueUpdates []*issuePayload `json:"issueUpdates"`
+	})
+
+	if !r.decode(payload) {
+		return
+	}
+
+	created := []object{}
+	failed := []object{}
+
+	for index, update := range payload.IssueUpdates {
+
+		i, errors := s.newIssue(r, update)
+		if len(errors) != 0 {
+			failed = append(failed, object{
+				"status":              http.StatusBadRequest,
+				"elementErrors":       object{"errorMessages": []string{}, "errors": errors},
+				"failedElementNumber": index,
+			})
+
+			continue
+		}
+
+		created = append(created, object{"id": i.ID, "key": i.Key, "self": r.self("issue", i.ID)})
+	}
+
+	status := http.StatusCreated
+	if len(created) == 0 {
+		status = http.StatusBadRequest
+	}
+
+	r.json(status, object{"issues": created, "errors": failed})
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}
+func (s *Server) getIssue(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	r.json(http.StatusOK, r.renderIssue(i, r.listParam("fields"), "*all"))
+}
+
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}
+//
+// The fields are set and the operations applied on a copy of the issue, which replaces it once they are all valid.
+func (s *Server) updateIssue(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	payload := new(issuePayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	updated, errors := s.edit(r, i, payload)
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	*i = *updated
+	r.noContent()
+}
+
+// edit returns a copy of the issue with the fields and operations of the payload applied.
+func (s *Server) edit(r *request, i *issue, payload *issuePayload) (*issue, map[string]string) {
+
+	errors := make(map[string]string)
+
+	updated := *i
+	updated.Labels = slices.Clone(i.Labels)
+	updated.Comments = slices.Clone(i.Comments)
+	updated.Custom = make(map[string]interface{}, len(i.Custom))
+
+	for id, value := range i.Custom {
+		updated.Custom[id] = value
+	}
+
+	for id, value := range payload.Fields {
+
+		if id == "project" {
+			errors[id] = "Field 'project' cannot be set. Use the move operation to change the project of an issue."
+			continue
+		}
+
+		s.setField(&updated, id, value, errors)
+	}
+
+	s.applyOperations(r, &updated, payload.Update, errors)
+
+	updated.Updated = s.Now()
+
+	return &updated, errors
+}
+
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}
+func (s *Server) deleteIssue(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	if r.param("deleteSubtasks") != "true" && slices.ContainsFunc(s.issues, func(child *issue) bool { return child.Parent == i && child.Type.Subtask }) {
+		r.error(http.StatusBadRequest, "The issue has subtasks, so it can't be deleted. To delete the issue, you must first delete its subtasks.")
+		return
+	}
+
+	s.removeIssue(i)
+	r.noContent()
+}
+
+// removeIssue deletes the issue with its children and links.
+func (s *Server) removeIssue(i *issue) {
+
+	s.issues = slices.DeleteFunc(s.issues, func(other *issue) bool { return other == i })
+	s.links = slices.DeleteFunc(s.links, func(l *link) bool { return l.Inward == i || l.Outward == i })
+
+	for _, child := range slices.Clone(s.issues) {
+		if child.Parent == i {
+			if child.Type.Subtask {
+				s.removeIssue(child)
+			} else {
+				child.Parent = nil
+			}
+		}
+	}
+}
+
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/assignee
+func (s *Server) assignIssue(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	payload := make(map[string]interface{})
+	if !r.decode(&payload) {
+		return
+	}
+
+	errors := make(map[string]string)
+	if s.setField(i, "assignee", payload["accountId"], errors); len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	i.Updated = s.Now()
+	r.noContent()
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/transitions
+//
+// Every status of the workflow can be reached from the others, through the transition leading to it.
+func (s *Server) getTransitions(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	available := []object{}
+	for _, t := range transitions {
+
+		if t.To == i.Status {
+			continue
+		}
+
+		available = append(available, object{
+			"id":            t.ID,
+			"name":          t.To.Name,
+			"to":            r.renderStatus(t.To),
+			"hasScreen":     false,
+			"isGlobal":      true,
+			"isInitial":     false,
+			"isAvailable":   true,
+			"isConditional": false,
+			"isLooped":      false,
+		})
+	}
+
+	r.json(http.StatusOK, object{"expand": "transitions", "transitions": available})
+}
+
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/transitions
+//
+// The issues moved to a done status are resolved, the resolution is cleared when they leave it.
+func (s *Server) doTransition(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	payload := new(issuePayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	id := reference(payload.Transition)
+
+	t := lookup(transitions, transitionID, id)
+	if t == nil || t.To == i.Status {
+		r.error(http.StatusBadRequest, fmt.Sprintf("Transition id '%v' is not valid for this issue.", id))
+		return
+	}
+
+	updated, errors := s.edit(r, i, payload)
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	updated.Status = t.To
+	updated.Resolved = time.Time{}
+
+	if t.To.Category == categoryDone {
+		updated.Resolved = updated.Updated
+	}
+
+	*i = *updated
+	r.noContent()
+}
+
+// setField sets a field of the issue from its value in a payload, the invalid values are reported in errors.
+func (s *Server) setField(i *issue, id string, value interface{}, errors map[string]string) {
+
+	switch id {
+	case "summary":
+		summary, ok := value.(string)
+		if !ok || strings.TrimSpace(summary) == "" {
+			errors[id] = "You must specify a summary of the issue."
+			return
+		}
+
+		i.Summary = summary
+	case "description":
+		i.Description = value
+	case "issuetype":
+		t := lookup(issueTypes, issueTypeID, reference(value))
+		if t == nil {
+			errors[id] = "The issue type selected is invalid."
+			return
+		}
+
+		i.Type = t
+	case "priority":
+		p := lookup(priorities, priorityID, reference(value))
+		if p == nil {
+			errors[id] = "The priority selected is invalid."
+			return
+		}
+
+		i.Priority = p
+	case "assignee", "reporter":
+		accountID := reference(value)
+		if accountID != "" && s.user(accountID) == nil {
+			errors[id] = fmt.Sprintf("Specified user does not exist or you do not have required permissions: %v", accountID)
+			return
+		}
+
+		if id == "assignee" {
+			i.Assignee = accountID
+		} else {
+			i.Reporter = accountID
+		}
+	case "labels":
+		labels, ok := stringList(value)
+		if !ok {
+			errors[id] = "The labels must be a list of strings."
+			return
+		}
+
+		i.Labels = labels
+	case "parent":
+		if value == nil {
+			i.Parent = nil
+			return
+		}
+
+		parent := s.issue(reference(value))
+		if parent == nil {
+			errors[id] = "Could not find issue by id or key."
+			return
+		}
+
+		i.Parent = parent
+	case "duedate":
+		if value != nil {
+			if date, _ := value.(string); date != "" {
+				if _, err := time.Parse("2006-01-02", date); err != nil {
+					errors[id] = "Error parsing date string: " + date
+					return
+				}
+			}
+		}
+
+		i.Custom[id] = value
+	default:
+		f := s.field(id)
+		if f == nil || !f.Custom || f.ID != id {
+			errors[id] = fmt.Sprintf("Field '%v' cannot be set. It is not on the appropriate screen, or unknown.", id)
+			return
+		}
+
+		if value == nil {
+			delete(i.Custom, id)
+			return
+		}
+
+		i.Custom[id] = value
+	}
+}
+
+// applyOperations applies the update operations of a payload, e.g. {"labels": [{"add": "triaged"}]}.
+//
+// The add and remove operations apply to the labels, the comments and the array custom fields,
+// the set operation applies to every field.
+func (s *Server) applyOperations(r *request, i *issue, operations map[string][]map[string]interface{}, errors map[string]string) {
+
+	for id, list := range operations {
+		for _, operation := range list {
+			for verb, value := range operation {
+
+				switch {
+				case verb == "set":
+					s.setField(i, id, value, errors)
+				case id == "comment" && verb == "add":
+					body, _ := value.(map[string]interface{})
+					i.Comments = append(i.Comments, &comment{ID: s.newID(), Author: r.user.AccountID, Body: body["body"], Created: s.Now(), Updated: s.Now()})
+				case id == "labels" && (verb == "add" || verb == "remove"):
+					i.Labels = editList(i.Labels, verb, value)
+				case strings.HasPrefix(id, "customfield_") && (verb == "add" || verb == "remove"):
+					current, _ := stringList(i.Custom[id])
+					s.setField(i, id, editList(current, verb, value), errors)
+				default:
+					errors[id] = fmt.Sprintf("The operation '%v' is not supported by the field '%v'.", verb, id)
+				}
+			}
+		}
+	}
+}
+
+// editList adds or removes a value from a list of strings.
+func editList(values []string, verb string, value interface{}) []string {
+
+	element := reference(value)
+
+	values = slices.DeleteFunc(slices.Clone(values), func(other string) bool { return other == element })
+	if verb == "add" {
+		values = append(values, element)
+	}
+
+	return values
+}
+
+// stringList converts a list decoded from JSON to strings, the references like {"value": "red"} are reduced to their value.
+func stringList(value interface{}) ([]string, bool) {
+
+	if values, ok := value.([]string); ok {
+		return values, true
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, value == nil
+	}
+
+	values := make([]string, 0, len(list))
+	for _, element := range list {
+		values = append(values, reference(element))
+	}
+
+	return values, true
+}
+
+// findIssue returns the issue of the path, it writes the error response and returns nil when it does not exist.
+func (r *request) findIssue() *issue {
+
+	i := r.server.issue(r.r.PathValue("issueIdOrKey"))
+	if i == nil {
+		r.error(http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+	}
+
+	return i
+}
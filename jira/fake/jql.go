@@ -0,0 +1,669 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/jql"
+)
+
+// The queries are parsed by jql.Parse, the fake evaluates a practical subset of JQL:
+//
+//   - the clauses combined with AND, OR, NOT and parentheses, followed by an optional ORDER BY;
+//   - the =, !=, IN, NOT IN, IS, IS NOT, ~, !~, >, >=, < and <= operators;
+//   - the EMPTY and NULL values and the currentUser(), now(), startOfDay() and endOfDay() functions;
+//   - the absolute dates like 2024-01-31 and the relative ones like -2w, -1d or 4h;
+//   - the common system fields and the custom fields, referenced by ID, name or cf[10000].
+//
+// The history operators like WAS or CHANGED, and the other functions are reported as invalid queries.
+
+// jqlFunctions are the functions supported by the fake, by their lowercase name.
+var jqlFunctions = []string{"currentuser", "now", "startofday", "endofday"}
+
+// query is a parsed JQL query, with the fields of its conditions and of its ORDER BY resolved.
+type query struct {
+	*jql.Query
+	fields map[jql.Field]*jqlField
+}
+
+// parseJQL parses the query and resolves its fields, the errors are worded like the ones of Jira.
+func (s *Server) parseJQL(source string) (*query, error) {
+
+	parsed, err := jql.Parse(source)
+	if err != nil {
+
+		var syntaxError *jql.SyntaxError
+		if errors.As(err, &syntaxError) {
+			message := []rune(syntaxError.Message)
+			message[0] = unicode.ToUpper(message[0])
+
+			return nil, fmt.Errorf("Error in the JQL Query: %v. (line 1, character %v)", string(message), syntaxError.Position+1)
+		}
+
+		return nil, err
+	}
+
+	q := &query{Query: parsed, fields: make(map[jql.Field]*jqlField)}
+
+	jql.Walk(parsed.Where, func(clause jql.Clause) bool {
+
+		if condition, ok := clause.(*jql.Condition); ok && err == nil {
+			err = s.checkCondition(q, condition)
+		}
+
+		return err == nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range parsed.Order {
+		if _, err = q.resolveField(s, order.Field); err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
+// resolveField resolves a field of the query once, see Server.resolveField.
+func (q *query) resolveField(s *Server, name jql.Field) (*jqlField, error) {
+
+	if f, ok := q.fields[name]; ok {
+		return f, nil
+	}
+
+	f, err := s.resolveField(string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	q.fields[name] = f
+
+	return f, nil
+}
+
+// jqlField is a field referenced by a query.
+type jqlField struct {
+	name   string // The name of the field in the query, for the error messages.
+	id     string // The ID of the field, e.g. status or customfield_10000.
+	kind   string // How the values are compared: text, number, date or identifier.
+	custom *field
+}
+
+// jqlAliases maps the names of the fields in JQL to their IDs.
+var jqlAliases = map[string]string{
+	"issuekey": "key",
+	"type":     "issuetype",
+	"resolved": "resolutiondate",
+	"due":      "duedate",
+}
+
+// resolveField resolves a field name of a query, reporting the unknown fields like Jira does.
+func (s *Server) resolveField(name string) (*jqlField, error) {
+
+	id := strings.ToLower(name)
+	if alias, ok := jqlAliases[id]; ok {
+		id = alias
+	}
+
+	if strings.HasPrefix(id, "cf[") && strings.HasSuffix(id, "]") {
+		id = "customfield_" + id[3:len(id)-1]
+	}
+
+	f := &jqlField{name: name, id: id, kind: "identifier"}
+
+	switch id {
+	case "summary", "description", "comment", "text":
+		f.kind = "text"
+		return f, nil
+	case "created", "updated", "resolutiondate", "duedate":
+		f.kind = "date"
+		return f, nil
+	case "key", "id", "project", "status", "statuscategory", "issuetype", "priority", "assignee", "reporter", "creator", "labels", "resolution", "parent":
+		return f, nil
+	}
+
+	custom := s.field(name)
+	if custom == nil {
+		custom = s.field(id)
+	}
+
+	if custom == nil || !custom.Custom {
+		return nil, fmt.Errorf("Field '%v' does not exist or you do not have permission to view it.", name)
+	}
+
+	f.id, f.custom = custom.ID, custom
+
+	switch custom.Schema["type"] {
+	case "string":
+		f.kind = "text"
+	case "number":
+		f.kind = "number"
+	case "date", "datetime":
+		f.kind = "date"
+	}
+
+	return f, nil
+}
+
+// checkCondition resolves the field of the condition, and checks that the field supports the operator,
+// that the functions are supported and that the projects of the condition exist.
+func (s *Server) checkCondition(q *query, condition *jql.Condition) error {
+
+	f, err := q.resolveField(s, condition.Field)
+	if err != nil {
+		return err
+	}
+
+	switch condition.Operator {
+	case jql.Was, jql.WasNot, jql.WasIn, jql.WasNotIn, jql.Changed:
+		return fmt.Errorf("Error in the JQL Query: The history operator '%v' is not supported.", condition.Operator)
+	}
+
+	_, list := condition.Operand.(jql.List)
+
+	switch multiple := condition.Operator == jql.In || condition.Operator == jql.NotIn; {
+	case multiple && !list:
+		return fmt.Errorf("Error in the JQL Query: Expecting a list of values after the operator '%v'.", condition.Operator)
+	case !multiple && list:
+		return fmt.Errorf("Error in the JQL Query: The operator '%v' does not support the list of values '%v'.", condition.Operator, condition.Operand)
+	}
+
+	unsupported := fmt.Errorf("Error in the JQL Query: The operator '%v' is not supported by the '%v' field.", condition.Operator, f.name)
+
+	switch condition.Operator {
+	case jql.Contains, jql.NotContains:
+		if f.kind != "text" {
+			return unsupported
+		}
+	case jql.GreaterThan, jql.GreaterThanEqual, jql.LessThan, jql.LessThanEqual:
+		if f.kind != "date" && f.kind != "number" {
+			return unsupported
+		}
+	case jql.Equals, jql.NotEquals, jql.In, jql.NotIn:
+		if f.kind == "text" && f.custom == nil {
+			return unsupported
+		}
+	}
+
+	for _, value := range operands(condition.Operand) {
+
+		switch value := value.(type) {
+		case *jql.Function:
+			if !slices.Contains(jqlFunctions, strings.ToLower(value.Name)) {
+				return fmt.Errorf("Error in the JQL Query: Unable to find JQL function '%v()'.", value.Name)
+			}
+		case jql.Literal:
+			name := string(value)
+			if f.id == "project" && s.project(name) == nil && !slices.ContainsFunc(s.projects, func(p *project) bool { return strings.EqualFold(p.Name, name) }) {
+				return fmt.Errorf("Error in the JQL Query: The value '%v' does not exist for the field 'project'.", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// operands returns the values of the operand, the lists are flattened.
+func operands(operand jql.Operand) []jql.Operand {
+
+	list, ok := operand.(jql.List)
+	if !ok {
+		return []jql.Operand{operand}
+	}
+
+	var values []jql.Operand
+	for _, value := range list {
+		values = append(values, operands(value)...)
+	}
+
+	return values
+}
+
+// evaluator evaluates the queries on behalf of a user, at a given time.
+type evaluator struct {
+	server *Server
+	user   *user
+	now    time.Time
+}
+
+// values returns the values of the field of the issue, each value is a string, a float64 or a time.Time.
+// The identifiers have several values, e.g. the name and the ID of a status, a clause matches any of them.
+func (e *evaluator) values(i *issue, f *jqlField) []interface{} {
+
+	userValues := func(accountID string) []interface{} {
+
+		u := e.server.user(accountID)
+		if u == nil {
+			return nil
+		}
+
+		return []interface{}{u.AccountID, u.Email, u.DisplayName}
+	}
+
+	switch f.id {
+	case "key":
+		return []interface{}{i.Key}
+	case "id":
+		return []interface{}{i.ID}
+	case "project":
+		return []interface{}{i.Project.Key, i.Project.ID, i.Project.Name}
+	case "status":
+		return []interface{}{i.Status.Name, i.Status.ID}
+	case "statuscategory":
+		return []interface{}{i.Status.Category.Name, i.Status.Category.Key, strconv.Itoa(i.Status.Category.ID)}
+	case "issuetype":
+		return []interface{}{i.Type.Name, i.Type.ID}
+	case "priority":
+		return []interface{}{i.Priority.Name, i.Priority.ID}
+	case "assignee":
+		return userValues(i.Assignee)
+	case "reporter":
+		return userValues(i.Reporter)
+	case "creator":
+		return userValues(i.Creator)
+	case "labels":
+		values := make([]interface{}, 0, len(i.Labels))
+		for _, label := range i.Labels {
+			values = append(values, label)
+		}
+
+		return values
+	case "resolution":
+		if i.Resolved.IsZero() {
+			return nil
+		}
+
+		return []interface{}{"Done", "10000"}
+	case "parent":
+		if i.Parent == nil {
+			return nil
+		}
+
+		return []interface{}{i.Parent.Key, i.Parent.ID}
+	case "summary":
+		return []interface{}{i.Summary}
+	case "description":
+		if text := plainText(i.Description); text != "" {
+			return []interface{}{text}
+		}
+
+		return nil
+	case "comment", "text":
+		var values []interface{}
+		if f.id == "text" {
+			values = append(values, i.Summary, plainText(i.Description))
+		}
+
+		for _, c := range i.Comments {
+			values = append(values, plainText(c.Body))
+		}
+
+		return values
+	case "created":
+		return []interface{}{i.Created}
+	case "updated":
+		return []interface{}{i.Updated}
+	case "resolutiondate":
+		if i.Resolved.IsZero() {
+			return nil
+		}
+
+		return []interface{}{i.Resolved}
+	}
+
+	value, ok := i.Custom[f.id]
+	if !ok || value == nil {
+		return nil
+	}
+
+	if f.kind == "date" {
+		date, ok := value.(string)
+		if !ok {
+			return nil
+		}
+
+		t, err := parseTime(date)
+		if err != nil {
+			return nil
+		}
+
+		return []interface{}{t}
+	}
+
+	if f.custom != nil && f.custom.Schema["type"] == "user" {
+		return userValues(reference(value))
+	}
+
+	switch value := value.(type) {
+	case float64:
+		return []interface{}{value}
+	case string:
+		return []interface{}{value}
+	case []interface{}, []string:
+		list, _ := stringList(value)
+
+		values := make([]interface{}, 0, len(list))
+		for _, element := range list {
+			values = append(values, element)
+		}
+
+		return values
+	}
+
+	return []interface{}{reference(value)}
+}
+
+// match reports whether the issue matches the clause of the query, every issue matches a nil clause.
+func (e *evaluator) match(q *query, clause jql.Clause, i *issue) bool {
+
+	switch clause := clause.(type) {
+	case *jql.Compound:
+
+		// An AND stops at the first clause not matching, an OR at the first clause matching.
+		and := clause.Operator == "AND"
+		for _, nested := range clause.Clauses {
+			if e.match(q, nested, i) != and {
+				return !and
+			}
+		}
+
+		return and
+	case *jql.Negation:
+		return !e.match(q, clause.Clause, i)
+	case *jql.Condition:
+		return e.matchCondition(q.fields[clause.Field], clause, i)
+	}
+
+	return true
+}
+
+// matchCondition reports whether the issue matches the condition, its field is resolved.
+func (e *evaluator) matchCondition(f *jqlField, condition *jql.Condition, i *issue) bool {
+
+	values := e.values(i, f)
+	list := operands(condition.Operand)
+
+	// The unresolved issues are matched by the Unresolved value or by EMPTY.
+	first, _ := list[0].(jql.Literal)
+	empty := isEmpty(list[0]) || (f.id == "resolution" && strings.EqualFold(string(first), "unresolved"))
+
+	switch condition.Operator {
+	case jql.Is, jql.Equals:
+		if empty {
+			return len(values) == 0
+		}
+	case jql.IsNot, jql.NotEquals:
+		if empty {
+			return len(values) != 0
+		}
+	}
+
+	matches := func(compare func(value interface{}, o jql.Operand) bool) bool {
+		for _, value := range values {
+			for _, o := range list {
+				if !isEmpty(o) && compare(value, o) {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	switch condition.Operator {
+	case jql.Equals, jql.In:
+		return matches(e.equal)
+	case jql.NotEquals, jql.NotIn:
+		return len(values) != 0 && !matches(e.equal)
+	case jql.Contains:
+		return matches(e.contains)
+	case jql.NotContains:
+		return len(values) != 0 && !matches(e.contains)
+	}
+
+	return matches(func(value interface{}, o jql.Operand) bool {
+
+		c, ok := e.compare(value, o)
+		if !ok {
+			return false
+		}
+
+		switch condition.Operator {
+		case jql.GreaterThan:
+			return c > 0
+		case jql.GreaterThanEqual:
+			return c >= 0
+		case jql.LessThan:
+			return c < 0
+		}
+
+		return c <= 0
+	})
+}
+
+// isEmpty reports whether the operand is EMPTY or NULL.
+func isEmpty(o jql.Operand) bool {
+
+	_, ok := o.(jql.Keyword)
+
+	return ok
+}
+
+// resolve returns the value of the operand, the functions are evaluated.
+func (e *evaluator) resolve(o jql.Operand) interface{} {
+
+	function, ok := o.(*jql.Function)
+	if !ok {
+		literal, _ := o.(jql.Literal)
+		return string(literal)
+	}
+
+	name := strings.ToLower(function.Name)
+	if name == "currentuser" {
+		return e.user.AccountID
+	}
+
+	now := e.now
+	if len(function.Args) != 0 {
+		if offset, ok := parseRelative(function.Args[0]); ok {
+			now = now.Add(offset)
+		}
+	}
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch name {
+	case "startofday":
+		return day
+	case "endofday":
+		return day.AddDate(0, 0, 1).Add(-time.Millisecond)
+	}
+
+	return now
+}
+
+func (e *evaluator) equal(value interface{}, o jql.Operand) bool {
+
+	if s, ok := value.(string); ok {
+		resolved, ok := e.resolve(o).(string)
+		return ok && strings.EqualFold(s, resolved)
+	}
+
+	c, ok := e.compare(value, o)
+
+	return ok && c == 0
+}
+
+// contains reports whether the text contains every word of the operand, or the phrase when the operand is quoted.
+// The trailing wildcards are ignored.
+func (e *evaluator) contains(value interface{}, o jql.Operand) bool {
+
+	text, ok := value.(string)
+	if !ok {
+		return false
+	}
+
+	text = strings.ToLower(text)
+	search := strings.ToLower(fmt.Sprint(e.resolve(o)))
+
+	if len(search) > 1 && strings.HasPrefix(search, `"`) && strings.HasSuffix(search, `"`) {
+		return strings.Contains(text, search[1:len(search)-1])
+	}
+
+	for _, word := range strings.Fields(search) {
+		if !strings.Contains(text, strings.TrimRight(word, "*?")) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compare compares a number or a date with the operand, ok is false when the operand is not comparable.
+func (e *evaluator) compare(value interface{}, o jql.Operand) (int, bool) {
+
+	resolved := e.resolve(o)
+
+	switch value := value.(type) {
+	case float64:
+		number, err := strconv.ParseFloat(fmt.Sprint(resolved), 64)
+		if err != nil {
+			return 0, false
+		}
+
+		switch {
+		case value < number:
+			return -1, true
+		case value > number:
+			return 1, true
+		}
+
+		return 0, true
+	case time.Time:
+		t, ok := resolved.(time.Time)
+		if !ok {
+			if t, ok = e.parseDate(fmt.Sprint(resolved)); !ok {
+				return 0, false
+			}
+		}
+
+		return value.Compare(t), true
+	}
+
+	return 0, false
+}
+
+var relativePattern = regexp.MustCompile(`^([-+]?\d+)([wdhm])$`)
+
+// parseRelative parses a relative date like -2w or 4h.
+func parseRelative(value string) (time.Duration, bool) {
+
+	match := relativePattern.FindStringSubmatch(strings.ToLower(value))
+	if match == nil {
+		return 0, false
+	}
+
+	amount, _ := strconv.Atoi(match[1])
+	unit := map[string]time.Duration{"w": 7 * 24 * time.Hour, "d": 24 * time.Hour, "h": time.Hour, "m": time.Minute}[match[2]]
+
+	return time.Duration(amount) * unit, true
+}
+
+// parseDate parses an absolute date of a query, in the location of the server, or a relative one.
+func (e *evaluator) parseDate(value string) (time.Time, bool) {
+
+	if offset, ok := parseRelative(value); ok {
+		return e.now.Add(offset), true
+	}
+
+	for _, layout := range []string{"2006-01-02", "2006/01/02", "2006-01-02 15:04", "2006/01/02 15:04"} {
+		if t, err := time.ParseInLocation(layout, value, e.now.Location()); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// sort orders the issues following the ORDER BY clause, the newest issues come first without it.
+func (e *evaluator) sort(issues []*issue, q *query) {
+
+	slices.SortStableFunc(issues, func(a, b *issue) int {
+
+		for _, order := range q.Order {
+
+			c := e.compareIssues(a, b, q.fields[order.Field])
+			if order.Direction == jql.Desc {
+				c = -c
+			}
+
+			if c != 0 {
+				return c
+			}
+		}
+
+		idA, _ := strconv.Atoi(a.ID)
+		idB, _ := strconv.Atoi(b.ID)
+
+		return idB - idA
+	})
+}
+
+// compareIssues compares two issues on a field, the issues without value come last.
+func (e *evaluator) compareIssues(a, b *issue, f *jqlField) int {
+
+	switch f.id {
+	case "key":
+		if c := strings.Compare(a.Project.Key, b.Project.Key); c != 0 {
+			return c
+		}
+
+		return a.Number - b.Number
+	case "id":
+		idA, _ := strconv.Atoi(a.ID)
+		idB, _ := strconv.Atoi(b.ID)
+
+		return idA - idB
+	case "priority":
+		return strings.Compare(a.Priority.ID, b.Priority.ID)
+	}
+
+	valuesA, valuesB := e.values(a, f), e.values(b, f)
+
+	switch {
+	case len(valuesA) == 0 && len(valuesB) == 0:
+		return 0
+	case len(valuesA) == 0:
+		return 1
+	case len(valuesB) == 0:
+		return -1
+	}
+
+	switch valueA := valuesA[0].(type) {
+	case float64:
+		valueB, _ := valuesB[0].(float64)
+
+		switch {
+		case valueA < valueB:
+			return -1
+		case valueA > valueB:
+			return 1
+		}
+
+		return 0
+	case time.Time:
+		valueB, _ := valuesB[0].(time.Time)
+		return valueA.Compare(valueB)
+	}
+
+	return strings.Compare(strings.ToLower(fmt.Sprint(valuesA[0])), strings.ToLower(fmt.Sprint(valuesB[0])))
+}
@@ -0,0 +1,142 @@
+package fake
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestState seeds a server with issues, without going through the REST API.
+func newTestState(t *testing.T) (*Server, *evaluator) {
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	s.AddUser("alice-account-id", "Alice", "alice@example.com")
+	s.AddProject("KP", "Kanban Project")
+	s.AddProject("OPS", "Operations")
+	points := s.AddField("Story Points", "number")
+
+	add := func(projectKey, summary string, build func(*issue)) {
+
+		p := s.project(projectKey)
+		p.issues++
+
+		i := &issue{
+			ID:       s.newID(),
+			Key:      projectKey + "-" + strconv.Itoa(p.issues),
+			Project:  p,
+			Number:   p.issues,
+			Type:     issueTypes[1],
+			Status:   statuses[0],
+			Priority: defaultPriority,
+			Summary:  summary,
+			Reporter: DefaultAccountID,
+			Created:  now.AddDate(0, 0, -p.issues),
+			Updated:  now,
+			Custom:   map[string]interface{}{},
+		}
+
+		if build != nil {
+			build(i)
+		}
+
+		s.issues = append(s.issues, i)
+	}
+
+	add("KP", "Login with SSO", func(i *issue) {
+		i.Assignee = "alice-account-id"
+		i.Labels = []string{"auth", "frontend"}
+		i.Custom[points] = float64(5)
+		i.Description = map[string]interface{}{"type": "doc", "content": []interface{}{
+			map[string]interface{}{"type": "paragraph", "content": []interface{}{map[string]interface{}{"type": "text", "text": "Use the identity provider"}}},
+		}}
+	})
+	add("KP", "Logout button", func(i *issue) {
+		i.Status = statuses[2]
+		i.Resolved = now
+		i.Priority = priorities[0]
+		i.Custom[points] = float64(2)
+	})
+	add("OPS", "Rotate the certificates", func(i *issue) {
+		i.Type = issueTypes[2]
+		i.Labels = []string{"security"}
+	})
+
+	return s, &evaluator{server: s, user: s.users[0], now: now}
+}
+
+func TestServer_parseJQL(t *testing.T) {
+
+	s, e := newTestState(t)
+
+	testCases := []struct {
+		jql      string
+		wantKeys []string
+		wantErr  string
+	}{
+		{jql: "", wantKeys: []string{"OPS-1", "KP-2", "KP-1"}},
+		{jql: "project = KP ORDER BY key ASC", wantKeys: []string{"KP-1", "KP-2"}},
+		{jql: `project in (KP, "Operations") AND labels = security`, wantKeys: []string{"OPS-1"}},
+		{jql: "assignee = currentUser()", wantKeys: nil},
+		{jql: "assignee = alice@example.com", wantKeys: []string{"KP-1"}},
+		{jql: "assignee is EMPTY ORDER BY key", wantKeys: []string{"KP-2", "OPS-1"}},
+		{jql: "resolution = Unresolved AND type != Bug", wantKeys: []string{"KP-1"}},
+		{jql: "statusCategory = Done OR priority = Highest", wantKeys: []string{"KP-2"}},
+		{jql: "NOT (project = OPS) ORDER BY \"Story Points\" DESC", wantKeys: []string{"KP-1", "KP-2"}},
+		{jql: "cf[10002] > 3", wantKeys: []string{"KP-1"}},
+		{jql: "text ~ \"identity provider\"", wantKeys: []string{"KP-1"}},
+		{jql: "summary ~ log*", wantKeys: []string{"KP-2", "KP-1"}},
+		{jql: "created < -1d", wantKeys: []string{"KP-2"}},
+		{jql: "created < 2024-03-09", wantKeys: []string{"KP-2"}},
+		{jql: "labels not in (auth) ORDER BY key", wantKeys: []string{"OPS-1"}},
+		{jql: "project = KP && (labels = auth || priority = Highest) ORDER BY key", wantKeys: []string{"KP-1", "KP-2"}},
+		{jql: "created >= startOfDay(-1d) AND !(assignee = alice@example.com)", wantKeys: []string{"OPS-1"}},
+		{jql: "project = UNKNOWN", wantErr: "The value 'UNKNOWN' does not exist for the field 'project'."},
+		{jql: "team = A", wantErr: "Field 'team' does not exist or you do not have permission to view it."},
+		{jql: "status ~ Done", wantErr: "The operator '~' is not supported by the 'status' field."},
+		{jql: "summary = Login", wantErr: "The operator '=' is not supported by the 'summary' field."},
+		{jql: "assignee = membersOf(jira-users)", wantErr: "Unable to find JQL function 'membersOf()'."},
+		{jql: "status WAS Done", wantErr: "The history operator 'WAS' is not supported."},
+		{jql: "status in Done", wantErr: "Expecting a list of values after the operator 'IN'."},
+		{jql: "status = (Done, Closed)", wantErr: "The operator '=' does not support the list of values '(Done, Closed)'."},
+		{jql: "ORDER BY team", wantErr: "Field 'team' does not exist or you do not have permission to view it."},
+		{jql: "project = KP AND", wantErr: "Error in the JQL Query: Expected a field, found the end of the query. (line 1, character 17)"},
+		{jql: "summary ~ \"open", wantErr: "Unterminated string"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.jql, func(t *testing.T) {
+
+			q, err := s.parseJQL(testCase.jql)
+			if testCase.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var matched []*issue
+			for _, i := range s.issues {
+				if e.match(q, q.Where, i) {
+					matched = append(matched, i)
+				}
+			}
+
+			e.sort(matched, q)
+
+			var keys []string
+			for _, i := range matched {
+				keys = append(keys, i.Key)
+			}
+
+			assert.Equal(t, testCase.wantKeys, keys)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+)
+
+type linkPayload struct {
+	Type         map[string]interface{} `json:"type"`
+	InwardIssue  map[string]interface{} `json:"inwardIssue"`
+	OutwardIssue map[string]interface{} `json:"outwardIssue"`
+	Comment      *commentPayload        `json:"comment"`
+}
+
+// GET /rest/api/{2-3}/issueLinkType
+func (s *Server) listLinkTypes(r *request) {
+
+	types := make([]object, 0, len(linkTypes))
+	for _, t := range linkTypes {
+		types = append(types, r.renderLinkType(t))
+	}
+
+	r.json(http.StatusOK, object{"issueLinkTypes": types})
+}
+
+// POST /rest/api/{2-3}/issueLink
+//
+// The comment of the payload is added to the outward issue.
+func (s *Server) createLink(r *request) {
+
+	payload := new(linkPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	t := lookup(linkTypes, linkTypeID, reference(payload.Type))
+	if t == nil {
+		r.error(http.StatusNotFound, "No issue link type with name '"+reference(payload.Type)+"' found.")
+		return
+	}
+
+	inward, outward := s.issue(reference(payload.InwardIssue)), s.issue(reference(payload.OutwardIssue))
+	if inward == nil || outward == nil {
+		r.error(http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	if inward == outward {
+		r.error(http.StatusBadRequest, "You cannot link an issue to itself.")
+		return
+	}
+
+	s.links = append(s.links, &link{ID: s.newID(), Type: t, Inward: inward, Outward: outward})
+
+	if payload.Comment != nil && plainText(payload.Comment.Body) != "" {
+		now := s.Now()
+		outward.Comments = append(outward.Comments, &comment{ID: s.newID(), Author: r.user.AccountID, Body: payload.Comment.Body, Visibility: payload.Comment.Visibility, Created: now, Updated: now})
+	}
+
+	r.w.WriteHeader(http.StatusCreated)
+}
+
+// GET /rest/api/{2-3}/issueLink/{linkId}
+func (s *Server) getLink(r *request) {
+
+	l := r.findLink()
+	if l == nil {
+		return
+	}
+
+	r.json(http.StatusOK, r.renderLink(l, nil))
+}
+
+// DELETE /rest/api/{2-3}/issueLink/{linkId}
+func (s *Server) deleteLink(r *request) {
+
+	l := r.findLink()
+	if l == nil {
+		return
+	}
+
+	s.links = slices.DeleteFunc(s.links, func(other *link) bool { return other == l })
+	r.noContent()
+}
+
+// findLink returns the link of the path, it writes the error response and returns nil when it does not exist.
+func (r *request) findLink() *link {
+
+	id := r.r.PathValue("linkId")
+	for _, l := range r.server.links {
+		if l.ID == id {
+			return l
+		}
+	}
+
+	r.error(http.StatusNotFound, "No issue link with id '"+id+"' exists.")
+
+	return nil
+}
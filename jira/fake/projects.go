@@ -0,0 +1,226 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// projectKeyPattern matches the valid project keys, an uppercase letter followed by uppercase letters or digits.
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,9}$`)
+
+// GET /rest/api/{2-3}/project
+func (s *Server) listProjects(r *request) {
+
+	projects := make([]object, 0, len(s.projects))
+	for _, p := range s.projects {
+		projects = append(projects, r.renderProject(p, true))
+	}
+
+	r.json(http.StatusOK, projects)
+}
+
+// GET /rest/api/{2-3}/project/search
+//
+// The projects are filtered by the query, matched against their key and name, and by the keys or IDs.
+func (s *Server) searchProjects(r *request) {
+
+	query := strings.ToLower(r.param("query"))
+	keys := r.listParam("keys")
+
+	var projects []object
+	for _, p := range s.projects {
+
+		if query != "" && !strings.Contains(strings.ToLower(p.Key), query) && !strings.Contains(strings.ToLower(p.Name), query) {
+			continue
+		}
+
+		if len(keys) != 0 && !slices.Contains(keys, p.Key) && !slices.Contains(keys, p.ID) {
+			continue
+		}
+
+		projects = append(projects, r.renderProject(p, true))
+	}
+
+	startAt, maxResults, end := r.page(len(projects), 50)
+
+	r.json(http.StatusOK, object{
+		"self":       r.self("project", "search"),
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(projects),
+		"isLast":     end >= len(projects),
+		"values":     slice(projects, startAt, end),
+	})
+}
+
+// GET /rest/api/{2-3}/project/{projectIdOrKey}
+func (s *Server) getProject(r *request) {
+
+	p := r.findProject()
+	if p == nil {
+		return
+	}
+
+	r.json(http.StatusOK, r.renderProject(p, true))
+}
+
+type projectPayload struct {
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectTypeKey string `json:"projectTypeKey"`
+	LeadAccountID  string `json:"leadAccountId"`
+}
+
+// POST /rest/api/{2-3}/project
+func (s *Server) createProject(r *request) {
+
+	payload := new(projectPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	errors := make(map[string]string)
+
+	switch {
+	case !projectKeyPattern.MatchString(payload.Key):
+		errors["projectKey"] = "Project keys must start with an uppercase letter, followed by one or more uppercase alphanumeric characters."
+	case s.project(payload.Key) != nil:
+		errors["projectKey"] = fmt.Sprintf("Project '%v' uses this project key.", s.project(payload.Key).Name)
+	}
+
+	if payload.Name == "" {
+		errors["projectName"] = "You must specify a valid project name."
+	}
+
+	for _, p := range s.projects {
+		if strings.EqualFold(p.Name, payload.Name) {
+			errors["projectName"] = "A project with that name already exists."
+		}
+	}
+
+	if payload.LeadAccountID != "" && s.user(payload.LeadAccountID) == nil {
+		errors["projectLead"] = "The project lead specified does not exist."
+	}
+
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return
+	}
+
+	p := &project{
+		ID:          s.newID(),
+		Key:         payload.Key,
+		Name:        payload.Name,
+		Description: payload.Description,
+		TypeKey:     payload.ProjectTypeKey,
+		Lead:        payload.LeadAccountID,
+	}
+
+	if p.TypeKey == "" {
+		p.TypeKey = "software"
+	}
+
+	if p.Lead == "" {
+		p.Lead = r.user.AccountID
+	}
+
+	s.projects = append(s.projects, p)
+
+	// The ID of the created project is a number, unlike in the other responses.
+	id, _ := strconv.Atoi(p.ID)
+
+	r.json(http.StatusCreated, object{"self": r.self("project", p.ID), "id": id, "key": p.Key})
+}
+
+// PUT /rest/api/{2-3}/project/{projectIdOrKey}
+func (s *Server) updateProject(r *request) {
+
+	p := r.findProject()
+	if p == nil {
+		return
+	}
+
+	payload := new(projectPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	if payload.LeadAccountID != "" && s.user(payload.LeadAccountID) == nil {
+		r.fieldErrors(map[string]string{"projectLead": "The project lead specified does not exist."})
+		return
+	}
+
+	if payload.Name != "" {
+		p.Name = payload.Name
+	}
+
+	if payload.Description != "" {
+		p.Description = payload.Description
+	}
+
+	if payload.LeadAccountID != "" {
+		p.Lead = payload.LeadAccountID
+	}
+
+	r.json(http.StatusOK, r.renderProject(p, true))
+}
+
+// DELETE /rest/api/{2-3}/project/{projectIdOrKey}
+//
+// The issues of the project are deleted along with it.
+func (s *Server) deleteProject(r *request) {
+
+	p := r.findProject()
+	if p == nil {
+		return
+	}
+
+	s.projects = slices.DeleteFunc(s.projects, func(other *project) bool { return other == p })
+
+	for _, i := range slices.Clone(s.issues) {
+		if i.Project == p {
+			s.removeIssue(i)
+		}
+	}
+
+	r.noContent()
+}
+
+// GET /rest/api/{2-3}/project/{projectIdOrKey}/statuses
+func (s *Server) projectStatuses(r *request) {
+
+	p := r.findProject()
+	if p == nil {
+		return
+	}
+
+	rendered := make([]object, 0, len(statuses))
+	for _, st := range statuses {
+		rendered = append(rendered, r.renderStatus(st))
+	}
+
+	types := make([]object, 0, len(issueTypes))
+	for _, t := range issueTypes {
+		types = append(types, object{"self": r.self("issuetype", t.ID), "id": t.ID, "name": t.Name, "subtask": t.Subtask, "statuses": rendered})
+	}
+
+	r.json(http.StatusOK, types)
+}
+
+// findProject returns the project of the path, it writes the error response and returns nil when it does not exist.
+func (r *request) findProject() *project {
+
+	idOrKey := r.r.PathValue("projectIdOrKey")
+
+	p := r.server.project(idOrKey)
+	if p == nil {
+		r.error(http.StatusNotFound, fmt.Sprintf("No project could be found with key '%v'.", idOrKey))
+	}
+
+	return p
+}
@@ -0,0 +1,335 @@
+package fake
+
+import (
+	"strconv"
+	"time"
+)
+
+type object = map[string]interface{}
+
+func formatTime(t time.Time) interface{} {
+
+	if t.IsZero() {
+		return nil
+	}
+
+	return t.Format(timeLayout)
+}
+
+func (r *request) renderUser(accountID string) interface{} {
+
+	u := r.server.user(accountID)
+	if u == nil {
+		return nil
+	}
+
+	return object{
+		"self":         r.self("user?accountId=" + u.AccountID),
+		"accountId":    u.AccountID,
+		"accountType":  "atlassian",
+		"emailAddress": u.Email,
+		"displayName":  u.DisplayName,
+		"active":       u.Active,
+		"timeZone":     "UTC",
+	}
+}
+
+func (r *request) renderStatus(s *status) object {
+
+	return object{
+		"self":        r.self("status", s.ID),
+		"id":          s.ID,
+		"name":        s.Name,
+		"description": "",
+		"statusCategory": object{
+			"self":      r.self("statuscategory", strconv.Itoa(s.Category.ID)),
+			"id":        s.Category.ID,
+			"key":       s.Category.Key,
+			"colorName": s.Category.Color,
+			"name":      s.Category.Name,
+		},
+	}
+}
+
+func (r *request) renderIssueType(t *issueType) object {
+
+	return object{
+		"self":           r.self("issuetype", t.ID),
+		"id":             t.ID,
+		"name":           t.Name,
+		"description":    "",
+		"subtask":        t.Subtask,
+		"hierarchyLevel": t.HierarchyLevel,
+	}
+}
+
+func (r *request) renderPriority(p *priority) object {
+	return object{"self": r.self("priority", p.ID), "id": p.ID, "name": p.Name}
+}
+
+func (r *request) renderLinkType(t *linkType) object {
+	return object{"self": r.self("issueLinkType", t.ID), "id": t.ID, "name": t.Name, "inward": t.Inward, "outward": t.Outward}
+}
+
+func (r *request) renderProject(p *project, full bool) object {
+
+	rendered := object{
+		"self":           r.self("project", p.ID),
+		"id":             p.ID,
+		"key":            p.Key,
+		"name":           p.Name,
+		"projectTypeKey": p.TypeKey,
+		"simplified":     false,
+		"style":          "classic",
+		"isPrivate":      false,
+	}
+
+	if full {
+
+		types := make([]object, 0, len(issueTypes))
+		for _, t := range issueTypes {
+			types = append(types, r.renderIssueType(t))
+		}
+
+		rendered["description"] = p.Description
+		rendered["lead"] = r.renderUser(p.Lead)
+		rendered["issueTypes"] = types
+		rendered["assigneeType"] = "UNASSIGNED"
+	}
+
+	return rendered
+}
+
+func (r *request) renderComment(c *comment) object {
+
+	return object{
+		"self":         r.self("comment", c.ID),
+		"id":           c.ID,
+		"author":       r.renderUser(c.Author),
+		"updateAuthor": r.renderUser(c.Author),
+		"body":         richText(c.Body, r.version),
+		"created":      formatTime(c.Created),
+		"updated":      formatTime(c.Updated),
+		"visibility":   c.Visibility,
+		"jsdPublic":    true,
+	}
+}
+
+func (r *request) renderWorklog(w *worklog) object {
+
+	return object{
+		"self":             r.self("issue", w.Issue.ID, "worklog", w.ID),
+		"id":               w.ID,
+		"issueId":          w.Issue.ID,
+		"author":           r.renderUser(w.Author),
+		"updateAuthor":     r.renderUser(w.Author),
+		"comment":          richText(w.Comment, r.version),
+		"started":          formatTime(w.Started),
+		"timeSpent":        formatDuration(w.Seconds),
+		"timeSpentSeconds": w.Seconds,
+		"created":          formatTime(w.Created),
+		"updated":          formatTime(w.Updated),
+		"visibility":       w.Visibility,
+	}
+}
+
+// renderLinkedIssue renders the summary of an issue referenced by another one, e.g. in a link or as a parent.
+func (r *request) renderLinkedIssue(i *issue) object {
+
+	return object{
+		"id":   i.ID,
+		"key":  i.Key,
+		"self": r.self("issue", i.ID),
+		"fields": object{
+			"summary":   i.Summary,
+			"status":    r.renderStatus(i.Status),
+			"priority":  r.renderPriority(i.Priority),
+			"issuetype": r.renderIssueType(i.Type),
+		},
+	}
+}
+
+// renderLink renders a link, from the point of view of an issue when from is set: only the other issue is rendered.
+func (r *request) renderLink(l *link, from *issue) object {
+
+	rendered := object{"id": l.ID, "self": r.self("issueLink", l.ID), "type": r.renderLinkType(l.Type)}
+
+	if from != l.Inward {
+		rendered["inwardIssue"] = r.renderLinkedIssue(l.Inward)
+	}
+
+	if from != l.Outward {
+		rendered["outwardIssue"] = r.renderLinkedIssue(l.Outward)
+	}
+
+	return rendered
+}
+
+// fieldValue renders the value of a field of the issue, ok is false when the issue has no such field.
+func (r *request) fieldValue(i *issue, id string) (interface{}, bool) {
+
+	switch id {
+	case "summary":
+		return i.Summary, true
+	case "description":
+		return richText(i.Description, r.version), true
+	case "issuetype":
+		return r.renderIssueType(i.Type), true
+	case "project":
+		return r.renderProject(i.Project, false), true
+	case "status":
+		return r.renderStatus(i.Status), true
+	case "priority":
+		return r.renderPriority(i.Priority), true
+	case "assignee":
+		return r.renderUser(i.Assignee), true
+	case "reporter":
+		return r.renderUser(i.Reporter), true
+	case "creator":
+		return r.renderUser(i.Creator), true
+	case "labels":
+		return append([]string{}, i.Labels...), true
+	case "created":
+		return formatTime(i.Created), true
+	case "updated":
+		return formatTime(i.Updated), true
+	case "resolutiondate":
+		return formatTime(i.Resolved), true
+	case "resolution":
+		if i.Resolved.IsZero() {
+			return nil, true
+		}
+
+		return object{"self": r.self("resolution", "10000"), "id": "10000", "name": "Done"}, true
+	case "parent":
+		if i.Parent == nil {
+			return nil, false
+		}
+
+		return r.renderLinkedIssue(i.Parent), true
+	case "subtasks":
+		subtasks := []object{}
+		for _, child := range r.server.issues {
+			if child.Parent == i && child.Type.Subtask {
+				subtasks = append(subtasks, r.renderLinkedIssue(child))
+			}
+		}
+
+		return subtasks, true
+	case "issuelinks":
+		links := []object{}
+		for _, l := range r.server.links {
+			if l.Inward == i || l.Outward == i {
+				links = append(links, r.renderLink(l, i))
+			}
+		}
+
+		return links, true
+	case "timespent":
+		var seconds int
+		for _, w := range i.Worklogs {
+			seconds += w.Seconds
+		}
+
+		if seconds == 0 {
+			return nil, true
+		}
+
+		return seconds, true
+	case "comment":
+		comments := make([]object, 0, len(i.Comments))
+		for _, c := range i.Comments {
+			comments = append(comments, r.renderComment(c))
+		}
+
+		return object{"comments": comments, "startAt": 0, "maxResults": len(comments), "total": len(comments), "self": r.self("issue", i.ID, "comment")}, true
+	case "worklog":
+		worklogs := make([]object, 0, len(i.Worklogs))
+		for _, w := range i.Worklogs {
+			worklogs = append(worklogs, r.renderWorklog(w))
+		}
+
+		return object{"worklogs": worklogs, "startAt": 0, "maxResults": len(worklogs), "total": len(worklogs)}, true
+	}
+
+	value, ok := i.Custom[id]
+	if f := r.server.field(id); f != nil && f.Schema["type"] == "user" {
+		return r.renderUser(reference(value)), ok
+	}
+
+	return value, ok
+}
+
+// renderIssue renders the issue with the requested fields.
+//
+// The fields are requested by ID, with the *all and *navigable shortcuts and the exclusions prefixed by a minus.
+// An empty list renders the fallback fields instead.
+func (r *request) renderIssue(i *issue, requested []string, fallback string) object {
+
+	rendered := object{"id": i.ID, "key": i.Key, "self": r.self("issue", i.ID), "expand": "renderedFields,names,schema,transitions,operations,editmeta,changelog"}
+
+	if len(requested) == 0 {
+		requested = []string{fallback}
+	}
+
+	include := make(map[string]bool)
+	for _, name := range requested {
+
+		switch name {
+		case "*all", "*navigable":
+			for _, f := range r.server.fields {
+				if name == "*all" || f.Navigable {
+					include[f.ID] = true
+				}
+			}
+		case "id", "key", "":
+		default:
+			if len(name) > 1 && name[0] == '-' {
+				include[name[1:]] = false
+				continue
+			}
+
+			if f := r.server.field(name); f != nil {
+				include[f.ID] = true
+			}
+		}
+	}
+
+	fields := object{}
+	for id, included := range include {
+		if !included {
+			continue
+		}
+
+		if value, ok := r.fieldValue(i, id); ok {
+			fields[id] = value
+		}
+	}
+
+	if len(fields) != 0 {
+		rendered["fields"] = fields
+	}
+
+	return rendered
+}
+
+func (r *request) renderField(f *field) object {
+
+	clauseNames := []string{f.ID}
+	if f.Custom {
+		clauseNames = []string{"cf[" + f.Schema["customId"].(string) + "]", f.Name}
+	}
+
+	return object{
+		"id":          f.ID,
+		"key":         f.Key,
+		"name":        f.Name,
+		"description": f.Description,
+		"custom":      f.Custom,
+		"orderable":   f.Orderable,
+		"navigable":   f.Navigable,
+		"searchable":  true,
+		"clauseNames": clauseNames,
+		"schema":      f.Schema,
+	}
+}
@@ -0,0 +1,121 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// request is the context of a request handled by the Server.
+type request struct {
+	server  *Server
+	w       http.ResponseWriter
+	r       *http.Request
+	version string
+	user    *user
+}
+
+// decode reads the JSON body of the request into v, it writes the error response and returns false when it fails.
+func (r *request) decode(v interface{}) bool {
+
+	if err := json.NewDecoder(r.r.Body).Decode(v); err != nil {
+		r.error(http.StatusBadRequest, fmt.Sprintf("Unexpected request body: %v", err))
+		return false
+	}
+
+	return true
+}
+
+// json writes v as the JSON body of the response.
+func (r *request) json(status int, v interface{}) {
+
+	r.w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	r.w.WriteHeader(status)
+	_ = json.NewEncoder(r.w).Encode(v)
+}
+
+// noContent writes an empty response.
+func (r *request) noContent() {
+	r.w.WriteHeader(http.StatusNoContent)
+}
+
+// error writes an error response in the format of the REST API.
+func (r *request) error(status int, messages ...string) {
+	writeError(r.w, status, messages...)
+}
+
+// fieldErrors writes the validation errors of the fields of a payload.
+func (r *request) fieldErrors(errors map[string]string) {
+
+	r.w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	r.w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(r.w).Encode(map[string]interface{}{"errorMessages": []string{}, "errors": errors})
+}
+
+// self returns the URL of a resource of the REST API, e.g. self("issue", "10000").
+func (r *request) self(elements ...string) string {
+	return fmt.Sprintf("http://%v/rest/api/%v/%v", r.r.Host, r.version, strings.Join(elements, "/"))
+}
+
+// param returns a query parameter of the request.
+func (r *request) param(name string) string {
+	return r.r.URL.Query().Get(name)
+}
+
+// intParam returns a numeric query parameter of the request, or fallback when it is missing or invalid.
+func (r *request) intParam(name string, fallback int) int {
+
+	value, err := strconv.Atoi(r.param(name))
+	if err != nil {
+		return fallback
+	}
+
+	return value
+}
+
+// listParam returns a query parameter holding a list, sent either repeated or comma separated.
+func (r *request) listParam(name string) []string {
+
+	var values []string
+	for _, value := range r.r.URL.Query()[name] {
+		for _, element := range strings.Split(value, ",") {
+			if element = strings.TrimSpace(element); element != "" {
+				values = append(values, element)
+			}
+		}
+	}
+
+	return values
+}
+
+// page returns the bounds of the requested page of a list of total items, maxResults is capped to limit.
+func (r *request) page(total, limit int) (startAt, maxResults, end int) {
+
+	startAt = max(r.intParam("startAt", 0), 0)
+
+	maxResults = r.intParam("maxResults", limit)
+	if maxResults <= 0 || maxResults > limit {
+		maxResults = limit
+	}
+
+	return startAt, maxResults, min(total, startAt+maxResults)
+}
+
+func writeError(w http.ResponseWriter, status int, messages ...string) {
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errorMessages": messages, "errors": map[string]string{}})
+}
+
+// slice returns the items of a page, empty when startAt is past the end.
+func slice[T any](items []T, startAt, end int) []T {
+
+	if startAt >= end {
+		return []T{}
+	}
+
+	return items[startAt:end]
+}
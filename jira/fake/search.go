@@ -0,0 +1,149 @@
+package fake
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+)
+
+type searchPayload struct {
+	JQL           string   `json:"jql"`
+	StartAt       int      `json:"startAt"`
+	MaxResults    int      `json:"maxResults"`
+	Fields        []string `json:"fields"`
+	NextPageToken string   `json:"nextPageToken"`
+}
+
+// searchRequest reads the search parameters from the query string of a GET request or the body of a POST request.
+func (r *request) searchRequest() (*searchPayload, bool) {
+
+	if r.r.Method == http.MethodPost {
+		payload := new(searchPayload)
+		return payload, r.decode(payload)
+	}
+
+	return &searchPayload{
+		JQL:           r.param("jql"),
+		StartAt:       r.intParam("startAt", 0),
+		MaxResults:    r.intParam("maxResults", 0),
+		Fields:        r.listParam("fields"),
+		NextPageToken: r.param("nextPageToken"),
+	}, true
+}
+
+// find returns the issues matching the JQL query, sorted.
+func (r *request) find(jql string) ([]*issue, *query, bool) {
+
+	q, err := r.server.parseJQL(jql)
+	if err != nil {
+		r.error(http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	e := &evaluator{server: r.server, user: r.user, now: r.server.Now()}
+
+	var issues []*issue
+	for _, i := range r.server.issues {
+		if e.match(q, q.Where, i) {
+			issues = append(issues, i)
+		}
+	}
+
+	e.sort(issues, q)
+
+	return issues, q, true
+}
+
+// GET /rest/api/{2-3}/search
+// POST /rest/api/{2-3}/search
+//
+// The navigable fields are returned unless other fields are requested.
+func (s *Server) search(r *request) {
+
+	payload, ok := r.searchRequest()
+	if !ok {
+		return
+	}
+
+	issues, _, ok := r.find(payload.JQL)
+	if !ok {
+		return
+	}
+
+	maxResults := payload.MaxResults
+	if maxResults <= 0 || maxResults > 100 {
+		maxResults = 50
+	}
+
+	startAt := max(payload.StartAt, 0)
+	end := min(len(issues), startAt+maxResults)
+
+	rendered := []object{}
+	for _, i := range slice(issues, startAt, end) {
+		rendered = append(rendered, r.renderIssue(i, payload.Fields, "*navigable"))
+	}
+
+	r.json(http.StatusOK, object{
+		"expand":     "schema,names",
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(issues),
+		"issues":     rendered,
+	})
+}
+
+// GET /rest/api/{2-3}/search/jql
+// POST /rest/api/{2-3}/search/jql
+//
+// Like the enhanced search of Jira, only the IDs of the issues are returned unless fields are requested,
+// the queries must be bounded by a condition and the pages are chained with an opaque nextPageToken.
+func (s *Server) searchJQL(r *request) {
+
+	payload, ok := r.searchRequest()
+	if !ok {
+		return
+	}
+
+	issues, q, ok := r.find(payload.JQL)
+	if !ok {
+		return
+	}
+
+	if q.Where == nil {
+		r.error(http.StatusBadRequest, "Unbounded JQL queries are not allowed here. Please add a search restriction to your query.")
+		return
+	}
+
+	startAt := 0
+	if payload.NextPageToken != "" {
+
+		decoded, err := base64.RawURLEncoding.DecodeString(payload.NextPageToken)
+		if err == nil {
+			startAt, err = strconv.Atoi(string(decoded))
+		}
+
+		if err != nil || startAt < 0 {
+			r.error(http.StatusBadRequest, "The provided next page token is invalid or expired.")
+			return
+		}
+	}
+
+	maxResults := payload.MaxResults
+	if maxResults <= 0 || maxResults > 100 {
+		maxResults = 50
+	}
+
+	end := min(len(issues), startAt+maxResults)
+
+	rendered := []object{}
+	for _, i := range slice(issues, startAt, end) {
+		rendered = append(rendered, r.renderIssue(i, payload.Fields, "id"))
+	}
+
+	page := object{"issues": rendered, "isLast": end >= len(issues)}
+	if end < len(issues) {
+		page["nextPageToken"] = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	r.json(http.StatusOK, page)
+}
@@ -0,0 +1,205 @@
+// Package fake provides an in-memory Jira Cloud server for offline integration tests.
+//
+// The Server implements the core of the Jira REST API v2 and v3 on top of an httptest.Server:
+// projects, issues, transitions, comments, worklogs, issue links, fields, users and the issue search,
+// with a practical subset of JQL. The state lives in memory and is discarded when the server is closed.
+//
+// The clients are pointed at the server through its URL, any credentials are accepted:
+//
+//	server := fake.NewServer()
+//	defer server.Close()
+//
+//	server.AddProject("KP", "Kanban Project")
+//
+//	client, err := v3.New(server.Client(), server.URL, nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client.Auth.SetBasicAuth(fake.DefaultEmail, "token")
+//
+// The requests authenticated with the basic credentials of a user added with AddUser are made on their behalf,
+// the other requests are made on behalf of the default user.
+package fake
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The default user of the server, the owner of the requests not made on behalf of another user.
+const (
+	DefaultAccountID   = "5b10ac8d82e05b22cc7d4ef5"
+	DefaultDisplayName = "Fake User"
+	DefaultEmail       = "fake.user@example.com"
+)
+
+// Server is an in-memory Jira Cloud server.
+type Server struct {
+	*httptest.Server
+
+	// Now returns the current time of the server, it is used to stamp the issues, comments and worklogs.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	nextID   int
+	users    []*user
+	projects []*project
+	issues   []*issue
+	fields   []*field
+	links    []*link
+}
+
+// NewServer starts a Server with the default user, the system fields and no project.
+func NewServer() *Server {
+
+	s := &Server{
+		Now:    time.Now,
+		nextID: 10000,
+		fields: systemFields(),
+	}
+
+	s.users = append(s.users, &user{AccountID: DefaultAccountID, DisplayName: DefaultDisplayName, Email: DefaultEmail, Active: true})
+	s.Server = httptest.NewServer(s.routes())
+
+	return s
+}
+
+// AddUser adds a user, the requests authenticated with their email are made on their behalf.
+func (s *Server) AddUser(accountID, displayName, email string) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = append(s.users, &user{AccountID: accountID, DisplayName: displayName, Email: email, Active: true})
+}
+
+// AddProject adds a software project led by the default user and returns its ID.
+func (s *Server) AddProject(key, name string) string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := &project{ID: s.newID(), Key: key, Name: name, TypeKey: "software", Lead: DefaultAccountID}
+	s.projects = append(s.projects, p)
+
+	return p.ID
+}
+
+// AddField adds a custom field and returns its ID, e.g. customfield_10000.
+//
+// The schemaType is the type of the values of the field: string, number, date, datetime, option, user or array.
+// The array fields hold strings, like the labels.
+func (s *Server) AddField(name, schemaType string) string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addField(name, "", schemaType).ID
+}
+
+// newID returns the next numeric ID, the IDs are shared by every kind of resource.
+func (s *Server) newID() string {
+
+	id := s.nextID
+	s.nextID++
+
+	return strconv.Itoa(id)
+}
+
+// routes registers the handlers of the REST API, for both the v2 and v3 versions.
+func (s *Server) routes() http.Handler {
+
+	mux := http.NewServeMux()
+
+	handle := func(pattern string, handler func(*request)) {
+		mux.HandleFunc(pattern, s.serve(handler))
+	}
+
+	handle("GET /rest/api/{version}/myself", s.myself)
+	handle("GET /rest/api/{version}/user", s.getUser)
+	handle("GET /rest/api/{version}/user/bulk", s.bulkUsers)
+	handle("GET /rest/api/{version}/user/search", s.searchUsers)
+	handle("GET /rest/api/{version}/users/search", s.listUsers)
+
+	handle("GET /rest/api/{version}/project", s.listProjects)
+	handle("POST /rest/api/{version}/project", s.createProject)
+	handle("GET /rest/api/{version}/project/search", s.searchProjects)
+	handle("GET /rest/api/{version}/project/{projectIdOrKey}", s.getProject)
+	handle("PUT /rest/api/{version}/project/{projectIdOrKey}", s.updateProject)
+	handle("DELETE /rest/api/{version}/project/{projectIdOrKey}", s.deleteProject)
+	handle("GET /rest/api/{version}/project/{projectIdOrKey}/statuses", s.projectStatuses)
+
+	handle("GET /rest/api/{version}/field", s.listFields)
+	handle("POST /rest/api/{version}/field", s.createField)
+	handle("GET /rest/api/{version}/field/search", s.searchFields)
+
+	handle("POST /rest/api/{version}/issue", s.createIssue)
+	handle("POST /rest/api/{version}/issue/bulk", s.createIssues)
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}", s.getIssue)
+	handle("PUT /rest/api/{version}/issue/{issueIdOrKey}", s.updateIssue)
+	handle("DELETE /rest/api/{version}/issue/{issueIdOrKey}", s.deleteIssue)
+	handle("PUT /rest/api/{version}/issue/{issueIdOrKey}/assignee", s.assignIssue)
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}/transitions", s.getTransitions)
+	handle("POST /rest/api/{version}/issue/{issueIdOrKey}/transitions", s.doTransition)
+
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}/comment", s.listComments)
+	handle("POST /rest/api/{version}/issue/{issueIdOrKey}/comment", s.addComment)
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}/comment/{id}", s.getComment)
+	handle("PUT /rest/api/{version}/issue/{issueIdOrKey}/comment/{id}", s.updateComment)
+	handle("DELETE /rest/api/{version}/issue/{issueIdOrKey}/comment/{id}", s.deleteComment)
+
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}/worklog", s.listWorklogs)
+	handle("POST /rest/api/{version}/issue/{issueIdOrKey}/worklog", s.addWorklog)
+	handle("GET /rest/api/{version}/issue/{issueIdOrKey}/worklog/{id}", s.getWorklog)
+	handle("PUT /rest/api/{version}/issue/{issueIdOrKey}/worklog/{id}", s.updateWorklog)
+	handle("DELETE /rest/api/{version}/issue/{issueIdOrKey}/worklog/{id}", s.deleteWorklog)
+	handle("POST /rest/api/{version}/worklog/list", s.bulkWorklogs)
+
+	handle("GET /rest/api/{version}/issueLinkType", s.listLinkTypes)
+	handle("POST /rest/api/{version}/issueLink", s.createLink)
+	handle("GET /rest/api/{version}/issueLink/{linkId}", s.getLink)
+	handle("DELETE /rest/api/{version}/issueLink/{linkId}", s.deleteLink)
+
+	handle("GET /rest/api/{version}/search", s.search)
+	handle("POST /rest/api/{version}/search", s.search)
+	handle("GET /rest/api/{version}/search/jql", s.searchJQL)
+	handle("POST /rest/api/{version}/search/jql", s.searchJQL)
+
+	return mux
+}
+
+// serve adapts a handler of the REST API, it holds the lock of the state while the handler runs.
+func (s *Server) serve(handler func(*request)) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		version := r.PathValue("version")
+		if version != "2" && version != "3" {
+			writeError(w, http.StatusNotFound, "The requested API version is not supported.")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		handler(&request{server: s, w: w, r: r, version: version, user: s.currentUser(r)})
+	}
+}
+
+// currentUser returns the user the request is made on behalf of.
+func (s *Server) currentUser(r *http.Request) *user {
+
+	if email, _, ok := r.BasicAuth(); ok {
+		for _, u := range s.users {
+			if u.Email == email {
+				return u
+			}
+		}
+	}
+
+	return s.users[0]
+}
@@ -0,0 +1,208 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func newTestClient(t *testing.T) (*Server, *v3.Client) {
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := v3.New(server.Client(), server.URL, nil)
+	require.NoError(t, err)
+
+	client.Auth.SetBasicAuth(DefaultEmail, "token")
+
+	return server, client
+}
+
+func paragraph(text string) *model.CommentNodeScheme {
+
+	return &model.CommentNodeScheme{
+		Version: 1,
+		Type:    "doc",
+		Content: []*model.CommentNodeScheme{
+			{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: text}}},
+		},
+	}
+}
+
+func TestServer_IssueLifecycle(t *testing.T) {
+
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	server.AddUser("alice-account-id", "Alice", "alice@example.com")
+	points := server.AddField("Story Points", "number")
+
+	project, _, err := client.Project.Create(ctx, &model.ProjectPayloadScheme{Key: "KP", Name: "Kanban Project", ProjectTypeKey: "software"})
+	require.NoError(t, err)
+	assert.Equal(t, "KP", project.Key)
+
+	customFields := &model.CustomFields{}
+	require.NoError(t, customFields.Number(points, 5))
+
+	created, response, err := client.Issue.Create(ctx, &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{
+			Project:     &model.ProjectScheme{Key: "KP"},
+			IssueType:   &model.IssueTypeScheme{Name: "Story"},
+			Summary:     "Login with SSO",
+			Description: paragraph("Users can login through the identity provider."),
+			Labels:      []string{"auth"},
+			Assignee:    &model.UserScheme{AccountID: "alice-account-id"},
+		},
+	}, customFields)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, "KP-1", created.Key)
+
+	issue, _, err := client.Issue.Get(ctx, "KP-1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Login with SSO", issue.Fields.Summary)
+	assert.Equal(t, "To Do", issue.Fields.Status.Name)
+	assert.Equal(t, "Alice", issue.Fields.Assignee.DisplayName)
+	assert.Equal(t, DefaultAccountID, issue.Fields.Reporter.AccountID)
+	assert.Equal(t, "Users can login through the identity provider.", issue.Fields.Description.Content[0].Content[0].Text)
+
+	transitions, _, err := client.Issue.Transitions(ctx, "KP-1")
+	require.NoError(t, err)
+	require.Len(t, transitions.Transitions, 2)
+
+	var done string
+	for _, transition := range transitions.Transitions {
+		if transition.To.Name == "Done" {
+			done = transition.ID
+		}
+	}
+
+	_, err = client.Issue.Move(ctx, "KP-1", done, nil)
+	require.NoError(t, err)
+
+	comment, _, err := client.Issue.Comment.Add(ctx, "KP-1", &model.CommentPayloadScheme{Body: paragraph("Shipped in the last release.")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDisplayName, comment.Author.DisplayName)
+
+	worklog, _, err := client.Issue.Worklog.Add(ctx, "KP-1", &model.WorklogADFPayloadScheme{TimeSpent: "1h 30m"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5400, worklog.TimeSpentSeconds)
+
+	bug, _, err := client.Issue.Create(ctx, &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{
+			Project:   &model.ProjectScheme{Key: "KP"},
+			IssueType: &model.IssueTypeScheme{Name: "Bug"},
+			Summary:   "The SSO button is misaligned",
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = client.Issue.Link.Create(ctx, &model.LinkPayloadSchemeV3{
+		Type:         &model.LinkTypeScheme{Name: "Blocks"},
+		InwardIssue:  &model.LinkedIssueScheme{Key: "KP-1"},
+		OutwardIssue: &model.LinkedIssueScheme{Key: bug.Key},
+	})
+	require.NoError(t, err)
+
+	links, _, err := client.Issue.Link.Gets(ctx, bug.Key)
+	require.NoError(t, err)
+	require.Len(t, links.Fields.IssueLinks, 1)
+	assert.Equal(t, "KP-1", links.Fields.IssueLinks[0].InwardIssue.Key)
+
+	issue, _, err = client.Issue.Get(ctx, "KP-1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Done", issue.Fields.Status.Name)
+	assert.Equal(t, "Done", issue.Fields.Resolution.Name)
+	assert.Equal(t, 1, issue.Fields.Comment.Total)
+	assert.Equal(t, 1, issue.Fields.Worklog.Total)
+
+	page, _, err := client.Issue.Search.Post(ctx, `project = KP AND "Story Points" >= 3 AND statusCategory = Done ORDER BY key`, []string{"summary"}, nil, 0, 50, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, page.Total)
+	assert.Equal(t, "KP-1", page.Issues[0].Key)
+
+	results, _, err := client.Issue.Search.GetByJQLSearch(ctx, "assignee = alice@example.com OR issuetype = Bug", "summary", false, "")
+	require.NoError(t, err)
+	assert.True(t, results.IsLast)
+	require.Len(t, results.Issues, 2)
+	assert.Equal(t, bug.Key, results.Issues[0].Key)
+
+	_, err = client.Issue.Delete(ctx, bug.Key, false)
+	require.NoError(t, err)
+
+	_, response, err = client.Issue.Get(ctx, bug.Key, nil, nil)
+	assert.ErrorIs(t, err, model.ErrNotFound)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestServer_Validation(t *testing.T) {
+
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	server.AddProject("KP", "Kanban Project")
+
+	_, response, err := client.Issue.Create(ctx, &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{Project: &model.ProjectScheme{Key: "KP"}, IssueType: &model.IssueTypeScheme{Name: "Task"}},
+	}, nil)
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Contains(t, response.Bytes.String(), "You must specify a summary of the issue.")
+
+	_, response, err = client.Issue.Search.Post(ctx, "project = UNKNOWN", nil, nil, 0, 50, "")
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+	assert.Contains(t, response.Bytes.String(), "The value 'UNKNOWN' does not exist for the field 'project'.")
+
+	_, response, err = client.Issue.Search.GetByJQLSearch(ctx, "ORDER BY created", "", false, "")
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+	assert.Contains(t, response.Bytes.String(), "Unbounded JQL queries are not allowed here.")
+}
+
+func TestServer_Users(t *testing.T) {
+
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	server.AddUser("alice-account-id", "Alice", "alice@example.com")
+
+	myself, _, err := client.MySelf.Details(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAccountID, myself.AccountID)
+
+	client.Auth.SetBasicAuth("alice@example.com", "token")
+
+	myself, _, err = client.MySelf.Details(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "alice-account-id", myself.AccountID)
+
+	_, _, err = client.User.Get(ctx, "unknown-account-id", nil)
+	assert.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestServer_Now(t *testing.T) {
+
+	server, client := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)
+	server.Now = func() time.Time { return now }
+	server.AddProject("KP", "Kanban Project")
+
+	_, _, err := client.Issue.Create(ctx, &model.IssueScheme{
+		Fields: &model.IssueFieldsScheme{Project: &model.ProjectScheme{Key: "KP"}, IssueType: &model.IssueTypeScheme{Name: "Task"}, Summary: "Stamped"},
+	}, nil)
+	require.NoError(t, err)
+
+	issue, _, err := client.Issue.Get(ctx, "KP-1", []string{"created"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01T09:30:00.000+0000", issue.Fields.Created)
+	assert.Empty(t, issue.Fields.Summary)
+}
@@ -0,0 +1,486 @@
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayout is the layout of the timestamps returned by the REST API.
+const timeLayout = "2006-01-02T15:04:05.000-0700"
+
+type user struct {
+	AccountID   string
+	DisplayName string
+	Email       string
+	Active      bool
+}
+
+type project struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	TypeKey     string
+	Lead        string
+	issues      int // The number of the last issue created, it numbers the issue keys.
+}
+
+type statusCategory struct {
+	ID    int
+	Key   string
+	Name  string
+	Color string
+}
+
+type status struct {
+	ID       string
+	Name     string
+	Category *statusCategory
+}
+
+type transition struct {
+	ID string
+	To *status
+}
+
+type issueType struct {
+	ID             string
+	Name           string
+	Subtask        bool
+	HierarchyLevel int
+}
+
+type priority struct {
+	ID   string
+	Name string
+}
+
+type linkType struct {
+	ID      string
+	Name    string
+	Inward  string
+	Outward string
+}
+
+type issue struct {
+	ID          string
+	Key         string
+	Project     *project
+	Number      int
+	Type        *issueType
+	Status      *status
+	Priority    *priority
+	Summary     string
+	Description interface{}
+	Assignee    string
+	Reporter    string
+	Creator     string
+	Labels      []string
+	Parent      *issue
+	Resolved    time.Time
+	Created     time.Time
+	Updated     time.Time
+	Custom      map[string]interface{} // The values of the other fields, keyed by field ID.
+	Comments    []*comment
+	Worklogs    []*worklog
+}
+
+type comment struct {
+	ID         string
+	Author     string
+	Body       interface{}
+	Visibility interface{}
+	Created    time.Time
+	Updated    time.Time
+}
+
+type worklog struct {
+	ID         string
+	Issue      *issue
+	Author     string
+	Comment    interface{}
+	Started    time.Time
+	Seconds    int
+	Created    time.Time
+	Updated    time.Time
+	Visibility interface{}
+}
+
+type link struct {
+	ID      string
+	Type    *linkType
+	Inward  *issue
+	Outward *issue
+}
+
+type field struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	Custom      bool
+	Schema      map[string]interface{}
+	Navigable   bool
+	Orderable   bool
+}
+
+// The statuses and transitions of the workflow shared by every project, any status can be reached from the others.
+var (
+	categoryToDo       = &statusCategory{ID: 2, Key: "new", Name: "To Do", Color: "blue-gray"}
+	categoryInProgress = &statusCategory{ID: 4, Key: "indeterminate", Name: "In Progress", Color: "yellow"}
+	categoryDone       = &statusCategory{ID: 3, Key: "done", Name: "Done", Color: "green"}
+
+	statuses = []*status{
+		{ID: "10000", Name: "To Do", Category: categoryToDo},
+		{ID: "3", Name: "In Progress", Category: categoryInProgress},
+		{ID: "10001", Name: "Done", Category: categoryDone},
+	}
+
+	transitions = []*transition{
+		{ID: "11", To: statuses[0]},
+		{ID: "21", To: statuses[1]},
+		{ID: "31", To: statuses[2]},
+	}
+)
+
+// The issue types, priorities and link types shared by every project.
+var (
+	issueTypes = []*issueType{
+		{ID: "10000", Name: "Epic", HierarchyLevel: 1},
+		{ID: "10001", Name: "Task"},
+		{ID: "10002", Name: "Bug"},
+		{ID: "10003", Name: "Story"},
+		{ID: "10004", Name: "Subtask", Subtask: true, HierarchyLevel: -1},
+	}
+
+	priorities = []*priority{
+		{ID: "1", Name: "Highest"},
+		{ID: "2", Name: "High"},
+		{ID: "3", Name: "Medium"},
+		{ID: "4", Name: "Low"},
+		{ID: "5", Name: "Lowest"},
+	}
+
+	defaultPriority = priorities[2]
+
+	linkTypes = []*linkType{
+		{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+		{ID: "10001", Name: "Cloners", Inward: "is cloned by", Outward: "clones"},
+		{ID: "10002", Name: "Duplicate", Inward: "is duplicated by", Outward: "duplicates"},
+		{ID: "10003", Name: "Relates", Inward: "relates to", Outward: "relates to"},
+	}
+)
+
+// customTypes maps the schema types accepted by AddField to the custom field types of Jira.
+var customTypes = map[string]string{
+	"string":   "com.atlassian.jira.plugin.system.customfieldtypes:textfield",
+	"number":   "com.atlassian.jira.plugin.system.customfieldtypes:float",
+	"date":     "com.atlassian.jira.plugin.system.customfieldtypes:datepicker",
+	"datetime": "com.atlassian.jira.plugin.system.customfieldtypes:datetime",
+	"option":   "com.atlassian.jira.plugin.system.customfieldtypes:select",
+	"user":     "com.atlassian.jira.plugin.system.customfieldtypes:userpicker",
+	"array":    "com.atlassian.jira.plugin.system.customfieldtypes:labels",
+}
+
+// systemFields returns the fields known by every server.
+func systemFields() []*field {
+
+	system := func(id, name, schemaType string, navigable bool) *field {
+		return &field{
+			ID:        id,
+			Key:       id,
+			Name:      name,
+			Schema:    map[string]interface{}{"type": schemaType, "system": id},
+			Navigable: navigable,
+			Orderable: navigable,
+		}
+	}
+
+	return []*field{
+		system("summary", "Summary", "string", true),
+		system("description", "Description", "string", true),
+		system("issuetype", "Issue Type", "issuetype", true),
+		system("project", "Project", "project", true),
+		system("status", "Status", "status", true),
+		system("priority", "Priority", "priority", true),
+		system("assignee", "Assignee", "user", true),
+		system("reporter", "Reporter", "user", true),
+		system("creator", "Creator", "user", true),
+		system("labels", "Labels", "array", true),
+		system("parent", "Parent", "issuelink", true),
+		system("created", "Created", "datetime", true),
+		system("updated", "Updated", "datetime", true),
+		system("duedate", "Due date", "date", true),
+		system("resolution", "Resolution", "resolution", true),
+		system("resolutiondate", "Resolved", "datetime", true),
+		system("issuelinks", "Linked Issues", "array", true),
+		system("subtasks", "Sub-tasks", "array", true),
+		system("timespent", "Time Spent", "number", true),
+		system("comment", "Comment", "comments-page", false),
+		system("worklog", "Log Work", "array", false),
+	}
+}
+
+// addField adds a custom field, customType is the custom field type of Jira, derived from schemaType when empty.
+func (s *Server) addField(name, customType, schemaType string) *field {
+
+	id := s.newID()
+
+	if customType == "" {
+		customType = customTypes[schemaType]
+	}
+
+	schema := map[string]interface{}{"type": schemaType, "custom": customType, "customId": id}
+	if schemaType == "array" {
+		schema["items"] = "string"
+	}
+
+	f := &field{
+		ID:        "customfield_" + id,
+		Key:       "customfield_" + id,
+		Name:      name,
+		Custom:    true,
+		Schema:    schema,
+		Navigable: true,
+		Orderable: true,
+	}
+
+	s.fields = append(s.fields, f)
+
+	return f
+}
+
+// schemaTypeOf returns the schema type of a Jira custom field type, e.g. float is a number.
+func schemaTypeOf(customType string) string {
+
+	for schemaType, custom := range customTypes {
+		if custom == customType {
+			return schemaType
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(customType, ":textarea"), strings.HasSuffix(customType, ":url"):
+		return "string"
+	case strings.HasSuffix(customType, ":multiselect"), strings.HasSuffix(customType, ":multicheckboxes"):
+		return "array"
+	case strings.HasSuffix(customType, ":radiobuttons"):
+		return "option"
+	}
+
+	return "any"
+}
+
+func (s *Server) user(accountID string) *user {
+
+	for _, u := range s.users {
+		if u.AccountID == accountID {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// project finds a project by ID or key, the keys are case-insensitive.
+func (s *Server) project(idOrKey string) *project {
+
+	for _, p := range s.projects {
+		if p.ID == idOrKey || strings.EqualFold(p.Key, idOrKey) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// issue finds an issue by ID or key, the keys are case-insensitive.
+func (s *Server) issue(idOrKey string) *issue {
+
+	for _, i := range s.issues {
+		if i.ID == idOrKey || strings.EqualFold(i.Key, idOrKey) {
+			return i
+		}
+	}
+
+	return nil
+}
+
+// field finds a field by ID, key or name, the names are case-insensitive.
+func (s *Server) field(idKeyOrName string) *field {
+
+	for _, f := range s.fields {
+		if f.ID == idKeyOrName || f.Key == idKeyOrName || strings.EqualFold(f.Name, idKeyOrName) {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// lookup finds an element by ID or name, the names are case-insensitive.
+func lookup[T any](elements []*T, id func(*T) (string, string), idOrName string) *T {
+
+	for _, element := range elements {
+		if elementID, name := id(element); elementID == idOrName || strings.EqualFold(name, idOrName) {
+			return element
+		}
+	}
+
+	return nil
+}
+
+func statusID(s *status) (string, string)         { return s.ID, s.Name }
+func issueTypeID(t *issueType) (string, string)   { return t.ID, t.Name }
+func priorityID(p *priority) (string, string)     { return p.ID, p.Name }
+func linkTypeID(t *linkType) (string, string)     { return t.ID, t.Name }
+func transitionID(t *transition) (string, string) { return t.ID, t.To.Name }
+
+// reference returns the ID, key or name identifying the resource in a reference like {"key": "KP"} or {"name": "Bug"}.
+func reference(value interface{}) string {
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if s, ok := value.(string); ok {
+			return s
+		}
+
+		return ""
+	}
+
+	for _, name := range []string{"id", "key", "accountId", "name", "value"} {
+		if s, ok := object[name].(string); ok && s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// richText converts a rich text value to the format of the API version,
+// the version 2 uses the wiki markup strings while the version 3 uses the Atlassian Document Format.
+func richText(value interface{}, version string) interface{} {
+
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if version == "2" {
+			return value
+		}
+
+		return map[string]interface{}{
+			"type":    "doc",
+			"version": 1,
+			"content": []interface{}{
+				map[string]interface{}{
+					"type":    "paragraph",
+					"content": []interface{}{map[string]interface{}{"type": "text", "text": value}},
+				},
+			},
+		}
+	default:
+		if version == "3" {
+			return value
+		}
+
+		return plainText(value)
+	}
+}
+
+// plainText extracts the text of a rich text value, the paragraphs of a document are separated by new lines.
+func plainText(value interface{}) string {
+
+	var text strings.Builder
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+
+		switch node := node.(type) {
+		case string:
+			text.WriteString(node)
+		case []interface{}:
+			for _, child := range node {
+				walk(child)
+			}
+		case map[string]interface{}:
+			if s, ok := node["text"].(string); ok {
+				text.WriteString(s)
+			}
+
+			walk(node["content"])
+
+			if node["type"] == "paragraph" || node["type"] == "heading" {
+				text.WriteString("\n")
+			}
+		}
+	}
+
+	walk(value)
+
+	return strings.TrimSpace(text.String())
+}
+
+// parseDuration parses a Jira duration like "1w 2d 3h 30m", a day is 8 hours and a week is 5 days.
+func parseDuration(value string) (int, error) {
+
+	units := map[byte]int{'w': 5 * 8 * 3600, 'd': 8 * 3600, 'h': 3600, 'm': 60, 's': 1}
+
+	var seconds int
+	for _, part := range strings.Fields(value) {
+
+		unit, ok := units[part[len(part)-1]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+
+		amount, err := strconv.ParseFloat(part[:len(part)-1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+
+		seconds += int(amount * float64(unit))
+	}
+
+	if seconds <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return seconds, nil
+}
+
+// formatDuration formats seconds as a Jira duration, e.g. 5400 is "1h 30m".
+func formatDuration(seconds int) string {
+
+	var parts []string
+	for _, unit := range []struct {
+		suffix  string
+		seconds int
+	}{{"w", 5 * 8 * 3600}, {"d", 8 * 3600}, {"h", 3600}, {"m", 60}} {
+
+		if seconds >= unit.seconds {
+			parts = append(parts, fmt.Sprintf("%d%v", seconds/unit.seconds, unit.suffix))
+			seconds %= unit.seconds
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0m"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parseTime parses a timestamp sent to the REST API.
+func parseTime(value string) (time.Time, error) {
+
+	for _, layout := range []string{timeLayout, time.RFC3339, "2006-01-02T15:04:05.000Z0700", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
@@ -0,0 +1,82 @@
+package fake
+
+import (
+	"net/http"
+	"strings"
+)
+
+// GET /rest/api/{2-3}/myself
+func (s *Server) myself(r *request) {
+	r.json(http.StatusOK, r.renderUser(r.user.AccountID))
+}
+
+// GET /rest/api/{2-3}/user?accountId={accountId}
+func (s *Server) getUser(r *request) {
+
+	u := s.user(r.param("accountId"))
+	if u == nil {
+		r.error(http.StatusNotFound, "Specified user does not exist or you do not have required permissions")
+		return
+	}
+
+	r.json(http.StatusOK, r.renderUser(u.AccountID))
+}
+
+// GET /rest/api/{2-3}/user/bulk?accountId={accountId}
+func (s *Server) bulkUsers(r *request) {
+
+	var users []interface{}
+	for _, accountID := range r.listParam("accountId") {
+		if u := s.user(accountID); u != nil {
+			users = append(users, r.renderUser(u.AccountID))
+		}
+	}
+
+	startAt, maxResults, end := r.page(len(users), 200)
+
+	r.json(http.StatusOK, object{
+		"self":       r.self("user", "bulk"),
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(users),
+		"isLast":     end >= len(users),
+		"values":     slice(users, startAt, end),
+	})
+}
+
+// GET /rest/api/{2-3}/user/search?query={query}
+//
+// The users are matched by account ID, or by the start of their display name or email.
+func (s *Server) searchUsers(r *request) {
+
+	query := strings.ToLower(r.param("query"))
+	accountID := r.param("accountId")
+
+	var users []interface{}
+	for _, u := range s.users {
+
+		switch {
+		case accountID != "" && u.AccountID != accountID:
+			continue
+		case query != "" && !strings.HasPrefix(strings.ToLower(u.DisplayName), query) && !strings.HasPrefix(strings.ToLower(u.Email), query):
+			continue
+		}
+
+		users = append(users, r.renderUser(u.AccountID))
+	}
+
+	startAt, _, end := r.page(len(users), 1000)
+	r.json(http.StatusOK, slice(users, startAt, end))
+}
+
+// GET /rest/api/{2-3}/users/search
+func (s *Server) listUsers(r *request) {
+
+	users := make([]interface{}, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, r.renderUser(u.AccountID))
+	}
+
+	startAt, _, end := r.page(len(users), 1000)
+	r.json(http.StatusOK, slice(users, startAt, end))
+}
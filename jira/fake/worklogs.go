@@ -0,0 +1,210 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type worklogPayload struct {
+	Comment          interface{} `json:"comment"`
+	Started          string      `json:"started"`
+	TimeSpent        string      `json:"timeSpent"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds"`
+	Visibility       interface{} `json:"visibility"`
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/worklog
+//
+// The worklogs are ordered by their start, the startedAfter parameter is a Unix timestamp in milliseconds.
+func (s *Server) listWorklogs(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	after := time.UnixMilli(int64(r.intParam("startedAfter", 0)))
+
+	var worklogs []*worklog
+	for _, w := range i.Worklogs {
+		if !w.Started.Before(after) {
+			worklogs = append(worklogs, w)
+		}
+	}
+
+	slices.SortStableFunc(worklogs, func(a, b *worklog) int { return a.Started.Compare(b.Started) })
+
+	startAt, maxResults, end := r.page(len(worklogs), 5000)
+
+	rendered := []object{}
+	for _, w := range slice(worklogs, startAt, end) {
+		rendered = append(rendered, r.renderWorklog(w))
+	}
+
+	r.json(http.StatusOK, object{"startAt": startAt, "maxResults": maxResults, "total": len(worklogs), "worklogs": rendered})
+}
+
+// POST /rest/api/{2-3}/issue/{issueIdOrKey}/worklog
+func (s *Server) addWorklog(r *request) {
+
+	i := r.findIssue()
+	if i == nil {
+		return
+	}
+
+	payload := new(worklogPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	now := s.Now()
+
+	w := &worklog{Issue: i, Author: r.user.AccountID, Started: now, Created: now}
+	if !r.applyWorklog(w, payload, true) {
+		return
+	}
+
+	w.ID = s.newID()
+	i.Worklogs = append(i.Worklogs, w)
+	i.Updated = now
+
+	r.json(http.StatusCreated, r.renderWorklog(w))
+}
+
+// GET /rest/api/{2-3}/issue/{issueIdOrKey}/worklog/{id}
+func (s *Server) getWorklog(r *request) {
+
+	_, w := r.findWorklog()
+	if w == nil {
+		return
+	}
+
+	r.json(http.StatusOK, r.renderWorklog(w))
+}
+
+// PUT /rest/api/{2-3}/issue/{issueIdOrKey}/worklog/{id}
+func (s *Server) updateWorklog(r *request) {
+
+	_, w := r.findWorklog()
+	if w == nil {
+		return
+	}
+
+	payload := new(worklogPayload)
+	if !r.decode(payload) {
+		return
+	}
+
+	updated := *w
+	if !r.applyWorklog(&updated, payload, false) {
+		return
+	}
+
+	*w = updated
+	r.json(http.StatusOK, r.renderWorklog(w))
+}
+
+// DELETE /rest/api/{2-3}/issue/{issueIdOrKey}/worklog/{id}
+func (s *Server) deleteWorklog(r *request) {
+
+	i, w := r.findWorklog()
+	if w == nil {
+		return
+	}
+
+	i.Worklogs = slices.DeleteFunc(i.Worklogs, func(other *worklog) bool { return other == w })
+	r.noContent()
+}
+
+// POST /rest/api/{2-3}/worklog/list
+func (s *Server) bulkWorklogs(r *request) {
+
+	payload := new(struct {
+		IDs []int `json:"ids"`
+	})
+
+	if !r.decode(payload) {
+		return
+	}
+
+	worklogs := []object{}
+	for _, i := range s.issues {
+		for _, w := range i.Worklogs {
+			if id, _ := strconv.Atoi(w.ID); slices.Contains(payload.IDs, id) {
+				worklogs = append(worklogs, r.renderWorklog(w))
+			}
+		}
+	}
+
+	r.json(http.StatusOK, worklogs)
+}
+
+// applyWorklog applies the payload to the worklog, it writes the error response and returns false when the payload is invalid.
+// The time spent is only required when the worklog is created.
+func (r *request) applyWorklog(w *worklog, payload *worklogPayload, create bool) bool {
+
+	errors := make(map[string]string)
+
+	switch {
+	case payload.TimeSpentSeconds > 0:
+		w.Seconds = payload.TimeSpentSeconds
+	case payload.TimeSpent != "":
+		seconds, err := parseDuration(payload.TimeSpent)
+		if err != nil {
+			errors["timeLogged"] = "Invalid time duration entered."
+			break
+		}
+
+		w.Seconds = seconds
+	case create:
+		errors["timeLogged"] = "You must indicate the time spent working."
+	}
+
+	if payload.Started != "" {
+		started, err := parseTime(payload.Started)
+		if err != nil {
+			errors["started"] = "Invalid date format. Please enter the date in the format \"yyyy-MM-dd'T'HH:mm:ss.SSSZ\"."
+		}
+
+		w.Started = started
+	}
+
+	if len(errors) != 0 {
+		r.fieldErrors(errors)
+		return false
+	}
+
+	if payload.Comment != nil {
+		w.Comment = payload.Comment
+	}
+
+	if payload.Visibility != nil {
+		w.Visibility = payload.Visibility
+	}
+
+	w.Updated = r.server.Now()
+
+	return true
+}
+
+// findWorklog returns the worklog of the path with its issue, it writes the error response and returns nil when it does not exist.
+func (r *request) findWorklog() (*issue, *worklog) {
+
+	i := r.findIssue()
+	if i == nil {
+		return nil, nil
+	}
+
+	id := r.r.PathValue("id")
+	for _, w := range i.Worklogs {
+		if w.ID == id {
+			return i, w
+		}
+	}
+
+	r.error(http.StatusNotFound, "Cannot find worklog with id: '"+id+"'.")
+
+	return nil, nil
+}
@@ -26,6 +26,12 @@ type AuthenticationService struct {
 	userAgentProvided bool
 	// agent is the user agent string.
 	agent string
+
+	// source supplies the bearer token of the requests, e.g. a renewed OAuth 2.0 access token.
+	source common.TokenSource
+
+	// signer signs the requests once built, e.g. with an Atlassian Connect JWT.
+	signer common.RequestSigner
 }
 
 // SetBearerToken sets the bearer token for authentication.
@@ -78,3 +84,23 @@ func (a *AuthenticationService) GetUserAgent() string {
 func (a *AuthenticationService) HasUserAgent() bool {
 	return a.userAgentProvided
 }
+
+// SetTokenSource sets the source of the bearer token of the requests, it takes precedence over the other credentials.
+func (a *AuthenticationService) SetTokenSource(source common.TokenSource) {
+	a.source = source
+}
+
+// GetTokenSource returns the source of the bearer token of the requests.
+func (a *AuthenticationService) GetTokenSource() common.TokenSource {
+	return a.source
+}
+
+// SetRequestSigner sets the signer of the requests, e.g. a Connect JWT signer, it runs after the other credentials are set.
+func (a *AuthenticationService) SetRequestSigner(signer common.RequestSigner) {
+	a.signer = signer
+}
+
+// GetRequestSigner returns the signer of the requests.
+func (a *AuthenticationService) GetRequestSigner() common.RequestSigner {
+	return a.signer
+}
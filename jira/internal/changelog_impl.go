@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewChangelogService creates a new instance of ChangelogService.
+func NewChangelogService(client service.Connector, version string) (*ChangelogService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &ChangelogService{
+		internalClient: &internalChangelogImpl{c: client, version: version},
+	}, nil
+}
+
+// ChangelogService provides methods to read the changelogs of the Jira issues through the platform REST API (v2 and v3).
+type ChangelogService struct {
+	// internalClient is the connector interface for changelog operations.
+	internalClient jira.ChangelogConnector
+}
+
+// Gets returns a paginated list of all changelogs for an issue sorted by date, starting from the oldest.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#get-changelogs
+func (c *ChangelogService) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Gets(ctx, issueKeyOrID, startAt, maxResults)
+}
+
+// List returns the changelogs for an issue specified by a list of changelog IDs.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#get-changelogs-by-ids
+func (c *ChangelogService) List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error) {
+	return c.internalClient.List(ctx, issueKeyOrID, changelogIDs)
+}
+
+// BulkFetch returns a paginated list of the changelogs of multiple issues, optionally filtered by field IDs.
+//
+// The next page is requested by setting the NextPageToken of the payload to the token of the previous page.
+//
+// POST /rest/api/{2-3}/changelog/bulkfetch
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#bulk-fetch-changelogs
+func (c *ChangelogService) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.BulkFetch(ctx, payload)
+}
+
+type internalChangelogImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalChangelogImpl) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog?%v", i.version, issueKeyOrID, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelogs := new(model.IssueChangelogPageScheme)
+	response, err := i.c.Call(request, changelogs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelogs, response, nil
+}
+
+func (i *internalChangelogImpl) List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	if len(changelogIDs) == 0 {
+		return nil, nil, model.ErrNoChangelogIDs
+	}
+
+	payload := map[string]interface{}{"changelogIds": changelogIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog/list", i.version, issueKeyOrID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changelogs := new(model.IssueChangelogScheme)
+	response, err := i.c.Call(request, changelogs)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return changelogs, response, nil
+}
+
+func (i *internalChangelogImpl) BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.IssueIDsOrKeys) == 0 {
+		return nil, nil, model.ErrNoIssueKeysOrIDs
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/changelog/bulkfetch", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueChangelogBulkFetchPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
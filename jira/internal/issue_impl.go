@@ -14,6 +14,8 @@ import (
 type IssueServices struct {
 	// Attachment is the service for managing issue attachments.
 	Attachment *IssueAttachmentService
+	// Changelog is the service for reading issue changelogs.
+	Changelog *ChangelogService
 	// CommentRT is the service for managing rich text comments.
 	CommentRT *CommentRichTextService
 	// CommentADF is the service for managing ADF comments.
@@ -76,6 +78,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 	if services != nil {
 
 		adfService.Attachment = services.Attachment
+		adfService.Changelog = services.Changelog
 		adfService.Comment = services.CommentADF
 		adfService.Field = services.Field
 		adfService.Label = services.Label
@@ -92,6 +95,7 @@ func NewIssueService(client service.Connector, version string, services *IssueSe
 
 		richTextService.Comment = services.CommentRT
 		richTextService.Attachment = services.Attachment
+		richTextService.Changelog = services.Changelog
 		richTextService.Field = services.Field
 		richTextService.Label = services.Label
 		richTextService.Link = services.LinkRT
@@ -20,6 +20,8 @@ type IssueADFService struct {
 	internalClient jira.IssueADFConnector
 	// Attachment is the service for managing issue attachments.
 	Attachment *IssueAttachmentService
+	// Changelog is the service for reading issue changelogs.
+	Changelog *ChangelogService
 	// Comment is the service for managing ADF comments.
 	Comment *CommentADFService
 	// Field is the service for managing issue fields.
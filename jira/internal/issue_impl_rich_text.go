@@ -20,6 +20,8 @@ type IssueRichTextService struct {
 	internalClient jira.IssueRichTextConnector
 	// Attachment is the service for managing issue attachments.
 	Attachment *IssueAttachmentService
+	// Changelog is the service for reading issue changelogs.
+	Changelog *ChangelogService
 	// Comment is the service for managing rich text comments.
 	Comment *CommentRichTextService
 	// Field is the service for managing issue fields.
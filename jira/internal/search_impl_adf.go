@@ -132,7 +132,6 @@ func (i *internalSearchADFImpl) GetByJQLSearch(ctx context.Context, jql string,
 	}
 
 	endpoint := fmt.Sprintf("rest/api/%v/search/jql?%v", i.version, params.Encode())
-	fmt.Println("endpoint get by jql search", endpoint)
 
 	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
 	if err != nil {
@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewWebhookService creates a new instance of WebhookService.
+func NewWebhookService(client service.Connector, version string) (*WebhookService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &WebhookService{
+		internalClient: &internalWebhookImpl{c: client, version: version},
+	}, nil
+}
+
+// WebhookService provides methods to manage the dynamic webhooks registered by Connect and OAuth 2.0 apps.
+type WebhookService struct {
+	// internalClient is the connector interface for webhook operations.
+	internalClient jira.WebhookConnector
+}
+
+// Gets returns a paginated list of the webhooks registered by the calling app.
+//
+// GET /rest/api/{2-3}/webhook
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-get
+func (w *WebhookService) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Gets(ctx, startAt, maxResults)
+}
+
+// Register registers webhooks, the result of each registration is returned in the order of the payload webhooks.
+//
+// POST /rest/api/{2-3}/webhook
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-post
+func (w *WebhookService) Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Register(ctx, payload)
+}
+
+// Delete removes webhooks by ID. Only webhooks registered by the calling app are removed.
+//
+// DELETE /rest/api/{2-3}/webhook
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-delete
+func (w *WebhookService) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, webhookIDs)
+}
+
+// Refresh extends the life of webhooks, they are removed 30 days after their registration unless refreshed.
+//
+// PUT /rest/api/{2-3}/webhook/refresh
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-refresh-put
+func (w *WebhookService) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Refresh(ctx, webhookIDs)
+}
+
+// Failed returns the webhooks that failed to be delivered in the last 72 hours, sorted by failure time.
+//
+// after is the failure time, in epoch milliseconds, after which the failed webhooks are returned, zero means no limit.
+//
+// GET /rest/api/{2-3}/webhook/failed
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-failed-get
+func (w *WebhookService) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Failed(ctx, maxResults, after)
+}
+
+type internalWebhookImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalWebhookImpl) Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks := new(model.WebhookPageScheme)
+	response, err := i.c.Call(request, webhooks)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhooks, response, nil
+}
+
+func (i *internalWebhookImpl) Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.Webhooks) == 0 {
+		return nil, nil, model.ErrNoWebhookPayload
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(model.WebhookRegistrationResultScheme)
+	response, err := i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+func (i *internalWebhookImpl) Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, model.ErrNoWebhookIDs
+	}
+
+	payload := map[string]interface{}{"webhookIds": webhookIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/webhook", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWebhookImpl) Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, nil, model.ErrNoWebhookIDs
+	}
+
+	payload := map[string]interface{}{"webhookIds": webhookIDs}
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/refresh", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expiration := new(model.WebhookExpirationScheme)
+	response, err := i.c.Call(request, expiration)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return expiration, response, nil
+}
+
+func (i *internalWebhookImpl) Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if after > 0 {
+		params.Add("after", strconv.FormatInt(after, 10))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/webhook/failed?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks := new(model.FailedWebhookPageScheme)
+	response, err := i.c.Call(request, webhooks)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return webhooks, response, nil
+}
@@ -5,8 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -53,6 +52,9 @@ func New(httpClient common.HTTPClient, site string, config *model.ClientConfig)
 		MaxRetries:        config.MaxRetries,
 		InitialRetryDelay: config.InitialRetryDelay,
 		MaxRetryDelay:     config.MaxRetryDelay,
+		RateLimiter:       common.RateLimiterFromConfig(u, config),
+		Logger:            config.Logger,
+		Debug:             config.Debug,
 	}
 
 	client.Auth = internal.NewAuthenticationService(client)
@@ -97,6 +99,11 @@ type Client struct {
 	MaxRetries        int
 	InitialRetryDelay time.Duration
 	MaxRetryDelay     time.Duration
+	RetryPolicy       common.RetryPolicy
+	RateLimiter       *common.RateLimiter
+	Instrumentation   common.Instrumentation
+	Logger            *slog.Logger
+	Debug             bool
 	Auth              common.Authentication
 	Customer          *internal.CustomerService
 	Info              *internal.InfoService
@@ -114,7 +121,13 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		return nil, err
 	}
 
-	u := c.Site.ResolveReference(rel)
+	// The requests authorized by an OAuth 2.0 token source are routed through the API gateway.
+	site, err := common.ResolveSite(ctx, c.Auth, c.Site, "jira")
+	if err != nil {
+		return nil, err
+	}
+
+	u := site.ResolveReference(rel)
 
 	buf := new(bytes.Buffer)
 	if body != nil {
@@ -165,97 +178,30 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 	return req, nil
 }
 
+// Call executes an API request through the shared transport and returns the response.
 func (c *Client) Call(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
-	retryCount := 0
-	ctx := request.Context()
 
-	for {
-		response, err := c.HTTP.Do(request)
-		if err != nil {
-			return nil, err
-		}
-
-		// If rate limit exceeded, sleep with exponential backoff
-		if response.StatusCode == http.StatusTooManyRequests {
-			delay := c.InitialRetryDelay
-			// Use bit shifting for exponential backoff (1 << retryCount)
-			delay = delay * (1 << uint(retryCount))
-			if delay > c.MaxRetryDelay {
-				delay = c.MaxRetryDelay
-			}
-			log.Printf("Rate limit exceeded, sleeping for %v request %v", delay, request.URL.String())
-
-			// Get timer
-			timer := time.NewTimer(delay)
-			defer timer.Stop()
-
-			// Wait for either context cancellation or timer
-			select {
-			case <-ctx.Done():
-				timer.Stop()
-				return nil, ctx.Err()
-			case <-timer.C:
-				log.Printf("Timer completed successfully")
-				// Timer completed successfully
-			}
-
-			retryCount++
-			if retryCount > c.MaxRetries {
-				return c.processResponse(response, structure)
-			}
-			continue
-		}
-
-		return c.processResponse(response, structure)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
 	}
-}
-
-func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
 
-	defer response.Body.Close()
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "servicemanagement"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
 
-	res := &model.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
-
-	responseAsBytes, err := io.ReadAll(response.Body)
+	response, err := transport.Do(request)
 	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, model.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, model.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, model.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, model.ErrBadRequest
-
-		default:
-			return res, model.ErrInvalidStatusCode
-		}
+		return nil, err
 	}
 
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
+	return c.processResponse(response, structure)
+}
 
-	return res, nil
+func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
+	return common.ProcessResponse(response, structure)
 }
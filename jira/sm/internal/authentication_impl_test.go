@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/connect"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/oauth"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
@@ -452,3 +455,30 @@ func TestAuthenticationService_SetExperimentalFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthenticationService_SetTokenSource(t *testing.T) {
+
+	source := oauth.NewTokenSource(&oauth.Config{}, &oauth.Token{AccessToken: "access-token"}, nil)
+
+	a := &AuthenticationService{}
+	assert.Nil(t, a.GetTokenSource())
+
+	a.SetTokenSource(source)
+	assert.Equal(t, source, a.GetTokenSource())
+
+	token, err := a.GetTokenSource().BearerToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", token)
+}
+
+func TestAuthenticationService_SetRequestSigner(t *testing.T) {
+
+	signer, err := connect.NewSigner("app-key", "shared-secret", "https://your-domain.atlassian.net")
+	assert.NoError(t, err)
+
+	a := &AuthenticationService{}
+	assert.Nil(t, a.GetRequestSigner())
+
+	a.SetRequestSigner(signer)
+	assert.Equal(t, signer, a.GetRequestSigner())
+}
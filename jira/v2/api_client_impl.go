@@ -5,8 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -57,6 +56,9 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 		MaxRetries:        config.MaxRetries,
 		InitialRetryDelay: config.InitialRetryDelay,
 		MaxRetryDelay:     config.MaxRetryDelay,
+		RateLimiter:       common.RateLimiterFromConfig(u, config),
+		Logger:            config.Logger,
+		Debug:             config.Debug,
 	}
 
 	client.Auth = internal.NewAuthenticationService(client)
@@ -196,6 +198,11 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 		return nil, err
 	}
 
+	changelog, err := internal.NewChangelogService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	vote, err := internal.NewVoteService(client, APIVersion)
 	if err != nil {
 		return nil, err
@@ -218,6 +225,7 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 
 	issueServices := &internal.IssueServices{
 		Attachment:      issueAttachmentService,
+		Changelog:       changelog,
 		CommentRT:       commentService,
 		Field:           issueFieldService,
 		Label:           label,
@@ -243,6 +251,11 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 		return nil, err
 	}
 
+	webhook, err := internal.NewWebhookService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	permissionSchemeGrant, err := internal.NewPermissionSchemeGrantService(client, APIVersion)
 	if err != nil {
 		return nil, err
@@ -410,6 +423,7 @@ func New(httpClient common.HTTPClient, site string, config *models.ClientConfig)
 	client.JQL = jql
 	client.NotificationScheme = projectNotificationScheme
 	client.Team = internal.NewTeamService(client)
+	client.Webhook = webhook
 
 	return client, nil
 }
@@ -421,6 +435,11 @@ type Client struct {
 	MaxRetries         int
 	InitialRetryDelay  time.Duration
 	MaxRetryDelay      time.Duration
+	RetryPolicy        common.RetryPolicy
+	RateLimiter        *common.RateLimiter
+	Instrumentation    common.Instrumentation
+	Logger             *slog.Logger
+	Debug              bool
 	Role               *internal.ApplicationRoleService
 	Banner             *internal.AnnouncementBannerService
 	Audit              *internal.AuditRecordService
@@ -440,6 +459,7 @@ type Client struct {
 	JQL                *internal.JQLService
 	NotificationScheme *internal.NotificationSchemeService
 	Team               *internal.TeamService
+	Webhook            *internal.WebhookService
 }
 
 // NewRequest creates an API request.
@@ -450,7 +470,13 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		return nil, err
 	}
 
-	u := c.Site.ResolveReference(rel)
+	// The requests authorized by an OAuth 2.0 token source are routed through the API gateway.
+	site, err := common.ResolveSite(ctx, c.Auth, c.Site, "jira")
+	if err != nil {
+		return nil, err
+	}
+
+	u := site.ResolveReference(rel)
 
 	buf := new(bytes.Buffer)
 	if body != nil {
@@ -496,104 +522,31 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 
 	return req, nil
 }
-func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
-	retryCount := 0
-	ctx := request.Context()
-
-	for {
-		response, err := c.HTTP.Do(request)
-		if err != nil {
-			return nil, err
-		}
 
-		// If rate limit exceeded, sleep with exponential backoff
-		if response.StatusCode == http.StatusTooManyRequests {
-			delay := c.InitialRetryDelay
-			// Use bit shifting for exponential backoff (1 << retryCount)
-			delay = delay * (1 << uint(retryCount))
-			if delay > c.MaxRetryDelay {
-				delay = c.MaxRetryDelay
-			}
-			h := response.Header
-			log.Printf("Rate limit exceeded (Retry-After=%q X-RateLimit-Limit=%q X-RateLimit-Remaining=%q X-RateLimit-Reset=%q RateLimit-Reason=%q), sleeping for %v request %v",
-				h.Get("Retry-After"),
-				h.Get("X-RateLimit-Limit"),
-				h.Get("X-RateLimit-Remaining"),
-				h.Get("X-RateLimit-Reset"),
-				h.Get("RateLimit-Reason"),
-				delay, request.URL.String())
-
-			// Get timer
-			timer := time.NewTimer(delay)
-			defer timer.Stop()
-
-			// Wait for either context cancellation or timer
-			select {
-			case <-ctx.Done():
-				timer.Stop()
-				return nil, ctx.Err()
-			case <-timer.C:
-				log.Printf("Timer completed successfully")
-				// Timer completed successfully
-			}
-
-			retryCount++
-			if retryCount > c.MaxRetries {
-				return c.processResponse(response, structure)
-			}
-			continue
-		}
+// Call executes an API request through the shared transport and returns the response.
+func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
 
-		return c.processResponse(response, structure)
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = common.NewRetryPolicy(c.MaxRetries, c.InitialRetryDelay, c.MaxRetryDelay)
 	}
-}
 
-func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
+	transport := common.NewTransport(c.HTTP, policy)
+	transport.Limiter = c.RateLimiter
+	transport.Instrumentation = c.Instrumentation
+	transport.Product = "jira"
+	transport.Logger = c.Logger
+	transport.Debug = c.Debug
+	transport.Auth = c.Auth
 
-	defer response.Body.Close()
-
-	res := &models.ResponseScheme{
-		Response: response,
-		Code:     response.StatusCode,
-		Endpoint: response.Request.URL.String(),
-		Method:   response.Request.Method,
-	}
-
-	responseAsBytes, err := io.ReadAll(response.Body)
+	response, err := transport.Do(request)
 	if err != nil {
-		return res, err
-	}
-
-	res.Bytes.Write(responseAsBytes)
-
-	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
-
-	if !wasSuccess {
-
-		switch response.StatusCode {
-
-		case http.StatusNotFound:
-			return res, models.ErrNotFound
-
-		case http.StatusUnauthorized:
-			return res, models.ErrUnauthorized
-
-		case http.StatusInternalServerError:
-			return res, models.ErrInternal
-
-		case http.StatusBadRequest:
-			return res, models.ErrBadRequest
-
-		default:
-			return res, models.ErrInvalidStatusCode
-		}
+		return nil, err
 	}
 
-	if structure != nil {
-		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
-			return res, err
-		}
-	}
+	return c.processResponse(response, structure)
+}
 
-	return res, nil
+func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
+	return common.ProcessResponse(response, structure)
 }
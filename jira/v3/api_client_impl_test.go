@@ -6,14 +6,17 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/oauth"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -556,3 +559,36 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Call_TokenSource(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": "cloud-id", "url": "https://your-domain.atlassian.net", "name": "your-domain"}]`))
+	}))
+	defer server.Close()
+
+	config := &oauth.Config{HTTPClient: server.Client(), ResourcesURL: server.URL}
+
+	httpClient := mocks.NewHTTPClient(t)
+
+	client, err := New(httpClient, "https://your-domain.atlassian.net", nil)
+	assert.NoError(t, err)
+
+	client.Auth.SetBearerToken("static-token")
+	client.Auth.(common.TokenSourceAuthentication).SetTokenSource(oauth.NewTokenSource(config, &oauth.Token{AccessToken: "access-token"}, nil))
+
+	request, err := client.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", "", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/cloud-id/rest/api/3/myself", request.URL.String())
+
+	// The bearer token of the token source is set by the transport, replacing the static one.
+	httpClient.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("Authorization") == "Bearer access-token"
+	})).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Request: request}, nil).
+		Once()
+
+	_, err = client.Call(request, nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,111 @@
+// Package webhook decodes the webhooks sent by Jira and dispatches them to typed callbacks.
+//
+// The issue, comment and worklog payloads are decoded into the v3 models. Jira sends the rich text fields of the
+// webhooks as plain text, so they are wrapped into an ADF document made of one paragraph per line,
+// the original payload remains available through Header.Raw.
+package webhook
+
+import (
+	"encoding/json"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The webhook events decoded into typed events.
+const (
+	IssueCreated = "jira:issue_created"
+	IssueUpdated = "jira:issue_updated"
+	IssueDeleted = "jira:issue_deleted"
+
+	CommentCreated = "comment_created"
+	CommentUpdated = "comment_updated"
+	CommentDeleted = "comment_deleted"
+
+	WorklogCreated = "worklog_created"
+	WorklogUpdated = "worklog_updated"
+	WorklogDeleted = "worklog_deleted"
+
+	SprintCreated = "sprint_created"
+	SprintUpdated = "sprint_updated"
+	SprintDeleted = "sprint_deleted"
+	SprintStarted = "sprint_started"
+	SprintClosed  = "sprint_closed"
+
+	BoardCreated              = "board_created"
+	BoardUpdated              = "board_updated"
+	BoardDeleted              = "board_deleted"
+	BoardConfigurationChanged = "board_configuration_changed"
+)
+
+var (
+	// IssueEvents lists the events decoded as an IssueEvent.
+	IssueEvents = []string{IssueCreated, IssueUpdated, IssueDeleted}
+	// CommentEvents lists the events decoded as a CommentEvent.
+	CommentEvents = []string{CommentCreated, CommentUpdated, CommentDeleted}
+	// WorklogEvents lists the events decoded as a WorklogEvent.
+	WorklogEvents = []string{WorklogCreated, WorklogUpdated, WorklogDeleted}
+	// SprintEvents lists the events decoded as a SprintEvent.
+	SprintEvents = []string{SprintCreated, SprintUpdated, SprintDeleted, SprintStarted, SprintClosed}
+	// BoardEvents lists the events decoded as a BoardEvent.
+	BoardEvents = []string{BoardCreated, BoardUpdated, BoardDeleted, BoardConfigurationChanged}
+)
+
+// Event is implemented by every decoded webhook event.
+type Event interface {
+	// EventName returns the webhookEvent of the payload, e.g. jira:issue_updated.
+	EventName() string
+}
+
+// Header holds the attributes shared by every webhook payload.
+type Header struct {
+	Timestamp    int64           `json:"timestamp,omitempty"`    // The time the event occurred, in epoch milliseconds.
+	WebhookEvent string          `json:"webhookEvent,omitempty"` // The name of the event.
+	Raw          json.RawMessage `json:"-"`                      // The payload as sent by Jira.
+}
+
+// EventName returns the name of the event.
+func (h *Header) EventName() string {
+	return h.WebhookEvent
+}
+
+// IssueEvent is sent when an issue is created, updated or deleted.
+type IssueEvent struct {
+	Header
+	IssueEventTypeName string                             `json:"issue_event_type_name,omitempty"` // The issue event type, e.g. issue_generic or issue_assigned.
+	User               *model.UserScheme                  `json:"user,omitempty"`                  // The user who triggered the event.
+	Issue              *model.IssueScheme                 `json:"issue,omitempty"`                 // The issue.
+	Changelog          *model.IssueChangelogHistoryScheme `json:"changelog,omitempty"`             // The changes applied by the update, only set on jira:issue_updated.
+	Comment            *model.IssueCommentScheme          `json:"comment,omitempty"`               // The comment added along with the update, when any.
+}
+
+// CommentEvent is sent when a comment is created, updated or deleted.
+type CommentEvent struct {
+	Header
+	Comment *model.IssueCommentScheme `json:"comment,omitempty"` // The comment.
+	Issue   *model.IssueScheme        `json:"issue,omitempty"`   // The issue of the comment, with a subset of its fields.
+}
+
+// WorklogEvent is sent when a worklog is created, updated or deleted.
+type WorklogEvent struct {
+	Header
+	Worklog *model.IssueWorklogADFScheme `json:"worklog,omitempty"` // The worklog.
+}
+
+// SprintEvent is sent when a sprint is created, updated, deleted, started or closed.
+type SprintEvent struct {
+	Header
+	Sprint   *model.SprintScheme `json:"sprint,omitempty"`   // The sprint.
+	OldValue *model.SprintScheme `json:"oldValue,omitempty"` // The sprint before the update, only set on sprint_updated.
+}
+
+// BoardEvent is sent when a board is created, updated, deleted or its configuration changes.
+type BoardEvent struct {
+	Header
+	Board         *model.BoardScheme              `json:"board,omitempty"`         // The board.
+	Configuration *model.BoardConfigurationScheme `json:"configuration,omitempty"` // The board configuration, only set on board_configuration_changed.
+}
+
+// UnknownEvent is returned for the events without a typed representation, the payload is available through Header.Raw.
+type UnknownEvent struct {
+	Header
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/internal/dispatch"
+)
+
+// DefaultMaxBodyBytes is the maximum size of the payloads accepted by the Handler when MaxBodyBytes is not set.
+const DefaultMaxBodyBytes = dispatch.DefaultMaxBodyBytes
+
+// Callback is called with the decoded event, an error makes the Handler reply with a 500 so Jira retries the delivery.
+type Callback func(ctx context.Context, event Event) error
+
+// Handler is an http.Handler decoding the Jira webhooks and dispatching them to the registered callbacks.
+//
+// The callbacks of an event are called sequentially, in registration order, and the first error stops the dispatch.
+// The handler replies with:
+//
+//  1. 200 once the callbacks succeeded, or when no callback is registered for the event.
+//  2. 400 when the payload cannot be decoded.
+//  3. 405 when the request is not a POST.
+//  4. 413 when the payload is larger than MaxBodyBytes.
+//  5. 500 when a callback fails, the error is logged and not sent back to Jira.
+type Handler struct {
+	// MaxBodyBytes limits the size of the payloads, DefaultMaxBodyBytes is used when zero.
+	MaxBodyBytes int64
+	// Logger receives the errors of the callbacks, they are discarded when nil.
+	Logger *slog.Logger
+
+	dispatcher dispatch.Dispatcher[Event]
+}
+
+// NewHandler creates a Handler without callbacks.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// On registers a callback for the events with the given names.
+func (h *Handler) On(callback Callback, names ...string) {
+	h.dispatcher.On(callback, names...)
+}
+
+// OnAny registers a callback for every event, including the events without a typed representation.
+func (h *Handler) OnAny(callback Callback) {
+	h.dispatcher.OnAny(callback)
+}
+
+// OnIssue registers a callback for the given issue events, or for all of them when no name is provided.
+func (h *Handler) OnIssue(callback func(ctx context.Context, event *IssueEvent) error, names ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(names, IssueEvents)...)
+}
+
+// OnComment registers a callback for the given comment events, or for all of them when no name is provided.
+func (h *Handler) OnComment(callback func(ctx context.Context, event *CommentEvent) error, names ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(names, CommentEvents)...)
+}
+
+// OnWorklog registers a callback for the given worklog events, or for all of them when no name is provided.
+func (h *Handler) OnWorklog(callback func(ctx context.Context, event *WorklogEvent) error, names ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(names, WorklogEvents)...)
+}
+
+// OnSprint registers a callback for the given sprint events, or for all of them when no name is provided.
+func (h *Handler) OnSprint(callback func(ctx context.Context, event *SprintEvent) error, names ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(names, SprintEvents)...)
+}
+
+// OnBoard registers a callback for the given board events, or for all of them when no name is provided.
+func (h *Handler) OnBoard(callback func(ctx context.Context, event *BoardEvent) error, names ...string) {
+	h.On(dispatch.Typed[Event](callback), dispatch.Names(names, BoardEvents)...)
+}
+
+// ServeHTTP decodes the webhook and dispatches it to the callbacks registered for its event.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	dispatch.Serve(w, r, h.MaxBodyBytes, h.Logger, func(_ *http.Request, payload []byte) (Event, error) { return Parse(payload) }, h.Dispatch)
+}
+
+// Dispatch calls the callbacks registered for the event, followed by the callbacks registered with OnAny.
+func (h *Handler) Dispatch(ctx context.Context, event Event) error {
+	return h.dispatcher.Dispatch(ctx, event.EventName(), event)
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		method     string
+		body       string
+		register   func(*Handler, *[]string)
+		maxBytes   int64
+		wantStatus int
+		wantCalls  []string
+	}{
+		{
+			name:   "when the event is dispatched to the typed callbacks",
+			method: http.MethodPost,
+			body:   issueUpdatedPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnIssue(func(_ context.Context, event *IssueEvent) error {
+					*calls = append(*calls, "issue:"+event.Issue.Key)
+					return nil
+				}, IssueUpdated)
+
+				handler.OnIssue(func(_ context.Context, event *IssueEvent) error {
+					*calls = append(*calls, "created:"+event.Issue.Key)
+					return nil
+				}, IssueCreated)
+
+				handler.OnComment(func(_ context.Context, event *CommentEvent) error {
+					*calls = append(*calls, "comment")
+					return nil
+				})
+
+				handler.OnAny(func(_ context.Context, event Event) error {
+					*calls = append(*calls, "any:"+event.EventName())
+					return nil
+				})
+			},
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"issue:KP-2", "any:jira:issue_updated"},
+		},
+		{
+			name:       "when no callback is registered for the event",
+			method:     http.MethodPost,
+			body:       `{"webhookEvent": "jira:version_released"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "when a callback fails",
+			method: http.MethodPost,
+			body:   issueUpdatedPayload,
+			register: func(handler *Handler, calls *[]string) {
+
+				handler.OnIssue(func(_ context.Context, event *IssueEvent) error {
+					*calls = append(*calls, "failing")
+					return errors.New("unable to store the issue")
+				})
+
+				handler.OnIssue(func(_ context.Context, event *IssueEvent) error {
+					*calls = append(*calls, "skipped")
+					return nil
+				})
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantCalls:  []string{"failing"},
+		},
+		{
+			name:       "when the payload cannot be decoded",
+			method:     http.MethodPost,
+			body:       `{"timestamp": 1714999999000}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "when the payload is too large",
+			method:     http.MethodPost,
+			body:       issueUpdatedPayload,
+			maxBytes:   64,
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "when the method is not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var calls []string
+
+			handler := NewHandler()
+			handler.MaxBodyBytes = testCase.maxBytes
+
+			if testCase.register != nil {
+				testCase.register(handler, &calls)
+			}
+
+			request := httptest.NewRequest(testCase.method, "/webhooks/jira", strings.NewReader(testCase.body))
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.wantStatus, recorder.Code)
+			assert.Equal(t, testCase.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// textPaths lists the rich text attributes sent as plain text by the webhooks, "*" walking every item of an array.
+var textPaths = [][]string{
+	{"issue", "fields", "description"},
+	{"issue", "fields", "environment"},
+	{"issue", "fields", "comment", "comments", "*", "body"},
+	{"issue", "fields", "worklog", "worklogs", "*", "comment"},
+	{"comment", "body"},
+	{"worklog", "comment"},
+}
+
+// Parse decodes a webhook payload into its typed event.
+//
+// The events without a typed representation are returned as an *UnknownEvent.
+func Parse(payload []byte) (Event, error) {
+
+	header := new(Header)
+	if err := json.Unmarshal(payload, header); err != nil {
+		return nil, err
+	}
+
+	if header.WebhookEvent == "" {
+		return nil, model.ErrNoWebhookEvent
+	}
+
+	header.Raw = append(json.RawMessage(nil), payload...)
+
+	var event Event
+	switch name := header.WebhookEvent; {
+	case slices.Contains(IssueEvents, name):
+		event = &IssueEvent{Header: *header}
+	case slices.Contains(CommentEvents, name):
+		event = &CommentEvent{Header: *header}
+	case slices.Contains(WorklogEvents, name):
+		event = &WorklogEvent{Header: *header}
+	case slices.Contains(SprintEvents, name):
+		event = &SprintEvent{Header: *header}
+	case slices.Contains(BoardEvents, name):
+		event = &BoardEvent{Header: *header}
+	default:
+		return &UnknownEvent{Header: *header}, nil
+	}
+
+	normalized := json.RawMessage(payload)
+	for _, path := range textPaths {
+		normalized = rewriteText(normalized, path)
+	}
+
+	if err := json.Unmarshal(normalized, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// rewriteText replaces the plain text value found at path with its ADF representation.
+// The payload is returned untouched when the path does not exist or the value is not a string.
+func rewriteText(raw json.RawMessage, path []string) json.RawMessage {
+
+	if len(path) == 0 {
+		return textToADF(raw)
+	}
+
+	if path[0] == "*" {
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return raw
+		}
+
+		for index, item := range items {
+			items[index] = rewriteText(item, path[1:])
+		}
+
+		return marshalOr(items, raw)
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+		return raw
+	}
+
+	value, ok := object[path[0]]
+	if !ok {
+		return raw
+	}
+
+	object[path[0]] = rewriteText(value, path[1:])
+
+	return marshalOr(object, raw)
+}
+
+// textToADF wraps a JSON string into an ADF document made of one paragraph per line.
+func textToADF(raw json.RawMessage) json.RawMessage {
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		return raw
+	}
+
+	document := &model.CommentNodeScheme{Version: 1, Type: "doc"}
+
+	if text != "" {
+		for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+
+			paragraph := &model.CommentNodeScheme{Type: "paragraph"}
+			if line != "" {
+				paragraph.AppendNode(&model.CommentNodeScheme{Type: "text", Text: line})
+			}
+
+			document.AppendNode(paragraph)
+		}
+	}
+
+	return marshalOr(document, raw)
+}
+
+func marshalOr(value interface{}, fallback json.RawMessage) json.RawMessage {
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fallback
+	}
+
+	return encoded
+}
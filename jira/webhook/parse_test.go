@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const issueUpdatedPayload = `{
+	"timestamp": 1714999999000,
+	"webhookEvent": "jira:issue_updated",
+	"issue_event_type_name": "issue_generic",
+	"user": {"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Carlos Treminio"},
+	"issue": {
+		"id": "10002",
+		"key": "KP-2",
+		"fields": {
+			"summary": "Migrate the reporting jobs",
+			"description": "First line\nSecond line",
+			"status": {"name": "In Progress"},
+			"comment": {"comments": [{"id": "10000", "body": "Looks good"}], "total": 1}
+		}
+	},
+	"changelog": {
+		"id": "10104",
+		"items": [{"field": "status", "fieldtype": "jira", "fieldId": "status", "from": "10000", "fromString": "To Do", "to": "3", "toString": "In Progress"}]
+	}
+}`
+
+func paragraph(text string) *model.CommentNodeScheme {
+
+	node := &model.CommentNodeScheme{Type: "paragraph"}
+	if text != "" {
+		node.AppendNode(&model.CommentNodeScheme{Type: "text", Text: text})
+	}
+
+	return node
+}
+
+func TestParse(t *testing.T) {
+
+	t.Run("when the issue is updated", func(t *testing.T) {
+
+		event, err := Parse([]byte(issueUpdatedPayload))
+		assert.NoError(t, err)
+
+		issueEvent, ok := event.(*IssueEvent)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, IssueUpdated, issueEvent.EventName())
+		assert.Equal(t, int64(1714999999000), issueEvent.Timestamp)
+		assert.Equal(t, "issue_generic", issueEvent.IssueEventTypeName)
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", issueEvent.User.AccountID)
+		assert.Equal(t, "KP-2", issueEvent.Issue.Key)
+		assert.Equal(t, "Migrate the reporting jobs", issueEvent.Issue.Fields.Summary)
+		assert.Equal(t, "In Progress", issueEvent.Issue.Fields.Status.Name)
+
+		assert.Equal(t, &model.CommentNodeScheme{
+			Version: 1,
+			Type:    "doc",
+			Content: []*model.CommentNodeScheme{paragraph("First line"), paragraph("Second line")},
+		}, issueEvent.Issue.Fields.Description)
+
+		assert.Equal(t, []*model.CommentNodeScheme{paragraph("Looks good")}, issueEvent.Issue.Fields.Comment.Comments[0].Body.Content)
+
+		assert.Equal(t, "10104", issueEvent.Changelog.ID)
+		assert.Equal(t, "To Do", issueEvent.Changelog.Items[0].FromString)
+		assert.Equal(t, "In Progress", issueEvent.Changelog.Items[0].ToString)
+		assert.JSONEq(t, issueUpdatedPayload, string(issueEvent.Raw))
+	})
+
+	t.Run("when a comment is created", func(t *testing.T) {
+
+		event, err := Parse([]byte(`{
+			"timestamp": 1714999999000,
+			"webhookEvent": "comment_created",
+			"comment": {"id": "10001", "body": "Deployed to staging", "author": {"accountId": "5b10ac8d82e05b22cc7d4ef5"}},
+			"issue": {"id": "10002", "key": "KP-2", "fields": {"summary": "Migrate the reporting jobs"}}
+		}`))
+		assert.NoError(t, err)
+
+		commentEvent, ok := event.(*CommentEvent)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, "10001", commentEvent.Comment.ID)
+		assert.Equal(t, []*model.CommentNodeScheme{paragraph("Deployed to staging")}, commentEvent.Comment.Body.Content)
+		assert.Equal(t, "KP-2", commentEvent.Issue.Key)
+	})
+
+	t.Run("when a worklog is updated", func(t *testing.T) {
+
+		event, err := Parse([]byte(`{
+			"timestamp": 1714999999000,
+			"webhookEvent": "worklog_updated",
+			"worklog": {"id": "10100", "issueId": "10002", "timeSpent": "3h", "timeSpentSeconds": 10800, "comment": "Pairing session"}
+		}`))
+		assert.NoError(t, err)
+
+		worklogEvent, ok := event.(*WorklogEvent)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, 10800, worklogEvent.Worklog.TimeSpentSeconds)
+		assert.Equal(t, []*model.CommentNodeScheme{paragraph("Pairing session")}, worklogEvent.Worklog.Comment.Content)
+	})
+
+	t.Run("when a sprint is started", func(t *testing.T) {
+
+		event, err := Parse([]byte(`{
+			"timestamp": 1714999999000,
+			"webhookEvent": "sprint_started",
+			"sprint": {"id": 7, "state": "active", "name": "KP Sprint 7", "startDate": "2024-05-06T08:00:00.000Z", "originBoardId": 4}
+		}`))
+		assert.NoError(t, err)
+
+		sprintEvent, ok := event.(*SprintEvent)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, "KP Sprint 7", sprintEvent.Sprint.Name)
+		assert.Equal(t, time.Date(2024, time.May, 6, 8, 0, 0, 0, time.UTC), sprintEvent.Sprint.StartDate)
+	})
+
+	t.Run("when a board is created", func(t *testing.T) {
+
+		event, err := Parse([]byte(`{"timestamp": 1714999999000, "webhookEvent": "board_created", "board": {"id": 4, "name": "KP board", "type": "scrum"}}`))
+		assert.NoError(t, err)
+
+		boardEvent, ok := event.(*BoardEvent)
+		if !assert.True(t, ok) {
+			return
+		}
+
+		assert.Equal(t, 4, boardEvent.Board.ID)
+		assert.Equal(t, "scrum", boardEvent.Board.Type)
+	})
+
+	t.Run("when the event has no typed representation", func(t *testing.T) {
+
+		event, err := Parse([]byte(`{"timestamp": 1714999999000, "webhookEvent": "jira:version_released", "version": {"id": "10000"}}`))
+		assert.NoError(t, err)
+		assert.IsType(t, &UnknownEvent{}, event)
+		assert.Equal(t, "jira:version_released", event.EventName())
+	})
+
+	t.Run("when the webhook event is not set", func(t *testing.T) {
+
+		_, err := Parse([]byte(`{"timestamp": 1714999999000}`))
+		assert.ErrorIs(t, err, model.ErrNoWebhookEvent)
+	})
+
+	t.Run("when the payload is not a json document", func(t *testing.T) {
+
+		_, err := Parse([]byte(`<html></html>`))
+		assert.Error(t, err)
+	})
+}
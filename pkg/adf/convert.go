@@ -0,0 +1,68 @@
+package adf
+
+import (
+	"maps"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// CommentNode converts the node to the models.CommentNodeScheme used by the Jira v3 payloads,
+// e.g. the issue descriptions, the comments and the worklogs.
+func (n *Node) CommentNode() *model.CommentNodeScheme {
+
+	if n == nil {
+		return nil
+	}
+
+	node := &model.CommentNodeScheme{
+		Version: n.Version,
+		Type:    string(n.Type),
+		Text:    n.Text,
+		Attrs:   maps.Clone(n.Attrs),
+	}
+
+	for _, child := range n.Content {
+		node.Content = append(node.Content, child.CommentNode())
+	}
+
+	for _, mark := range n.Marks {
+
+		if mark == nil {
+			continue
+		}
+
+		node.Marks = append(node.Marks, &model.MarkScheme{Type: string(mark.Type), Attrs: maps.Clone(mark.Attrs)})
+	}
+
+	return node
+}
+
+// FromCommentNode converts a models.CommentNodeScheme, e.g. the description of an issue, to a node.
+func FromCommentNode(node *model.CommentNodeScheme) *Node {
+
+	if node == nil {
+		return nil
+	}
+
+	converted := &Node{
+		Version: node.Version,
+		Type:    NodeType(node.Type),
+		Text:    node.Text,
+		Attrs:   maps.Clone(node.Attrs),
+	}
+
+	for _, child := range node.Content {
+		converted.Content = append(converted.Content, FromCommentNode(child))
+	}
+
+	for _, mark := range node.Marks {
+
+		if mark == nil {
+			continue
+		}
+
+		converted.Marks = append(converted.Marks, &Mark{Type: MarkType(mark.Type), Attrs: maps.Clone(mark.Attrs)})
+	}
+
+	return converted
+}
@@ -0,0 +1,247 @@
+package adf
+
+import (
+	"slices"
+	"strings"
+)
+
+// CanContain reports if the ADF schema allows the parent node type to contain the child node type.
+func CanContain(parent, child NodeType) bool {
+
+	r, ok := rules[parent]
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(r.content, child)
+}
+
+// Fit returns the content with the nodes the parent cannot contain replaced by allowed equivalents,
+// it is used by the converters since the other formats nest their blocks more freely than ADF,
+// e.g. a Markdown list item may hold a quote or a heading, which an ADF list item cannot:
+//
+//   - a heading becomes a paragraph of strong text;
+//   - a quote, a panel or an expand is replaced by its content, the expand becomes a nested expand where allowed;
+//   - a table becomes a paragraph per row, its cells separated by " | ";
+//   - a task list becomes a bullet list of "[ ]" and "[x]" items, or paragraphs;
+//   - a code block becomes a paragraph of code, a rule is dropped;
+//   - the inline nodes are wrapped in paragraphs, the blocks in an inline container are replaced by their text.
+//
+// A list item starting with another node than a paragraph, a code block or a single media gets an empty paragraph first.
+// The content is returned unchanged when the parent can contain all of it.
+func Fit(parent NodeType, content ...*Node) []*Node {
+
+	fitted := fit(parent, content...)
+
+	if r := rules[parent]; r != nil && len(r.first) != 0 && len(fitted) != 0 && !slices.Contains(r.first, fitted[0].Type) {
+		fitted = append([]*Node{Paragraph()}, fitted...)
+	}
+
+	return fitted
+}
+
+// fit replaces the nodes the parent cannot contain, the substitutes are fitted recursively.
+func fit(parent NodeType, content ...*Node) []*Node {
+
+	var fitted []*Node
+
+	for _, child := range content {
+
+		if child == nil {
+			continue
+		}
+
+		if CanContain(parent, child.Type) {
+			fitted = append(fitted, child)
+			continue
+		}
+
+		fitted = append(fitted, substitute(parent, child)...)
+	}
+
+	return wrapInline(parent, fitted)
+}
+
+// substitute returns the nodes replacing a node the parent cannot contain.
+func substitute(parent NodeType, node *Node) []*Node {
+
+	if inlineContainer(parent) {
+		return flatten(node)
+	}
+
+	switch node.Type {
+	case NodeHeading:
+		return fit(parent, Paragraph(strong(node.Content)...))
+
+	case NodeExpand, NodeNestedExpand:
+
+		for _, nodeType := range []NodeType{NodeExpand, NodeNestedExpand} {
+			if CanContain(parent, nodeType) {
+				return []*Node{{Type: nodeType, Attrs: node.Attrs, Content: Fit(nodeType, node.Content...)}}
+			}
+		}
+
+		title, _ := stringAttr(node.Attrs, "title")
+		if title != "" {
+			return fit(parent, append([]*Node{Paragraph(Text(title, Strong()))}, node.Content...)...)
+		}
+
+		return fit(parent, node.Content...)
+
+	case NodeBlockquote, NodePanel:
+		return fit(parent, node.Content...)
+
+	case NodeTable:
+
+		var rows []*Node
+		for _, row := range node.Content {
+
+			var cells []string
+			for _, cell := range row.Content {
+				cells = append(cells, cell.PlainText())
+			}
+
+			// The rows with only blank cells have no text to keep.
+			if text := strings.Join(cells, " | "); strings.Trim(text, " |") != "" {
+				rows = append(rows, Paragraph(Text(text)))
+			}
+		}
+
+		return fit(parent, rows...)
+
+	case NodeTaskList:
+		return fit(parent, tasks(parent, node)...)
+
+	case NodeCodeBlock:
+
+		text := node.PlainText()
+		if text == "" {
+			return nil
+		}
+
+		return fit(parent, Paragraph(Text(text, Code())))
+
+	case NodeMediaGroup:
+
+		var media []*Node
+		for _, child := range node.Content {
+			media = append(media, MediaSingle(child))
+		}
+
+		return fit(parent, media...)
+
+	case NodeRule:
+		return nil
+
+	case NodeListItem, NodeTaskItem, NodeTableRow, NodeTableCell, NodeTableHeader, NodeMediaSingle:
+		return fit(parent, node.Content...)
+	}
+
+	if slices.Contains(inlineNodes, node.Type) {
+		return []*Node{node}
+	}
+
+	return nil
+}
+
+// tasks returns the task list as a bullet list, or as paragraphs when the parent cannot contain a bullet list.
+func tasks(parent NodeType, list *Node) []*Node {
+
+	var items []*Node
+
+	for _, child := range list.Content {
+
+		if child.Type == NodeTaskList {
+
+			nested := tasks(NodeListItem, child)
+			if len(items) == 0 {
+				items = append(items, ListItem(Paragraph()))
+			}
+
+			items[len(items)-1].Append(nested...)
+			continue
+		}
+
+		marker := "[ ] "
+		if state, _ := stringAttr(child.Attrs, "state"); state == "DONE" {
+			marker = "[x] "
+		}
+
+		items = append(items, ListItem(Paragraph(append([]*Node{Text(marker)}, child.Content...)...)))
+	}
+
+	if CanContain(parent, NodeBulletList) {
+		return []*Node{BulletList(items...)}
+	}
+
+	var paragraphs []*Node
+	for _, item := range items {
+		paragraphs = append(paragraphs, item.Content...)
+	}
+
+	return paragraphs
+}
+
+// wrapInline wraps the consecutive inline nodes of a block container in paragraphs.
+func wrapInline(parent NodeType, content []*Node) []*Node {
+
+	if inlineContainer(parent) || !CanContain(parent, NodeParagraph) {
+		return content
+	}
+
+	var (
+		wrapped   []*Node
+		paragraph *Node
+	)
+
+	for _, node := range content {
+
+		if !slices.Contains(inlineNodes, node.Type) {
+			wrapped, paragraph = append(wrapped, node), nil
+			continue
+		}
+
+		if paragraph == nil {
+			paragraph = Paragraph()
+			wrapped = append(wrapped, paragraph)
+		}
+
+		paragraph.Append(node)
+	}
+
+	return wrapped
+}
+
+// inlineContainer reports if the nodes of the type contain inline nodes, e.g. a paragraph.
+func inlineContainer(nodeType NodeType) bool {
+	return CanContain(nodeType, NodeText)
+}
+
+// flatten returns the inline nodes of a block, to fit it in an inline container.
+func flatten(node *Node) []*Node {
+
+	if slices.Contains(inlineNodes, node.Type) {
+		return []*Node{node}
+	}
+
+	if text := node.PlainText(); text != "" {
+		return []*Node{Text(text)}
+	}
+
+	return nil
+}
+
+// strong adds the strong mark to the text nodes, except the code.
+func strong(content []*Node) []*Node {
+
+	for _, node := range content {
+
+		if node.Type != NodeText || slices.ContainsFunc(node.Marks, func(m *Mark) bool { return m.Type == MarkCode || m.Type == MarkStrong }) {
+			continue
+		}
+
+		node.Marks = append(node.Marks, Strong())
+	}
+
+	return content
+}
@@ -0,0 +1,78 @@
+package adf
+
+// MarkType is the type of ADF mark.
+type MarkType string
+
+const (
+	MarkBackgroundColor = MarkType("backgroundColor")
+	MarkBorder          = MarkType("border")
+	MarkCode            = MarkType("code")
+	MarkEm              = MarkType("em")
+	MarkLink            = MarkType("link")
+	MarkStrike          = MarkType("strike")
+	MarkStrong          = MarkType("strong")
+	MarkSubSup          = MarkType("subsup")
+	MarkTextColor       = MarkType("textColor")
+	MarkUnderline       = MarkType("underline")
+)
+
+// Mark is the formatting applied to a text or a media node.
+type Mark struct {
+	Type  MarkType               `json:"type"`            // The type of the mark.
+	Attrs map[string]interface{} `json:"attrs,omitempty"` // The attributes of the mark.
+}
+
+// Strong returns the mark of the bold text.
+func Strong() *Mark {
+	return &Mark{Type: MarkStrong}
+}
+
+// Em returns the mark of the italic text.
+func Em() *Mark {
+	return &Mark{Type: MarkEm}
+}
+
+// Underline returns the mark of the underlined text.
+func Underline() *Mark {
+	return &Mark{Type: MarkUnderline}
+}
+
+// Strike returns the mark of the struck through text.
+func Strike() *Mark {
+	return &Mark{Type: MarkStrike}
+}
+
+// Code returns the mark of the inline code, it can only be combined with a link.
+func Code() *Mark {
+	return &Mark{Type: MarkCode}
+}
+
+// Sub returns the mark of the subscript text.
+func Sub() *Mark {
+	return &Mark{Type: MarkSubSup, Attrs: map[string]interface{}{"type": "sub"}}
+}
+
+// Sup returns the mark of the superscript text.
+func Sup() *Mark {
+	return &Mark{Type: MarkSubSup, Attrs: map[string]interface{}{"type": "sup"}}
+}
+
+// Link returns the mark of a hyperlink.
+func Link(href string) *Mark {
+	return &Mark{Type: MarkLink, Attrs: map[string]interface{}{"href": href}}
+}
+
+// TextColor returns the mark of the colored text, the color is a hex code, e.g. "#ff5630".
+func TextColor(color string) *Mark {
+	return &Mark{Type: MarkTextColor, Attrs: map[string]interface{}{"color": color}}
+}
+
+// BackgroundColor returns the mark of the highlighted text, the color is a hex code, e.g. "#fedec8".
+func BackgroundColor(color string) *Mark {
+	return &Mark{Type: MarkBackgroundColor, Attrs: map[string]interface{}{"color": color}}
+}
+
+// Border returns the mark of the media border, the size is from 1 to 3 pixels.
+func Border(size int, color string) *Mark {
+	return &Mark{Type: MarkBorder, Attrs: map[string]interface{}{"size": size, "color": color}}
+}
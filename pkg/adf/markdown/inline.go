@@ -0,0 +1,659 @@
+package markdown
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+var (
+	autolinkPattern     = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.\-]{1,31}:[^<>\s]*)>`)
+	emailAutolink       = regexp.MustCompile(`^<([A-Za-z0-9.!#$%&'*+/=?^_{|}~\-]+@[A-Za-z0-9](?:[A-Za-z0-9\-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9\-]*[A-Za-z0-9])?)*)>`)
+	bareURLPattern      = regexp.MustCompile(`^(?:https?://|www\.)[^\s<]+`)
+	trailingPunctuation = "?!.,:*_~'\""
+)
+
+// piece is a run of parsed inline nodes, or a run of emphasis delimiters, e.g. ** or ~~.
+type piece struct {
+	nodes []*adf.Node
+
+	delimiter byte
+	count     int
+	original  int
+	canOpen   bool
+	canClose  bool
+}
+
+type inlineParser struct {
+	*parser
+	source string
+	pieces []*piece
+	text   strings.Builder
+}
+
+// inline parses the inline content of a block, e.g. the text of a paragraph.
+func (p *parser) inline(source string) []*adf.Node {
+
+	parser := &inlineParser{parser: p, source: source}
+	parser.parse()
+
+	return merge(parser.emphasis())
+}
+
+func (p *inlineParser) flush() {
+
+	if p.text.Len() == 0 {
+		return
+	}
+
+	p.pieces = append(p.pieces, &piece{nodes: []*adf.Node{adf.Text(p.text.String())}})
+	p.text.Reset()
+}
+
+func (p *inlineParser) push(nodes ...*adf.Node) {
+	p.flush()
+	p.pieces = append(p.pieces, &piece{nodes: nodes})
+}
+
+func (p *inlineParser) parse() {
+
+	source := p.source
+
+	for index := 0; index < len(source); {
+
+		char := source[index]
+
+		switch {
+		case char == '\\' && index+1 < len(source) && source[index+1] == '\n':
+			p.push(adf.HardBreak())
+			index += 2
+			index += len(source[index:]) - len(strings.TrimLeft(source[index:], " "))
+
+		case char == '\\' && index+1 < len(source) && isPunctuation(source[index+1]):
+			p.text.WriteByte(source[index+1])
+			index += 2
+
+		case char == '`':
+			index = p.codeSpan(index)
+
+		case char == '*' || char == '_' || char == '~':
+			index = p.delimiterRun(index)
+
+		case char == '!' && index+1 < len(source) && source[index+1] == '[':
+			if end, ok := p.link(index+1, true); ok {
+				index = end
+				continue
+			}
+			p.text.WriteByte(char)
+			index++
+
+		case char == '[':
+			if end, ok := p.link(index, false); ok {
+				index = end
+				continue
+			}
+			p.text.WriteByte(char)
+			index++
+
+		case char == '<':
+			index = p.autolink(index)
+
+		case char == '\n':
+			// A line ending with two spaces is a hard break, the other line endings are soft breaks, rendered as a space.
+			text := p.text.String()
+			trimmed := strings.TrimRight(text, " ")
+			p.text.Reset()
+			p.text.WriteString(trimmed)
+
+			if len(text)-len(trimmed) >= 2 {
+				p.push(adf.HardBreak())
+			} else {
+				p.text.WriteByte(' ')
+			}
+
+			index++
+			index += len(source[index:]) - len(strings.TrimLeft(source[index:], " "))
+
+		case (char == 'h' || char == 'w') && p.atWordStart(index):
+			index = p.bareURL(index)
+
+		default:
+			p.text.WriteByte(char)
+			index++
+		}
+	}
+
+	p.flush()
+}
+
+func isPunctuation(char byte) bool {
+	return char < utf8.RuneSelf && unicode.IsPunct(rune(char)) || strings.IndexByte("$+<=>^`|~", char) >= 0
+}
+
+// atWordStart reports if the position follows a space, an opening parenthesis or an emphasis delimiter, where a bare URL can start.
+func (p *inlineParser) atWordStart(index int) bool {
+
+	if index == 0 {
+		return true
+	}
+
+	return strings.IndexByte(" \n(*_~", p.source[index-1]) >= 0
+}
+
+// codeSpan parses the code between two backtick runs of the same length.
+func (p *inlineParser) codeSpan(index int) int {
+
+	run := runLength(p.source, index)
+	fence := p.source[index : index+run]
+
+	for search := index + run; search < len(p.source); {
+
+		offset := strings.Index(p.source[search:], fence)
+		if offset < 0 {
+			break
+		}
+
+		closing := search + offset
+		if runLength(p.source, closing) != run {
+			search = closing + runLength(p.source, closing)
+			continue
+		}
+
+		code := strings.ReplaceAll(p.source[index+run:closing], "\n", " ")
+		if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+			code = code[1 : len(code)-1]
+		}
+
+		p.push(adf.Text(code, adf.Code()))
+
+		return closing + run
+	}
+
+	p.text.WriteString(fence)
+
+	return index + run
+}
+
+func runLength(source string, index int) int {
+
+	length := 0
+	for index+length < len(source) && source[index+length] == source[index] {
+		length++
+	}
+
+	return length
+}
+
+// delimiterRun parses a run of * _ or ~, it opens or closes the emphasis depending on the characters around it.
+func (p *inlineParser) delimiterRun(index int) int {
+
+	char := p.source[index]
+	run := runLength(p.source, index)
+
+	// The strikethrough is delimited by one or two tildes.
+	if char == '~' && run > 2 {
+		p.text.WriteString(p.source[index : index+run])
+		return index + run
+	}
+
+	before, _ := utf8.DecodeLastRuneInString(p.source[:index])
+	after, _ := utf8.DecodeRuneInString(p.source[index+run:])
+
+	if index == 0 {
+		before = ' '
+	}
+
+	if index+run == len(p.source) {
+		after = ' '
+	}
+
+	leftFlanking := !unicode.IsSpace(after) && (!isPunctuationRune(after) || unicode.IsSpace(before) || isPunctuationRune(before))
+	rightFlanking := !unicode.IsSpace(before) && (!isPunctuationRune(before) || unicode.IsSpace(after) || isPunctuationRune(after))
+
+	canOpen, canClose := leftFlanking, rightFlanking
+	if char == '_' {
+		canOpen = leftFlanking && (!rightFlanking || isPunctuationRune(before))
+		canClose = rightFlanking && (!leftFlanking || isPunctuationRune(after))
+	}
+
+	p.flush()
+	p.pieces = append(p.pieces, &piece{delimiter: char, count: run, original: run, canOpen: canOpen, canClose: canClose})
+
+	return index + run
+}
+
+func isPunctuationRune(char rune) bool {
+	return unicode.IsPunct(char) || unicode.IsSymbol(char)
+}
+
+// link parses a link, [text](url "title"), [text][label] or [label], or an image when it follows a !.
+func (p *inlineParser) link(index int, image bool) (int, bool) {
+
+	closing := matchingBracket(p.source, index)
+	if closing < 0 {
+		return 0, false
+	}
+
+	label := p.source[index+1 : closing]
+	end := closing + 1
+
+	url, end, ok := p.destination(end)
+	if !ok {
+
+		ref := label
+		end = closing + 1
+
+		if strings.HasPrefix(p.source[end:], "[") {
+			if refEnd := strings.IndexByte(p.source[end:], ']'); refEnd > 0 {
+				if inner := p.source[end+1 : end+refEnd]; inner != "" {
+					ref = inner
+				}
+				end += refEnd + 1
+			}
+		}
+
+		target, found := p.references[normalizeLabel(ref)]
+		if !found {
+			return 0, false
+		}
+
+		url = target.url
+	}
+
+	// A link or an image without a destination has no ADF node, the text is kept as written.
+	if url == "" {
+		return 0, false
+	}
+
+	if image {
+
+		alt := plainText(p.inline(label))
+
+		media := adf.ExternalMedia(url)
+		if alt != "" {
+			media.Attr("alt", alt)
+		}
+
+		p.push(media)
+
+		return end, true
+	}
+
+	content := p.inline(label)
+	for _, node := range content {
+		if node.Type == adf.NodeText && !hasMark(node, adf.MarkLink) {
+			node.Marks = append(node.Marks, adf.Link(url))
+		}
+	}
+
+	p.push(content...)
+
+	return end, true
+}
+
+// matchingBracket returns the index of the bracket closing the one at the index, or -1.
+func matchingBracket(source string, index int) int {
+
+	depth := 0
+
+	for position := index; position < len(source); position++ {
+
+		switch source[position] {
+		case '\\':
+			position++
+		case '`':
+			position += runLength(source, position) - 1
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return position
+			}
+		}
+	}
+
+	return -1
+}
+
+// destination parses the (url "title") following the text of a link.
+func (p *inlineParser) destination(index int) (string, int, bool) {
+
+	source := p.source
+	if index >= len(source) || source[index] != '(' {
+		return "", 0, false
+	}
+
+	position := skipSpaces(source, index+1)
+
+	var url string
+
+	if position < len(source) && source[position] == '<' {
+
+		end := strings.IndexByte(source[position:], '>')
+		if end < 0 {
+			return "", 0, false
+		}
+
+		url, position = source[position+1:position+end], position+end+1
+
+	} else {
+
+		start, depth := position, 0
+
+	scan:
+		for ; position < len(source); position++ {
+			switch char := source[position]; {
+			case char == '\\' && position+1 < len(source):
+				position++
+			case char == '(':
+				depth++
+			case char == ')' && depth == 0, char == ' ', char == '\n':
+				break scan
+			case char == ')':
+				depth--
+			}
+		}
+
+		url = unescape(source[start:position])
+	}
+
+	position = skipSpaces(source, position)
+
+	// The title is parsed and dropped, ADF links do not have one.
+	if position < len(source) && strings.IndexByte(`"'(`, source[position]) >= 0 {
+
+		closing := map[byte]byte{'"': '"', '\'': '\'', '(': ')'}[source[position]]
+
+		end := strings.IndexByte(source[position+1:], closing)
+		if end < 0 {
+			return "", 0, false
+		}
+
+		position = skipSpaces(source, position+end+2)
+	}
+
+	if position >= len(source) || source[position] != ')' {
+		return "", 0, false
+	}
+
+	return url, position + 1, true
+}
+
+func skipSpaces(source string, index int) int {
+
+	for index < len(source) && (source[index] == ' ' || source[index] == '\n') {
+		index++
+	}
+
+	return index
+}
+
+func unescape(text string) string {
+
+	var builder strings.Builder
+
+	for index := 0; index < len(text); index++ {
+
+		if text[index] == '\\' && index+1 < len(text) && isPunctuation(text[index+1]) {
+			index++
+		}
+
+		builder.WriteByte(text[index])
+	}
+
+	return builder.String()
+}
+
+// autolink parses a URL or an email between angle brackets, e.g. <https://example.com>.
+func (p *inlineParser) autolink(index int) int {
+
+	if match := autolinkPattern.FindStringSubmatch(p.source[index:]); match != nil {
+		p.push(adf.Text(match[1], adf.Link(match[1])))
+		return index + len(match[0])
+	}
+
+	if match := emailAutolink.FindStringSubmatch(p.source[index:]); match != nil {
+		p.push(adf.Text(match[1], adf.Link("mailto:"+match[1])))
+		return index + len(match[0])
+	}
+
+	p.text.WriteByte('<')
+
+	return index + 1
+}
+
+// bareURL parses a URL written without brackets, e.g. https://example.com or www.example.com, as GitHub does.
+func (p *inlineParser) bareURL(index int) int {
+
+	match := bareURLPattern.FindString(p.source[index:])
+	if match == "" {
+		p.text.WriteByte(p.source[index])
+		return index + 1
+	}
+
+	// The trailing punctuation and the unbalanced closing parentheses are not part of the URL.
+	for len(match) != 0 {
+
+		last := match[len(match)-1]
+
+		if strings.IndexByte(trailingPunctuation, last) >= 0 {
+			match = match[:len(match)-1]
+			continue
+		}
+
+		if last == ')' && strings.Count(match, ")") > strings.Count(match, "(") {
+			match = match[:len(match)-1]
+			continue
+		}
+
+		break
+	}
+
+	href := match
+	if strings.HasPrefix(match, "www.") {
+		href = "http://" + match
+	}
+
+	p.push(adf.Text(match, adf.Link(href)))
+
+	return index + len(match)
+}
+
+// emphasis matches the delimiter runs, following the CommonMark algorithm, and returns the nodes with their marks.
+func (p *inlineParser) emphasis() []*adf.Node {
+
+	pieces := p.pieces
+
+	for closer := 0; closer < len(pieces); closer++ {
+
+		if pieces[closer].delimiter == 0 || !pieces[closer].canClose {
+			continue
+		}
+
+		opener := p.opener(pieces, closer)
+		if opener < 0 {
+			continue
+		}
+
+		open, close := pieces[opener], pieces[closer]
+
+		used, mark := 1, adf.Em()
+		switch {
+		case open.delimiter == '~':
+			used, mark = open.count, adf.Strike()
+		case open.count >= 2 && close.count >= 2:
+			used, mark = 2, adf.Strong()
+		}
+
+		// The delimiters between the opener and the closer are left as text.
+		var nodes []*adf.Node
+		for _, between := range pieces[opener+1 : closer] {
+			nodes = append(nodes, between.content()...)
+		}
+
+		for _, node := range nodes {
+			addMark(node, mark)
+		}
+
+		open.count -= used
+		close.count -= used
+
+		replaced := []*piece{open, {nodes: nodes}, close}
+		pieces = slices.Replace(pieces, opener, closer+1, replaced...)
+		closer = opener + 2
+
+		if open.count == 0 {
+			pieces = slices.Delete(pieces, opener, opener+1)
+			closer--
+		}
+
+		if close.count == 0 {
+			pieces = slices.Delete(pieces, closer, closer+1)
+		}
+
+		// The closer is processed again when some of its delimiters are left.
+		closer--
+	}
+
+	var nodes []*adf.Node
+	for _, piece := range pieces {
+		nodes = append(nodes, piece.content()...)
+	}
+
+	return nodes
+}
+
+// opener returns the index of the delimiter run opening the emphasis closed by the closer, or -1.
+func (p *inlineParser) opener(pieces []*piece, closer int) int {
+
+	close := pieces[closer]
+
+	for index := closer - 1; index >= 0; index-- {
+
+		open := pieces[index]
+		if open.delimiter != close.delimiter || !open.canOpen || open.count == 0 {
+			continue
+		}
+
+		if open.delimiter == '~' && open.count != close.count {
+			continue
+		}
+
+		// The rule of 3: a run both opening and closing cannot match when the sum of the lengths is a multiple of 3.
+		if (open.canClose || close.canOpen) && (open.original+close.original)%3 == 0 && (open.original%3 != 0 || close.original%3 != 0) {
+			continue
+		}
+
+		return index
+	}
+
+	return -1
+}
+
+// content returns the nodes of the piece, the unmatched delimiters are returned as text.
+func (p *piece) content() []*adf.Node {
+
+	if p.delimiter == 0 {
+		return p.nodes
+	}
+
+	if p.count == 0 {
+		return nil
+	}
+
+	return []*adf.Node{adf.Text(strings.Repeat(string(p.delimiter), p.count))}
+}
+
+func hasMark(node *adf.Node, markType adf.MarkType) bool {
+	return slices.ContainsFunc(node.Marks, func(mark *adf.Mark) bool { return mark.Type == markType })
+}
+
+// addMark adds the mark to a text node, unless it is already set or the text is code.
+func addMark(node *adf.Node, mark *adf.Mark) {
+
+	if node.Type != adf.NodeText || hasMark(node, mark.Type) || hasMark(node, adf.MarkCode) {
+		return
+	}
+
+	node.Marks = append(node.Marks, mark)
+}
+
+// merge joins the consecutive text nodes with the same marks.
+func merge(nodes []*adf.Node) []*adf.Node {
+
+	var merged []*adf.Node
+
+	for _, node := range nodes {
+
+		if len(merged) != 0 {
+
+			last := merged[len(merged)-1]
+			if last.Type == adf.NodeText && node.Type == adf.NodeText && sameMarks(last.Marks, node.Marks) {
+				last.Text += node.Text
+				continue
+			}
+		}
+
+		merged = append(merged, node)
+	}
+
+	return merged
+}
+
+func sameMarks(a, b []*adf.Mark) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, mark := range a {
+
+		found := slices.ContainsFunc(b, func(other *adf.Mark) bool {
+			return other.Type == mark.Type && other.Attrs["href"] == mark.Attrs["href"]
+		})
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inlineImages replaces the images of a paragraph by links, since ADF only has block images.
+func inlineImages(nodes []*adf.Node) []*adf.Node {
+
+	for index, node := range nodes {
+
+		if node.Type != adf.NodeMedia {
+			continue
+		}
+
+		url, _ := node.Attrs["url"].(string)
+
+		text, _ := node.Attrs["alt"].(string)
+		if text == "" {
+			text = url
+		}
+
+		nodes[index] = adf.Text(text, adf.Link(url))
+	}
+
+	return merge(nodes)
+}
+
+// plainText returns the text of inline nodes, e.g. the alternative text of an image.
+func plainText(nodes []*adf.Node) string {
+
+	var builder strings.Builder
+	for _, node := range nodes {
+		builder.WriteString(node.PlainText())
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,39 @@
+// Package markdown converts between Markdown and the Atlassian Document Format.
+//
+// Parse reads CommonMark with the GitHub extensions: the headings, the emphasis, the strikethrough,
+// the links and the autolinks, the bullet, ordered and task lists, the tables, the fenced code blocks,
+// the quotes and the alerts, e.g. > [!WARNING], which become panels. The images alone in a paragraph
+// become single media, the other images become links, since ADF does not have inline images.
+// The blocks ADF cannot nest are fitted with adf.Fit, e.g. a quote in a list item is replaced by its content.
+//
+// Render writes the ADF nodes as Markdown, the nodes without Markdown equivalent degrade as follows:
+//
+//   - a mention is written as its text, e.g. @Carlos, or @ followed by the account ID;
+//   - an emoji is written as its text, or its short name, e.g. :tada:;
+//   - a status is written as inline code, e.g. `IN PROGRESS`;
+//   - a date is written as YYYY-MM-DD, in UTC;
+//   - an inline card is written as an autolink, e.g. <https://ctreminiom.atlassian.net/browse/KP-1>;
+//   - a panel is written as a GitHub alert, info as NOTE, note as IMPORTANT, success as TIP, warning as WARNING and error as CAUTION;
+//   - an expand is written as its title in bold, followed by its content;
+//   - a media stored in Atlassian is written as its alternative text or its file ID, an external media as an image;
+//   - the underline, the colors and the subscript or superscript marks are dropped;
+//   - a table cell holding several blocks is written on a single line.
+//
+// The plain text of a document, e.g. for a chat message, is returned by the PlainText method of adf.Node.
+package markdown
+
+import (
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ToCommentNode converts the Markdown document to the ADF document of the Jira v3 payloads,
+// e.g. the body of a comment or the description of an issue.
+func ToCommentNode(source string) *model.CommentNodeScheme {
+	return Parse(source).CommentNode()
+}
+
+// FromCommentNode converts the ADF document read from Jira, e.g. the body of a comment, to Markdown.
+func FromCommentNode(node *model.CommentNodeScheme) string {
+	return Render(adf.FromCommentNode(node))
+}
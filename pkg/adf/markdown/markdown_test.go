@@ -0,0 +1,293 @@
+package markdown
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// withoutLocalIDs removes the random local IDs of the task lists, so the documents can be compared.
+func withoutLocalIDs(node *adf.Node) *adf.Node {
+
+	delete(node.Attrs, "localId")
+	if len(node.Attrs) == 0 {
+		node.Attrs = nil
+	}
+
+	for _, child := range node.Content {
+		withoutLocalIDs(child)
+	}
+
+	return node
+}
+
+func TestParse(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		markdown string
+		want     *adf.Node
+	}{
+		{
+			name:     "when the text is emphasized",
+			markdown: "Some **bold**, *italic*, ***both***, ~~struck~~ and `code` text with snake_case_names.",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("Some "), adf.Text("bold", adf.Strong()), adf.Text(", "), adf.Text("italic", adf.Em()), adf.Text(", "),
+				adf.Text("both", adf.Strong(), adf.Em()), adf.Text(", "), adf.Text("struck", adf.Strike()), adf.Text(" and "),
+				adf.Text("code", adf.Code()), adf.Text(" text with snake_case_names."),
+			)),
+		},
+		{
+			name:     "when the emphasis is nested and unmatched",
+			markdown: "**bold _and italic_** 2 * 3 = 6 __x*",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("bold ", adf.Strong()), adf.Text("and italic", adf.Em(), adf.Strong()), adf.Text(" 2 * 3 = 6 __x*"),
+			)),
+		},
+		{
+			name:     "when the text has links",
+			markdown: "See [the docs](https://example.com/docs \"Docs\"), <https://example.com>, www.example.com, https://example.com/a_(b). and [ref][].\n\n[ref]: https://example.com/ref",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("See "), adf.Text("the docs", adf.Link("https://example.com/docs")), adf.Text(", "),
+				adf.Text("https://example.com", adf.Link("https://example.com")), adf.Text(", "),
+				adf.Text("www.example.com", adf.Link("http://www.example.com")), adf.Text(", "),
+				adf.Text("https://example.com/a_(b)", adf.Link("https://example.com/a_(b)")), adf.Text(". and "),
+				adf.Text("ref", adf.Link("https://example.com/ref")), adf.Text("."),
+			)),
+		},
+		{
+			name:     "when the link is emphasized",
+			markdown: "**[KP-1](https://ctreminiom.atlassian.net/browse/KP-1)** and [`code`](https://example.com)",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("KP-1", adf.Link("https://ctreminiom.atlassian.net/browse/KP-1"), adf.Strong()), adf.Text(" and "),
+				adf.Text("code", adf.Code(), adf.Link("https://example.com")),
+			)),
+		},
+		{
+			name:     "when the text has escapes and breaks",
+			markdown: "\\*not emphasized\\*  \nsecond line\\\nthird line\nsoft",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("*not emphasized*"), adf.HardBreak(), adf.Text("second line"), adf.HardBreak(), adf.Text("third line soft"),
+			)),
+		},
+		{
+			name:     "when the document has headings",
+			markdown: "# Title #\n\n### Section\n\nSetext\n===\n\nSub\n---",
+			want: adf.Doc(
+				adf.Heading(1, adf.Text("Title")), adf.Heading(3, adf.Text("Section")),
+				adf.Heading(1, adf.Text("Setext")), adf.Heading(2, adf.Text("Sub")),
+			),
+		},
+		{
+			name:     "when the document has code blocks",
+			markdown: "```go\nfmt.Println(\"*\")\n\n```\n\n    indented\n    code\n\n~~~\nunclosed",
+			want: adf.Doc(
+				adf.CodeBlock("go", "fmt.Println(\"*\")\n"), adf.CodeBlock("", "indented\ncode"), adf.CodeBlock("", "unclosed"),
+			),
+		},
+		{
+			name:     "when the document has lists",
+			markdown: "- one\n- two\n  - nested\n\n3. three\n4. four\n\n* [x] done\n* [ ] todo\n  * [ ] nested task",
+			want: adf.Doc(
+				adf.BulletList(
+					adf.ListItem(adf.Paragraph(adf.Text("one"))),
+					adf.ListItem(adf.Paragraph(adf.Text("two")), adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("nested"))))),
+				),
+				adf.OrderedList(adf.ListItem(adf.Paragraph(adf.Text("three"))), adf.ListItem(adf.Paragraph(adf.Text("four")))).Attr("order", 3),
+				adf.TaskList(
+					adf.TaskItem(true, adf.Text("done")),
+					adf.TaskItem(false, adf.Text("todo")),
+					adf.TaskList(adf.TaskItem(false, adf.Text("nested task"))),
+				),
+			),
+		},
+		{
+			name:     "when a list item holds blocks ADF cannot nest",
+			markdown: "1. > quoted\n   lazy\n2. ## heading\n\n   ```sh\n   make\n   ```\n- [ ] task\n- not a task",
+			want: adf.Doc(
+				adf.OrderedList(
+					adf.ListItem(adf.Paragraph(adf.Text("quoted lazy"))),
+					adf.ListItem(adf.Paragraph(adf.Text("heading", adf.Strong())), adf.CodeBlock("sh", "make")),
+				),
+				adf.BulletList(
+					adf.ListItem(adf.Paragraph(adf.Text("[ ] task"))),
+					adf.ListItem(adf.Paragraph(adf.Text("not a task"))),
+				),
+			),
+		},
+		{
+			name:     "when the document has quotes and alerts",
+			markdown: "> quoted\n> - item\n\n> [!WARNING]\n> Careful",
+			want: adf.Doc(
+				adf.Blockquote(adf.Paragraph(adf.Text("quoted")), adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("item"))))),
+				adf.Panel(adf.PanelWarning, adf.Paragraph(adf.Text("Careful"))),
+			),
+		},
+		{
+			name:     "when the document has a table",
+			markdown: "| Key | Summary |\n|:----|--------:|\n| KP-1 | Login \\| SSO |\n| KP-2 |\n\n---",
+			want: adf.Doc(
+				adf.Table(
+					adf.TableRow(adf.TableHeader(adf.Paragraph(adf.Text("Key"))), adf.TableHeader(adf.Paragraph(adf.Text("Summary")))),
+					adf.TableRow(adf.TableCell(adf.Paragraph(adf.Text("KP-1"))), adf.TableCell(adf.Paragraph(adf.Text("Login | SSO")))),
+					adf.TableRow(adf.TableCell(adf.Paragraph(adf.Text("KP-2"))), adf.TableCell(adf.Paragraph())),
+				),
+				adf.Rule(),
+			),
+		},
+		{
+			name:     "when the document has images",
+			markdown: "![Logo](https://example.com/logo.png)\n\nInline ![icon](https://example.com/icon.png) image",
+			want: adf.Doc(
+				adf.MediaSingle(adf.ExternalMedia("https://example.com/logo.png").Attr("alt", "Logo")),
+				adf.Paragraph(adf.Text("Inline "), adf.Text("icon", adf.Link("https://example.com/icon.png")), adf.Text(" image")),
+			),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			doc := Parse(testCase.markdown)
+			require.NoError(t, doc.Validate())
+
+			want, err := json.Marshal(withoutLocalIDs(testCase.want))
+			require.NoError(t, err)
+
+			got, err := json.Marshal(withoutLocalIDs(doc))
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(want), string(got))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		node *adf.Node
+		want string
+	}{
+		{
+			name: "when the marks span several nodes",
+			node: adf.Doc(adf.Paragraph(
+				adf.Text("Hello "), adf.Text("bold ", adf.Strong()), adf.Text("and italic", adf.Strong(), adf.Em()),
+				adf.Text(" text", adf.Underline()), adf.Text("!"), adf.HardBreak(), adf.Text("with `ticks`", adf.Code()),
+			)),
+			want: "Hello **bold *and italic*** text!\\\n`` with `ticks` ``",
+		},
+		{
+			name: "when the text needs escaping",
+			node: adf.Doc(adf.Paragraph(adf.Text("# not a heading, *a* [b] snake_case _c_")), adf.Paragraph(adf.Text("1. not a list"))),
+			want: "\\# not a heading, \\*a\\* \\[b\\] snake_case \\_c\\_\n\n1\\. not a list",
+		},
+		{
+			name: "when the nodes have no Markdown equivalent",
+			node: adf.Doc(
+				adf.Paragraph(
+					adf.Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"), adf.Text(" "), adf.Mention("5b10ac8d82e05b22cc7d4ef5", ""), adf.Text(" "),
+					adf.Emoji(":tada:"), adf.Text(" "), adf.Status("In progress", adf.StatusBlue), adf.Text(" "),
+					adf.Date(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)), adf.Text(" "),
+					adf.InlineCard("https://ctreminiom.atlassian.net/browse/KP-1"),
+				),
+				adf.Panel(adf.PanelError, adf.Paragraph(adf.Text("Broken"))),
+				adf.Expand("Details", adf.Paragraph(adf.Text("hidden"))),
+				adf.MediaSingle(adf.Media("6e7c7f2c", "")),
+			),
+			want: "@Carlos @5b10ac8d82e05b22cc7d4ef5 :tada: `In progress` 2024-03-01 <https://ctreminiom.atlassian.net/browse/KP-1>\n\n" +
+				"> [!CAUTION]\n> Broken\n\n**Details**\n\nhidden\n\n\\[attachment 6e7c7f2c\\]",
+		},
+		{
+			name: "when the table has spans and pipes",
+			node: adf.Doc(adf.Table(
+				adf.TableRow(adf.TableHeader(adf.Paragraph(adf.Text("Key"))), adf.TableHeader(adf.Paragraph(adf.Text("Summary")))),
+				adf.TableRow(adf.TableCell(adf.Paragraph(adf.Text("a | b"))).Attr("colspan", 2)),
+			)),
+			want: "| Key | Summary |\n| --- | --- |\n| a \\| b |  |",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, Render(testCase.node))
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+
+	source := `# Release 1.2
+
+Ships **SSO** for [KP-1](https://ctreminiom.atlassian.net/browse/KP-1), see ~~the old~~ *new* docs.
+
+## Changes
+
+1. Login with the identity provider
+2. Logout button
+   - on every page
+   - in the menu
+
+- [x] Rotate the certificates
+- [ ] Update the runbook
+  - [ ] Add the diagram
+
+> [!NOTE]
+> Restart the workers after the upgrade.
+
+> Quoted text
+
+| Service | Owner |
+| --- | --- |
+| auth | @Carlos |
+
+` + "```yaml\nsso:\n  enabled: true\n```" + `
+
+---
+
+![Diagram](https://example.com/diagram.png)`
+
+	doc := Parse(source)
+	require.NoError(t, doc.Validate())
+
+	assert.Equal(t, source, Render(doc))
+	assert.Equal(t, source, FromCommentNode(ToCommentNode(source)))
+}
+
+func TestParse_Validate(t *testing.T) {
+
+	corpus := []string{
+		"", "\n\n", "-", "*", "+", "1.", "2)", ">", "> ", ">>", "> [!NOTE]", "> [!WARNING]\n>",
+		"- a\n-\n- c", "1. a\n2.\n", "- [ ]", "- [x] \n- [ ] b", "-\n  -\n    -", "> -\n> 1.", "- >",
+		"#", "######", "---", "```", "```\n", "|a|\n|-|", "| a | b |\n| --- | --- |\n| |", "![]()", "![alt]()", "[]()", "[a]()", "a ![]() b", "**", "~~~~", "`",
+		"[ref]: https://example.com", "<>", "> ```", "1. > \n2. ---", ">|\n--", "- |\n  |-|\n  | |",
+	}
+
+	for _, markdown := range corpus {
+		assert.NoError(t, Parse(markdown).Validate(), "%q", markdown)
+	}
+}
+
+func TestFromCommentNode(t *testing.T) {
+
+	body := &model.CommentNodeScheme{
+		Version: 1,
+		Type:    "doc",
+		Content: []*model.CommentNodeScheme{
+			{Type: "paragraph", Content: []*model.CommentNodeScheme{
+				{Type: "text", Text: "Deployed by "},
+				{Type: "mention", Attrs: map[string]interface{}{"id": "5b10ac8d82e05b22cc7d4ef5", "text": "@Carlos"}},
+			}},
+			{Type: "heading", Attrs: map[string]interface{}{"level": float64(2)}, Content: []*model.CommentNodeScheme{{Type: "text", Text: "Notes"}}},
+		},
+	}
+
+	assert.Equal(t, "Deployed by @Carlos\n\n## Notes", FromCommentNode(body))
+	assert.Equal(t, "Deployed by @Carlos\nNotes", adf.FromCommentNode(body).PlainText())
+}
@@ -0,0 +1,587 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+var (
+	atxHeadingPattern    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*))?$`)
+	closingHashesPattern = regexp.MustCompile(`(?:^|[ \t]+)#+[ \t]*$`)
+	setextPattern        = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	thematicBreakPattern = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fencePattern         = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	blockquotePattern    = regexp.MustCompile(`^ {0,3}> ?`)
+	listItemPattern      = regexp.MustCompile(`^( {0,3})([-+*]|\d{1,9}[.)])([ \t]+|$)`)
+	taskPattern          = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	alertPattern         = regexp.MustCompile(`^\[!(NOTE|TIP|IMPORTANT|WARNING|CAUTION)\][ \t]*$`)
+	tableDelimiterRow    = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	referencePattern     = regexp.MustCompile(`^ {0,3}\[([^\]]+)\]:[ \t]*<?([^\s>]+)>?(?:[ \t]+(?:"([^"]*)"|'([^']*)'|\(([^)]*)\)))?[ \t]*$`)
+)
+
+// alertPanels are the panels of the GitHub alerts, e.g. > [!WARNING].
+var alertPanels = map[string]adf.PanelType{
+	"NOTE":      adf.PanelInfo,
+	"IMPORTANT": adf.PanelNote,
+	"TIP":       adf.PanelSuccess,
+	"WARNING":   adf.PanelWarning,
+	"CAUTION":   adf.PanelError,
+}
+
+type reference struct {
+	url, title string
+}
+
+type parser struct {
+	references map[string]reference
+}
+
+// Parse converts the Markdown document to an ADF document.
+func Parse(source string) *adf.Node {
+
+	source = strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(source)
+
+	lines := strings.Split(source, "\n")
+	for index, line := range lines {
+		lines[index] = expandTabs(line)
+	}
+
+	p := &parser{references: make(map[string]reference)}
+	lines = p.collectReferences(lines)
+
+	return adf.Doc(adf.Fit(adf.NodeDoc, p.blocks(lines)...)...)
+}
+
+// expandTabs replaces the tabs of the indentation by spaces, with tab stops of 4 columns.
+func expandTabs(line string) string {
+
+	var builder strings.Builder
+
+	for index, char := range line {
+
+		switch char {
+		case ' ':
+			builder.WriteByte(' ')
+		case '\t':
+			builder.WriteString(strings.Repeat(" ", 4-builder.Len()%4))
+		default:
+			return builder.String() + line[index:]
+		}
+	}
+
+	return builder.String()
+}
+
+// collectReferences removes the link reference definitions, e.g. [docs]: https://example.com, and stores them.
+func (p *parser) collectReferences(lines []string) []string {
+
+	var (
+		kept    []string
+		fence   string
+		newPara = true
+	)
+
+	for _, line := range lines {
+
+		if fence != "" {
+
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				fence = ""
+			}
+
+			kept = append(kept, line)
+			continue
+		}
+
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			fence, newPara = match[2], true
+			kept = append(kept, line)
+			continue
+		}
+
+		if match := referencePattern.FindStringSubmatch(line); match != nil && newPara {
+
+			label := normalizeLabel(match[1])
+			if _, ok := p.references[label]; !ok {
+				p.references[label] = reference{url: match[2], title: match[3] + match[4] + match[5]}
+			}
+
+			continue
+		}
+
+		newPara = isBlank(line)
+		kept = append(kept, line)
+	}
+
+	return kept
+}
+
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// startsBlock reports if the line starts a block which interrupts a paragraph.
+func startsBlock(line string) bool {
+
+	if fencePattern.MatchString(line) || atxHeadingPattern.MatchString(line) || thematicBreakPattern.MatchString(line) || blockquotePattern.MatchString(line) {
+		return true
+	}
+
+	// Only the bullet items and the ordered items starting at 1 interrupt a paragraph, when they are not empty.
+	if match := listItemPattern.FindStringSubmatch(line); match != nil && !isBlank(line[len(match[0]):]) {
+		return !isOrdered(match[2]) || strings.TrimRight(match[2], ".)") == "1"
+	}
+
+	return false
+}
+
+func isOrdered(marker string) bool {
+	return marker[0] >= '0' && marker[0] <= '9'
+}
+
+// blocks parses the lines as a sequence of blocks, which the caller fits in its node.
+func (p *parser) blocks(lines []string) []*adf.Node {
+
+	var nodes []*adf.Node
+
+	for index := 0; index < len(lines); {
+
+		line := lines[index]
+
+		var (
+			parsed   []*adf.Node
+			consumed int
+		)
+
+		switch {
+		case isBlank(line):
+			index++
+			continue
+		case fencePattern.MatchString(line):
+			parsed, consumed = p.fencedCode(lines[index:])
+		case atxHeadingPattern.MatchString(line):
+			parsed, consumed = p.atxHeading(line), 1
+		case thematicBreakPattern.MatchString(line):
+			parsed, consumed = []*adf.Node{adf.Rule()}, 1
+		case blockquotePattern.MatchString(line):
+			parsed, consumed = p.blockquote(lines[index:])
+		case listItemPattern.MatchString(line):
+			parsed, consumed = p.list(lines[index:])
+		case indentation(line) >= 4:
+			parsed, consumed = p.indentedCode(lines[index:])
+		case index+1 < len(lines) && isTable(line, lines[index+1]):
+			parsed, consumed = p.table(lines[index:])
+		default:
+			parsed, consumed = p.paragraph(lines[index:])
+		}
+
+		nodes = append(nodes, parsed...)
+		index += consumed
+	}
+
+	return nodes
+}
+
+func (p *parser) atxHeading(line string) []*adf.Node {
+
+	match := atxHeadingPattern.FindStringSubmatch(line)
+	text := closingHashesPattern.ReplaceAllString(strings.TrimSpace(match[2]), "")
+
+	return []*adf.Node{adf.Heading(len(match[1]), p.inline(text)...)}
+}
+
+// fencedCode parses a code block fenced by ``` or ~~~, the info string sets its language.
+func (p *parser) fencedCode(lines []string) ([]*adf.Node, int) {
+
+	match := fencePattern.FindStringSubmatch(lines[0])
+	indent, fence := len(match[1]), match[2]
+
+	var language string
+	if fields := strings.Fields(match[3]); len(fields) != 0 {
+		language = fields[0]
+	}
+
+	var (
+		code     []string
+		consumed = 1
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+		trimmed := strings.TrimSpace(line)
+
+		if indentation(line) < 4 && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			consumed++
+			break
+		}
+
+		code = append(code, line[min(indent, indentation(line)):])
+	}
+
+	return []*adf.Node{adf.CodeBlock(language, strings.Join(code, "\n"))}, consumed
+}
+
+func (p *parser) indentedCode(lines []string) ([]*adf.Node, int) {
+
+	var (
+		code     []string
+		consumed int
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+		if !isBlank(line) && indentation(line) < 4 {
+			break
+		}
+
+		code = append(code, line[min(4, len(line)):])
+	}
+
+	for len(code) != 0 && isBlank(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+
+	return []*adf.Node{adf.CodeBlock("", strings.Join(code, "\n"))}, consumed
+}
+
+// blockquote parses a quote, or a panel when it starts with a GitHub alert, e.g. > [!NOTE].
+func (p *parser) blockquote(lines []string) ([]*adf.Node, int) {
+
+	var (
+		inner    []string
+		consumed int
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+
+		if match := blockquotePattern.FindString(line); match != "" {
+			inner = append(inner, line[len(match):])
+			continue
+		}
+
+		// A paragraph continues in the quote without the marker, it is a lazy continuation line.
+		if isBlank(line) || startsBlock(line) || len(inner) == 0 || isBlank(inner[len(inner)-1]) {
+			break
+		}
+
+		inner = append(inner, line)
+	}
+
+	if len(inner) != 0 {
+		if match := alertPattern.FindStringSubmatch(strings.TrimSpace(inner[0])); match != nil {
+			panelType := alertPanels[match[1]]
+			return []*adf.Node{adf.Panel(panelType, fitBlocks(adf.NodePanel, p.blocks(inner[1:]))...)}, consumed
+		}
+	}
+
+	return []*adf.Node{adf.Blockquote(fitBlocks(adf.NodeBlockquote, p.blocks(inner))...)}, consumed
+}
+
+// fitBlocks fits the blocks in the parent, an empty quote or list item, e.g. a line with only the marker, has an empty paragraph.
+func fitBlocks(parent adf.NodeType, blocks []*adf.Node) []*adf.Node {
+
+	if fitted := adf.Fit(parent, blocks...); len(fitted) != 0 {
+		return fitted
+	}
+
+	return []*adf.Node{adf.Paragraph()}
+}
+
+type listItem struct {
+	lines []string
+	task  bool
+	done  bool
+}
+
+// list parses the consecutive items of a list, a bullet list of tasks, e.g. - [x] done, is a task list.
+func (p *parser) list(lines []string) ([]*adf.Node, int) {
+
+	first := listItemPattern.FindStringSubmatch(lines[0])
+	marker := first[2]
+	delimiter := marker[len(marker)-1:]
+
+	var (
+		items    []*listItem
+		consumed int
+	)
+
+	for consumed < len(lines) {
+
+		match := listItemPattern.FindStringSubmatch(lines[consumed])
+		if match == nil || isOrdered(match[2]) != isOrdered(marker) || !strings.HasSuffix(match[2], delimiter) {
+			break
+		}
+
+		item, n := p.listItem(lines[consumed:], match)
+		items = append(items, item)
+		consumed += n
+
+		// The blank lines between the items are skipped, the list ends at the first other line.
+		next := consumed
+		for next < len(lines) && isBlank(lines[next]) {
+			next++
+		}
+
+		if next == len(lines) || !listItemPattern.MatchString(lines[next]) || thematicBreakPattern.MatchString(lines[next]) {
+			break
+		}
+
+		consumed = next
+	}
+
+	if !isOrdered(marker) {
+
+		if list := p.taskList(items); list != nil {
+			return []*adf.Node{list}, consumed
+		}
+
+		var nodes []*adf.Node
+		for _, item := range items {
+			nodes = append(nodes, adf.ListItem(fitBlocks(adf.NodeListItem, p.blocks(item.lines))...))
+		}
+
+		return []*adf.Node{adf.BulletList(nodes...)}, consumed
+	}
+
+	var nodes []*adf.Node
+	for _, item := range items {
+		nodes = append(nodes, adf.ListItem(fitBlocks(adf.NodeListItem, p.blocks(item.lines))...))
+	}
+
+	list := adf.OrderedList(nodes...)
+	if start, _ := strconv.Atoi(strings.TrimRight(marker, ".)")); start != 1 {
+		list.Attr("order", start)
+	}
+
+	return []*adf.Node{list}, consumed
+}
+
+// listItem returns the lines of the item, without the marker and the indentation of its content.
+func (p *parser) listItem(lines []string, match []string) (*listItem, int) {
+
+	content := lines[0][len(match[0]):]
+
+	// The content is indented by the width of the marker, and a single space when it is followed by a code block or nothing.
+	width := len(match[1]) + len(match[2]) + len(match[3])
+	if isBlank(content) || len(match[3]) > 4 {
+		width = len(match[1]) + len(match[2]) + 1
+		content = strings.TrimPrefix(lines[0][min(len(lines[0]), len(match[1])+len(match[2])):], " ")
+	}
+
+	item := &listItem{lines: []string{content}}
+
+	if task := taskPattern.FindStringSubmatch(content); task != nil {
+		item.task, item.done = true, task[1] != " "
+	}
+
+	consumed := 1
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+
+		switch {
+		case isBlank(line):
+			item.lines = append(item.lines, "")
+		case indentation(line) >= width:
+			item.lines = append(item.lines, line[width:])
+		case !startsBlock(line) && !listItemPattern.MatchString(line) && !isBlank(item.lines[len(item.lines)-1]):
+			// A lazy continuation line of the paragraph.
+			item.lines = append(item.lines, line)
+		default:
+			return item.trim(), consumed
+		}
+	}
+
+	return item.trim(), consumed
+}
+
+// trim removes the trailing blank lines of the item, they belong to the list.
+func (i *listItem) trim() *listItem {
+
+	for len(i.lines) > 1 && isBlank(i.lines[len(i.lines)-1]) {
+		i.lines = i.lines[:len(i.lines)-1]
+	}
+
+	return i
+}
+
+// taskList returns the task list of the items, or nil when an item is not a task,
+// or holds other blocks than its text and nested tasks.
+func (p *parser) taskList(items []*listItem) *adf.Node {
+
+	var content []*adf.Node
+
+	for _, item := range items {
+
+		if !item.task {
+			return nil
+		}
+
+		lines := append([]string{taskPattern.ReplaceAllString(item.lines[0], "")}, item.lines[1:]...)
+		blocks := p.blocks(lines)
+
+		var text []*adf.Node
+		if len(blocks) != 0 && blocks[0].Type == adf.NodeParagraph {
+			text, blocks = blocks[0].Content, blocks[1:]
+		}
+
+		content = append(content, adf.TaskItem(item.done, text...))
+
+		for _, block := range blocks {
+
+			if block.Type != adf.NodeTaskList {
+				return nil
+			}
+
+			content = append(content, block)
+		}
+	}
+
+	return adf.TaskList(content...)
+}
+
+// isTable reports if the lines start a table, a header row followed by a delimiter row with as many cells.
+func isTable(header, delimiter string) bool {
+
+	if !strings.Contains(header, "|") || !tableDelimiterRow.MatchString(delimiter) {
+		return false
+	}
+
+	if !strings.Contains(delimiter, "|") && len(splitRow(header)) != 1 {
+		return false
+	}
+
+	return len(splitRow(header)) == len(splitRow(delimiter))
+}
+
+// splitRow returns the cells of a table row, the escaped pipes do not separate the cells.
+func splitRow(line string) []string {
+
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var (
+		cells []string
+		cell  strings.Builder
+	)
+
+	for index := 0; index < len(line); index++ {
+
+		switch {
+		case line[index] == '\\' && index+1 < len(line) && line[index+1] == '|':
+			cell.WriteString(`\|`)
+			index++
+		case line[index] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[index])
+		}
+	}
+
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// table parses a GitHub table, the first row holds the header cells.
+func (p *parser) table(lines []string) ([]*adf.Node, int) {
+
+	columns := len(splitRow(lines[0]))
+
+	row := func(line string, cell func(...*adf.Node) *adf.Node) *adf.Node {
+
+		cells := splitRow(line)
+
+		var nodes []*adf.Node
+		for index := 0; index < columns; index++ {
+
+			var text string
+			if index < len(cells) {
+				text = cells[index]
+			}
+
+			nodes = append(nodes, cell(adf.Paragraph(p.inline(text)...)))
+		}
+
+		return adf.TableRow(nodes...)
+	}
+
+	rows := []*adf.Node{row(lines[0], adf.TableHeader)}
+
+	consumed := 2
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+		if isBlank(line) || startsBlock(line) {
+			break
+		}
+
+		rows = append(rows, row(line, adf.TableCell))
+	}
+
+	return []*adf.Node{adf.Table(rows...)}, consumed
+}
+
+// paragraph parses a paragraph, or a setext heading when its lines are underlined by === or ---.
+// A paragraph holding only images is converted to single media nodes.
+func (p *parser) paragraph(lines []string) ([]*adf.Node, int) {
+
+	var (
+		text     []string
+		consumed int
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := lines[consumed]
+
+		if match := setextPattern.FindStringSubmatch(line); match != nil && len(text) != 0 {
+
+			level := 1
+			if match[1][0] == '-' {
+				level = 2
+			}
+
+			return []*adf.Node{adf.Heading(level, p.inline(strings.Join(text, "\n"))...)}, consumed + 1
+		}
+
+		if isBlank(line) || len(text) != 0 && startsBlock(line) {
+			break
+		}
+
+		text = append(text, strings.TrimLeft(line, " "))
+	}
+
+	content := p.inline(strings.TrimRight(strings.Join(text, "\n"), " "))
+
+	var media []*adf.Node
+	for _, node := range content {
+
+		switch {
+		case node.Type == adf.NodeMedia:
+			media = append(media, adf.MediaSingle(node))
+		case node.Type == adf.NodeText && strings.TrimSpace(node.Text) == "":
+		default:
+			return []*adf.Node{adf.Paragraph(inlineImages(content)...)}, consumed
+		}
+	}
+
+	return media, consumed
+}
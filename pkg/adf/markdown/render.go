@@ -0,0 +1,603 @@
+package markdown
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+// lineStartPattern matches the text at the start of a line which would be parsed as a block marker.
+var lineStartPattern = regexp.MustCompile(`^(?:#{1,6}(?:\s|$)|>|[-+*](?:\s|$)|=+\s*$|\d{1,9}[.)](?:\s|$))`)
+
+// panelAlerts are the GitHub alerts of the panels.
+var panelAlerts = map[string]string{
+	string(adf.PanelInfo):    "NOTE",
+	string(adf.PanelNote):    "IMPORTANT",
+	string(adf.PanelSuccess): "TIP",
+	string(adf.PanelWarning): "WARNING",
+	string(adf.PanelError):   "CAUTION",
+}
+
+// Render converts the ADF node, usually a document, to Markdown.
+func Render(node *adf.Node) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch {
+	case node.Type == adf.NodeDoc:
+		return strings.Join(renderBlocks(node.Content), "\n")
+	case isBlock(node):
+		return strings.Join(renderBlocks([]*adf.Node{node}), "\n")
+	default:
+		return renderInline([]*adf.Node{node}, false)
+	}
+}
+
+func isBlock(node *adf.Node) bool {
+	return !adf.CanContain(adf.NodeParagraph, node.Type) && node.Type != adf.NodeMedia
+}
+
+// renderBlocks returns the lines of the blocks, separated by a blank line.
+func renderBlocks(nodes []*adf.Node) []string {
+
+	var lines []string
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		block := renderBlock(node)
+		if len(block) == 0 {
+			continue
+		}
+
+		if len(lines) != 0 {
+			lines = append(lines, "")
+		}
+
+		lines = append(lines, block...)
+	}
+
+	return lines
+}
+
+func renderBlock(node *adf.Node) []string {
+
+	switch node.Type {
+	case adf.NodeParagraph:
+
+		text := renderInline(node.Content, false)
+		if strings.TrimSpace(text) == "" {
+			return nil
+		}
+
+		lines := strings.Split(text, "\n")
+		for index, line := range lines {
+			lines[index] = escapeLineStart(line)
+		}
+
+		return lines
+
+	case adf.NodeHeading:
+		level := intAttr(node.Attrs["level"], 1)
+		return []string{strings.Repeat("#", min(max(level, 1), 6)) + " " + renderInline(node.Content, true)}
+
+	case adf.NodeBulletList, adf.NodeOrderedList, adf.NodeTaskList:
+		return renderList(node)
+
+	case adf.NodeCodeBlock:
+
+		code := node.PlainText()
+
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+
+		language, _ := node.Attrs["language"].(string)
+
+		return append(append([]string{fence + language}, strings.Split(code, "\n")...), fence)
+
+	case adf.NodeBlockquote:
+		return quote(renderBlocks(node.Content))
+
+	case adf.NodePanel:
+		panelType, _ := node.Attrs["panelType"].(string)
+
+		alert, ok := panelAlerts[panelType]
+		if !ok {
+			alert = "NOTE"
+		}
+
+		return quote(append([]string{"[!" + alert + "]"}, renderBlocks(node.Content)...))
+
+	case adf.NodeExpand, adf.NodeNestedExpand:
+
+		var blocks []*adf.Node
+		if title, _ := node.Attrs["title"].(string); title != "" {
+			blocks = append(blocks, adf.Paragraph(adf.Text(title, adf.Strong())))
+		}
+
+		return renderBlocks(append(blocks, node.Content...))
+
+	case adf.NodeRule:
+		return []string{"---"}
+
+	case adf.NodeTable:
+		return renderTable(node)
+
+	case adf.NodeMediaSingle, adf.NodeMediaGroup:
+
+		var lines []string
+		for _, media := range node.Content {
+			if line := renderMedia(media); line != "" {
+				lines = append(lines, line)
+			}
+		}
+
+		if len(lines) > 1 {
+			return strings.Split(strings.Join(lines, "\n\n"), "\n")
+		}
+
+		return lines
+
+	case adf.NodeMedia:
+		return []string{renderMedia(node)}
+	}
+
+	if !isBlock(node) {
+		return []string{renderInline([]*adf.Node{node}, false)}
+	}
+
+	return renderBlocks(node.Content)
+}
+
+func intAttr(value interface{}, fallback int) int {
+
+	switch value := value.(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	default:
+		return fallback
+	}
+}
+
+func quote(lines []string) []string {
+
+	quoted := make([]string, 0, len(lines))
+	for _, line := range lines {
+
+		if line == "" {
+			quoted = append(quoted, ">")
+			continue
+		}
+
+		quoted = append(quoted, "> "+line)
+	}
+
+	return quoted
+}
+
+// renderMedia returns an external image as a Markdown image, the media stored in Atlassian as their alternative text
+// or their file ID, since reading them requires the credentials of the site.
+func renderMedia(node *adf.Node) string {
+
+	url, _ := node.Attrs["url"].(string)
+	alt, _ := node.Attrs["alt"].(string)
+
+	if mediaType, _ := node.Attrs["type"].(string); mediaType == "external" && url != "" {
+		return "![" + escapeText(alt) + "](" + destination(url) + ")"
+	}
+
+	if alt == "" {
+		id, _ := node.Attrs["id"].(string)
+		alt = "attachment " + id
+	}
+
+	return escapeText("[" + alt + "]")
+}
+
+// renderList renders the items with their marker, the nested blocks indented by the width of the marker.
+func renderList(node *adf.Node) []string {
+
+	order := intAttr(node.Attrs["order"], 1)
+
+	var lines []string
+
+	for _, item := range node.Content {
+
+		if item == nil {
+			continue
+		}
+
+		var (
+			marker  string
+			content []string
+		)
+
+		switch {
+		case item.Type == adf.NodeTaskList:
+			// A nested task list belongs to the previous item.
+			for _, line := range renderList(item) {
+				lines = append(lines, indent(line, "  "))
+			}
+			continue
+
+		case node.Type == adf.NodeTaskList:
+			marker = "- [ ] "
+			if state, _ := item.Attrs["state"].(string); state == "DONE" {
+				marker = "- [x] "
+			}
+			content = strings.Split(renderInline(item.Content, false), "\n")
+
+		case node.Type == adf.NodeOrderedList:
+			marker = strconv.Itoa(order) + ". "
+			order++
+			content = renderItem(item.Content)
+
+		default:
+			marker = "- "
+			content = renderItem(item.Content)
+		}
+
+		if len(content) == 0 {
+			content = []string{""}
+		}
+
+		lines = append(lines, strings.TrimRight(marker+content[0], " "))
+
+		padding := strings.Repeat(" ", len(marker))
+		for _, line := range content[1:] {
+			lines = append(lines, indent(line, padding))
+		}
+	}
+
+	return lines
+}
+
+// renderItem renders the blocks of a list item, the nested lists follow the text without a blank line.
+func renderItem(nodes []*adf.Node) []string {
+
+	var lines []string
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		block := renderBlock(node)
+		if len(block) == 0 {
+			continue
+		}
+
+		isList := node.Type == adf.NodeBulletList || node.Type == adf.NodeOrderedList || node.Type == adf.NodeTaskList
+		if len(lines) != 0 && !isList {
+			lines = append(lines, "")
+		}
+
+		lines = append(lines, block...)
+	}
+
+	return lines
+}
+
+func indent(line, padding string) string {
+
+	if line == "" {
+		return ""
+	}
+
+	return padding + line
+}
+
+// renderTable renders a GitHub table, the first row is the header, the cells spanning several columns are padded.
+func renderTable(node *adf.Node) []string {
+
+	var rows [][]string
+	columns := 0
+
+	for _, row := range node.Content {
+
+		var cells []string
+		for _, cell := range row.Content {
+
+			var texts []string
+			for _, block := range cell.Content {
+				if block.Type == adf.NodeParagraph || block.Type == adf.NodeHeading {
+					texts = append(texts, renderInline(block.Content, true))
+				} else if text := block.PlainText(); text != "" {
+					texts = append(texts, escapeText(strings.ReplaceAll(text, "\n", " ")))
+				}
+			}
+
+			cells = append(cells, strings.ReplaceAll(strings.Join(texts, " "), "|", `\|`))
+
+			for span := intAttr(cell.Attrs["colspan"], 1); span > 1; span-- {
+				cells = append(cells, "")
+			}
+		}
+
+		rows = append(rows, cells)
+		columns = max(columns, len(cells))
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	format := func(cells []string) string {
+
+		for len(cells) < columns {
+			cells = append(cells, "")
+		}
+
+		return "| " + strings.Join(cells, " | ") + " |"
+	}
+
+	lines := []string{format(rows[0]), format(slices.Repeat([]string{"---"}, columns))}
+	for _, row := range rows[1:] {
+		lines = append(lines, format(row))
+	}
+
+	return lines
+}
+
+// inlineMarks are the marks rendered in Markdown, from the outermost to the innermost.
+var inlineMarks = []adf.MarkType{adf.MarkLink, adf.MarkStrong, adf.MarkEm, adf.MarkStrike}
+
+// renderInline renders the inline nodes, the marks spanning several nodes are opened and closed once.
+// The hard breaks are rendered as a backslash at the end of the line, or as a space on a single line.
+func renderInline(nodes []*adf.Node, singleLine bool) string {
+
+	var (
+		builder strings.Builder
+		open    []*adf.Mark
+		pending string
+	)
+
+	closeTo := func(depth int) {
+		for len(open) > depth {
+			builder.WriteString(closing(open[len(open)-1]))
+			open = open[:len(open)-1]
+		}
+	}
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		marks := orderedMarks(node)
+
+		// The marks are closed from the first one differing from the marks of the node.
+		depth := 0
+		for depth < len(open) && depth < len(marks) && sameMark(open[depth], marks[depth]) {
+			depth++
+		}
+
+		closeTo(depth)
+
+		text := renderNode(node, singleLine)
+		trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+
+		// The emphasis cannot start or end with a space, the spaces are moved out of the marks.
+		builder.WriteString(pending)
+		builder.WriteString(text[:len(text)-len(trimmed)])
+		pending = ""
+
+		if trimmed == "" && depth < len(marks) {
+			continue
+		}
+
+		for _, mark := range marks[depth:] {
+			builder.WriteString(opening(mark))
+			open = append(open, mark)
+		}
+
+		core := strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		builder.WriteString(core)
+		pending = trimmed[len(core):]
+	}
+
+	closeTo(0)
+	builder.WriteString(pending)
+
+	return builder.String()
+}
+
+func orderedMarks(node *adf.Node) []*adf.Mark {
+
+	if node.Type != adf.NodeText || slices.ContainsFunc(node.Marks, func(m *adf.Mark) bool { return m != nil && m.Type == adf.MarkCode }) {
+
+		// The code is rendered by the node, only a link can wrap it.
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == adf.MarkLink {
+				return []*adf.Mark{mark}
+			}
+		}
+
+		return nil
+	}
+
+	var marks []*adf.Mark
+	for _, markType := range inlineMarks {
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == markType {
+				marks = append(marks, mark)
+				break
+			}
+		}
+	}
+
+	return marks
+}
+
+func sameMark(a, b *adf.Mark) bool {
+	return a.Type == b.Type && a.Attrs["href"] == b.Attrs["href"]
+}
+
+func opening(mark *adf.Mark) string {
+
+	switch mark.Type {
+	case adf.MarkLink:
+		return "["
+	case adf.MarkStrong:
+		return "**"
+	case adf.MarkEm:
+		return "*"
+	default:
+		return "~~"
+	}
+}
+
+func closing(mark *adf.Mark) string {
+
+	switch mark.Type {
+	case adf.MarkLink:
+		href, _ := mark.Attrs["href"].(string)
+		return "](" + destination(href) + ")"
+	case adf.MarkStrong:
+		return "**"
+	case adf.MarkEm:
+		return "*"
+	default:
+		return "~~"
+	}
+}
+
+// destination returns the URL of a link, between angle brackets when it holds spaces or parentheses.
+func destination(url string) string {
+
+	if strings.ContainsAny(url, " ()<>") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(url) + ">"
+	}
+
+	return url
+}
+
+// renderNode renders an inline node, the nodes without Markdown equivalent degrade to text.
+func renderNode(node *adf.Node, singleLine bool) string {
+
+	switch node.Type {
+	case adf.NodeText:
+
+		if hasMark(node, adf.MarkCode) {
+			return codeSpan(node.Text)
+		}
+
+		text := escapeText(node.Text)
+		if singleLine {
+			text = strings.ReplaceAll(text, "\n", " ")
+		}
+
+		return text
+
+	case adf.NodeHardBreak:
+
+		if singleLine {
+			return " "
+		}
+
+		return "\\\n"
+
+	case adf.NodeStatus:
+		return codeSpan(node.PlainText())
+
+	case adf.NodeInlineCard:
+
+		url, _ := node.Attrs["url"].(string)
+		if url == "" {
+			return ""
+		}
+
+		return "<" + url + ">"
+
+	case adf.NodeMedia:
+		return renderMedia(node)
+	}
+
+	return escapeText(node.PlainText())
+}
+
+// codeSpan wraps the code in a backtick run longer than the ones it holds.
+func codeSpan(code string) string {
+
+	longest, run := 0, 0
+	for _, char := range code {
+
+		if char == '`' {
+			run++
+			longest = max(longest, run)
+			continue
+		}
+
+		run = 0
+	}
+
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		code = " " + code + " "
+	}
+
+	return fence + code + fence
+}
+
+// escapeText escapes the characters of the text which Markdown would parse as formatting.
+// The underscores inside a word are not escaped, they cannot delimit an emphasis.
+func escapeText(text string) string {
+
+	var builder strings.Builder
+
+	for index := 0; index < len(text); index++ {
+
+		char := text[index]
+
+		switch char {
+		case '\\', '`', '*', '[', ']', '<', '~':
+			builder.WriteByte('\\')
+		case '_':
+			before, _ := utf8.DecodeLastRuneInString(text[:index])
+			after, _ := utf8.DecodeRuneInString(text[index+1:])
+			if index == 0 || index == len(text)-1 || !isAlphanumeric(before) || !isAlphanumeric(after) {
+				builder.WriteByte('\\')
+			}
+		}
+
+		builder.WriteByte(char)
+	}
+
+	return builder.String()
+}
+
+func isAlphanumeric(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
+
+// escapeLineStart escapes the start of a paragraph line which would be parsed as a heading, a quote or a list item.
+func escapeLineStart(line string) string {
+
+	match := lineStartPattern.FindString(line)
+	if match == "" {
+		return line
+	}
+
+	if match[0] >= '0' && match[0] <= '9' {
+		delimiter := strings.IndexAny(match, ".)")
+		return line[:delimiter] + `\` + line[delimiter:]
+	}
+
+	return `\` + line
+}
@@ -0,0 +1,300 @@
+// Package adf builds and validates Atlassian Document Format documents.
+//
+// The Atlassian Document Format (ADF) is the JSON format of the rich text of the Jira Cloud v3 API,
+// e.g. the issue descriptions, the comments and the worklogs, and of the Confluence Cloud pages.
+// The builders of this package return typed nodes, so a document is written as nested calls:
+//
+//	doc := adf.Doc(
+//		adf.Heading(2, adf.Text("Release notes")),
+//		adf.Paragraph(adf.Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"), adf.Text(" shipped "), adf.Text("SSO", adf.Strong())),
+//		adf.BulletList(
+//			adf.ListItem(adf.Paragraph(adf.Text("Login with the identity provider"))),
+//		),
+//	)
+//
+//	if err := doc.Validate(); err != nil {
+//		return err
+//	}
+//
+//	payload := &models.CommentPayloadScheme{Body: doc.CommentNode()}
+//
+// See https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+package adf
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NodeType is the type of ADF node.
+type NodeType string
+
+// The node types, grouped as in the ADF documentation.
+const (
+	NodeDoc = NodeType("doc")
+
+	// The top level block nodes.
+	NodeBlockquote  = NodeType("blockquote")
+	NodeBulletList  = NodeType("bulletList")
+	NodeCodeBlock   = NodeType("codeBlock")
+	NodeExpand      = NodeType("expand")
+	NodeHeading     = NodeType("heading")
+	NodeMediaGroup  = NodeType("mediaGroup")
+	NodeMediaSingle = NodeType("mediaSingle")
+	NodeOrderedList = NodeType("orderedList")
+	NodePanel       = NodeType("panel")
+	NodeParagraph   = NodeType("paragraph")
+	NodeRule        = NodeType("rule")
+	NodeTable       = NodeType("table")
+	NodeTaskList    = NodeType("taskList")
+
+	// The child block nodes.
+	NodeListItem     = NodeType("listItem")
+	NodeMedia        = NodeType("media")
+	NodeNestedExpand = NodeType("nestedExpand")
+	NodeTableCell    = NodeType("tableCell")
+	NodeTableHeader  = NodeType("tableHeader")
+	NodeTableRow     = NodeType("tableRow")
+	NodeTaskItem     = NodeType("taskItem")
+
+	// The inline nodes.
+	NodeDate       = NodeType("date")
+	NodeEmoji      = NodeType("emoji")
+	NodeHardBreak  = NodeType("hardBreak")
+	NodeInlineCard = NodeType("inlineCard")
+	NodeMention    = NodeType("mention")
+	NodeStatus     = NodeType("status")
+	NodeText       = NodeType("text")
+)
+
+// PanelType is the type of panel node, which sets its color and icon.
+type PanelType string
+
+const (
+	PanelInfo    = PanelType("info")
+	PanelNote    = PanelType("note")
+	PanelWarning = PanelType("warning")
+	PanelSuccess = PanelType("success")
+	PanelError   = PanelType("error")
+)
+
+// StatusColor is the color of a status lozenge.
+type StatusColor string
+
+const (
+	StatusNeutral = StatusColor("neutral")
+	StatusPurple  = StatusColor("purple")
+	StatusBlue    = StatusColor("blue")
+	StatusRed     = StatusColor("red")
+	StatusYellow  = StatusColor("yellow")
+	StatusGreen   = StatusColor("green")
+)
+
+// Node is a node of an ADF document.
+type Node struct {
+	Version int                    `json:"version,omitempty"` // The version of the format, only set on the doc node.
+	Type    NodeType               `json:"type"`              // The type of the node.
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`   // The attributes of the node.
+	Content []*Node                `json:"content,omitempty"` // The child nodes.
+	Text    string                 `json:"text,omitempty"`    // The text of the text nodes.
+	Marks   []*Mark                `json:"marks,omitempty"`   // The formatting of the text and the media nodes.
+}
+
+// Append appends the nodes to the content of the node, and returns it.
+func (n *Node) Append(content ...*Node) *Node {
+	n.Content = append(n.Content, content...)
+	return n
+}
+
+// Attr sets an attribute of the node, e.g. the colspan of a table cell, and returns it.
+func (n *Node) Attr(key string, value interface{}) *Node {
+
+	if n.Attrs == nil {
+		n.Attrs = make(map[string]interface{})
+	}
+
+	n.Attrs[key] = value
+
+	return n
+}
+
+// Mark adds the marks to the node, and returns it.
+func (n *Node) Mark(marks ...*Mark) *Node {
+	n.Marks = append(n.Marks, marks...)
+	return n
+}
+
+func newNode(nodeType NodeType, content []*Node) *Node {
+	return &Node{Type: nodeType, Content: content}
+}
+
+// Doc returns the root node of a document.
+func Doc(content ...*Node) *Node {
+	return &Node{Version: 1, Type: NodeDoc, Content: content}
+}
+
+// Paragraph returns a paragraph of inline nodes.
+func Paragraph(content ...*Node) *Node {
+	return newNode(NodeParagraph, content)
+}
+
+// Heading returns a heading of the level, from 1 to 6.
+func Heading(level int, content ...*Node) *Node {
+	return newNode(NodeHeading, content).Attr("level", level)
+}
+
+// Blockquote returns a quote of paragraphs, lists and code blocks.
+func Blockquote(content ...*Node) *Node {
+	return newNode(NodeBlockquote, content)
+}
+
+// BulletList returns an unordered list of list items.
+func BulletList(items ...*Node) *Node {
+	return newNode(NodeBulletList, items)
+}
+
+// OrderedList returns a numbered list of list items, starting at 1.
+func OrderedList(items ...*Node) *Node {
+	return newNode(NodeOrderedList, items)
+}
+
+// ListItem returns an item of a bullet or an ordered list, it starts with a paragraph, a code block or a single media.
+func ListItem(content ...*Node) *Node {
+	return newNode(NodeListItem, content)
+}
+
+// TaskList returns a list of task items, nested task lists follow the item they belong to.
+func TaskList(items ...*Node) *Node {
+	return newNode(NodeTaskList, items).Attr("localId", uuid.NewString())
+}
+
+// TaskItem returns an item of a task list, holding inline nodes.
+func TaskItem(done bool, content ...*Node) *Node {
+
+	state := "TODO"
+	if done {
+		state = "DONE"
+	}
+
+	return newNode(NodeTaskItem, content).Attr("localId", uuid.NewString()).Attr("state", state)
+}
+
+// CodeBlock returns a block of code, the language is used for the syntax highlighting and may be empty.
+func CodeBlock(language, code string) *Node {
+
+	node := newNode(NodeCodeBlock, nil)
+
+	if language != "" {
+		node.Attr("language", language)
+	}
+
+	if code != "" {
+		node.Append(Text(code))
+	}
+
+	return node
+}
+
+// Panel returns a colored box with an icon, holding paragraphs, headings, lists and code blocks.
+func Panel(panelType PanelType, content ...*Node) *Node {
+	return newNode(NodePanel, content).Attr("panelType", string(panelType))
+}
+
+// Expand returns a container hiding its content until expanded.
+func Expand(title string, content ...*Node) *Node {
+	return newNode(NodeExpand, content).Attr("title", title)
+}
+
+// NestedExpand returns an expand nested in a table cell or an expand.
+func NestedExpand(title string, content ...*Node) *Node {
+	return newNode(NodeNestedExpand, content).Attr("title", title)
+}
+
+// Rule returns a horizontal divider.
+func Rule() *Node {
+	return newNode(NodeRule, nil)
+}
+
+// Table returns a table of rows.
+func Table(rows ...*Node) *Node {
+	return newNode(NodeTable, rows).Attr("isNumberColumnEnabled", false).Attr("layout", "default")
+}
+
+// TableRow returns a row of header or regular cells.
+func TableRow(cells ...*Node) *Node {
+	return newNode(NodeTableRow, cells)
+}
+
+// TableHeader returns a header cell, holding block nodes.
+func TableHeader(content ...*Node) *Node {
+	return newNode(NodeTableHeader, content)
+}
+
+// TableCell returns a regular cell, holding block nodes.
+func TableCell(content ...*Node) *Node {
+	return newNode(NodeTableCell, content)
+}
+
+// MediaSingle returns a block displaying a single media, centered.
+func MediaSingle(media *Node) *Node {
+	return newNode(NodeMediaSingle, []*Node{media}).Attr("layout", "center")
+}
+
+// MediaGroup returns a block displaying several media as attachments.
+func MediaGroup(media ...*Node) *Node {
+	return newNode(NodeMediaGroup, media)
+}
+
+// Media returns a file stored in the Atlassian media services, e.g. an attachment.
+func Media(id, collection string) *Node {
+	return newNode(NodeMedia, nil).Attr("type", "file").Attr("id", id).Attr("collection", collection)
+}
+
+// ExternalMedia returns an image hosted outside of Atlassian.
+func ExternalMedia(url string) *Node {
+	return newNode(NodeMedia, nil).Attr("type", "external").Attr("url", url)
+}
+
+// Text returns a text node with the marks, e.g. Strong or Link.
+func Text(text string, marks ...*Mark) *Node {
+	return &Node{Type: NodeText, Text: text, Marks: marks}
+}
+
+// HardBreak returns a line break inside a paragraph.
+func HardBreak() *Node {
+	return newNode(NodeHardBreak, nil)
+}
+
+// Mention returns a mention of the user, the text is displayed when the user cannot be resolved, e.g. "@Carlos".
+func Mention(accountID, text string) *Node {
+
+	node := newNode(NodeMention, nil).Attr("id", accountID)
+
+	if text != "" {
+		node.Attr("text", text)
+	}
+
+	return node
+}
+
+// Emoji returns an emoji by its short name, e.g. ":grinning:".
+func Emoji(shortName string) *Node {
+	return newNode(NodeEmoji, nil).Attr("shortName", shortName)
+}
+
+// Status returns a colored lozenge, e.g. "IN PROGRESS".
+func Status(text string, color StatusColor) *Node {
+	return newNode(NodeStatus, nil).Attr("text", text).Attr("color", string(color))
+}
+
+// Date returns a date, displayed in the timezone of the reader.
+func Date(date time.Time) *Node {
+	return newNode(NodeDate, nil).Attr("timestamp", strconv.FormatInt(date.UnixMilli(), 10))
+}
+
+// InlineCard returns a link displayed as a card, e.g. a link to an issue or a page.
+func InlineCard(url string) *Node {
+	return newNode(NodeInlineCard, nil).Attr("url", url)
+}
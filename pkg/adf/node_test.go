@@ -0,0 +1,167 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestBuilders(t *testing.T) {
+
+	doc := Doc(
+		Heading(2, Text("Release notes")),
+		Paragraph(
+			Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"),
+			Text(" shipped "),
+			Text("SSO", Strong(), Link("https://ctreminiom.atlassian.net/browse/KP-1")),
+			HardBreak(),
+			Emoji(":tada:"),
+			Status("DONE", StatusGreen),
+			Date(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			InlineCard("https://ctreminiom.atlassian.net/browse/KP-2"),
+		),
+		OrderedList(ListItem(Paragraph(Text("first")))).Attr("order", 3),
+		CodeBlock("go", "fmt.Println()"),
+		Panel(PanelWarning, Paragraph(Text("careful", Em()))),
+		Table(
+			TableRow(TableHeader(Paragraph(Text("Key"))), TableHeader(Paragraph(Text("Summary")))),
+			TableRow(TableCell(Paragraph(Text("KP-1"))).Attr("colspan", 2)),
+		),
+		MediaSingle(Media("6e7c7f2c-dd7a-499c-bceb-6f32bfbf30b5", "").Mark(Border(2, "#091e4224"))),
+		Expand("Details", Paragraph(Text("hidden"))),
+		Rule(),
+	)
+
+	require.NoError(t, doc.Validate())
+
+	content, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	expected := `{"version":1,"type":"doc","content":[` +
+		`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Release notes"}]},` +
+		`{"type":"paragraph","content":[` +
+		`{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@Carlos"}},` +
+		`{"type":"text","text":" shipped "},` +
+		`{"type":"text","text":"SSO","marks":[{"type":"strong"},{"type":"link","attrs":{"href":"https://ctreminiom.atlassian.net/browse/KP-1"}}]},` +
+		`{"type":"hardBreak"},` +
+		`{"type":"emoji","attrs":{"shortName":":tada:"}},` +
+		`{"type":"status","attrs":{"color":"green","text":"DONE"}},` +
+		`{"type":"date","attrs":{"timestamp":"1709251200000"}},` +
+		`{"type":"inlineCard","attrs":{"url":"https://ctreminiom.atlassian.net/browse/KP-2"}}]},` +
+		`{"type":"orderedList","attrs":{"order":3},"content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"first"}]}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println()"}]},` +
+		`{"type":"panel","attrs":{"panelType":"warning"},"content":[{"type":"paragraph","content":[{"type":"text","text":"careful","marks":[{"type":"em"}]}]}]},` +
+		`{"type":"table","attrs":{"isNumberColumnEnabled":false,"layout":"default"},"content":[` +
+		`{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Summary"}]}]}]},` +
+		`{"type":"tableRow","content":[{"type":"tableCell","attrs":{"colspan":2},"content":[{"type":"paragraph","content":[{"type":"text","text":"KP-1"}]}]}]}]},` +
+		`{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media","attrs":{"collection":"","id":"6e7c7f2c-dd7a-499c-bceb-6f32bfbf30b5","type":"file"},"marks":[{"type":"border","attrs":{"color":"#091e4224","size":2}}]}]},` +
+		`{"type":"expand","attrs":{"title":"Details"},"content":[{"type":"paragraph","content":[{"type":"text","text":"hidden"}]}]},` +
+		`{"type":"rule"}]}`
+
+	assert.JSONEq(t, expected, string(content))
+}
+
+func TestCommentNode(t *testing.T) {
+
+	doc := Doc(
+		Paragraph(Text("Hello ", Strong()), Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos")),
+		BulletList(ListItem(Paragraph(Text("item")))),
+	)
+
+	node := doc.CommentNode()
+
+	assert.Equal(t, 1, node.Version)
+	assert.Equal(t, "doc", node.Type)
+	assert.Equal(t, "strong", node.Content[0].Content[0].Marks[0].Type)
+	assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", node.Content[0].Content[1].Attrs["id"])
+	assert.Equal(t, "listItem", node.Content[1].Content[0].Type)
+
+	// The nodes do not share their attributes.
+	node.Content[0].Content[1].Attrs["id"] = "changed"
+	assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", doc.Content[0].Content[1].Attrs["id"])
+
+	assert.Equal(t, doc, FromCommentNode(doc.CommentNode()))
+	assert.Nil(t, FromCommentNode(nil))
+}
+
+func TestFromCommentNode(t *testing.T) {
+
+	// The description of an issue, as decoded from the Jira API.
+	payload := `{"version":1,"type":"doc","content":[
+		{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Steps"}]},
+		{"type":"orderedList","attrs":{"order":1},"content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"Login"}]}]}]},
+		{"type":"mediaSingle","attrs":{"layout":"center","width":50},"content":[{"type":"media","attrs":{"type":"file","id":"7e1c","collection":"","width":640,"height":480}}]}
+	]}`
+
+	description := new(model.CommentNodeScheme)
+	require.NoError(t, json.Unmarshal([]byte(payload), description))
+
+	doc := FromCommentNode(description)
+	require.NoError(t, doc.Validate())
+
+	assert.Equal(t, NodeHeading, doc.Content[0].Type)
+	assert.Equal(t, "Steps", doc.Content[0].Content[0].Text)
+}
+
+func TestFit(t *testing.T) {
+
+	content := Fit(NodeListItem,
+		Heading(2, Text("Title")),
+		Blockquote(Paragraph(Text("quoted"))),
+		Table(TableRow(TableCell(Paragraph(Text("a"))), TableCell(Paragraph(Text("b"))))),
+		Rule(),
+		TaskList(TaskItem(true, Text("done"))),
+	)
+
+	item := ListItem(content...)
+	require.NoError(t, item.Validate())
+
+	assert.Equal(t, "- Title\n  quoted\n  a | b\n  - [x] done", BulletList(item).PlainText())
+
+	// The list item starts with a paragraph.
+	content = Fit(NodeListItem, BulletList(ListItem(Paragraph(Text("nested")))))
+	assert.Equal(t, NodeParagraph, content[0].Type)
+
+	// The inline nodes are wrapped in a paragraph.
+	content = Fit(NodeDoc, Text("loose"), Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"), Expand("More", Paragraph(Text("hidden"))))
+	require.NoError(t, Doc(content...).Validate())
+	assert.Len(t, content, 2)
+
+	// The expands in a table cell are nested expands.
+	cell := TableCell(Fit(NodeTableCell, Expand("More", Paragraph(Text("hidden"))))...)
+	assert.Equal(t, NodeNestedExpand, cell.Content[0].Type)
+}
+
+func TestNode_PlainText(t *testing.T) {
+
+	doc := Doc(
+		Heading(1, Text("Release")),
+		Paragraph(Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"), Text(" shipped on "), Date(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)), HardBreak(), Status("DONE", StatusGreen), Text(" "), Emoji(":tada:")),
+		OrderedList(ListItem(Paragraph(Text("first")), BulletList(ListItem(Paragraph(Text("nested")))))).Attr("order", 2),
+		TaskList(TaskItem(false, Text("todo")), TaskList(TaskItem(true, Text("sub task")))),
+		CodeBlock("go", "a := 1\nb := 2"),
+		Table(TableRow(TableHeader(Paragraph(Text("Key"))), TableHeader(Paragraph(Text("Summary"))))),
+		Expand("Details", Paragraph(InlineCard("https://ctreminiom.atlassian.net/browse/KP-1"))),
+		Rule(),
+	)
+
+	expected := "Release\n" +
+		"@Carlos shipped on 2024-03-01\n" +
+		"DONE :tada:\n" +
+		"2. first\n" +
+		"   - nested\n" +
+		"[ ] todo\n" +
+		"  [x] sub task\n" +
+		"a := 1\nb := 2\n" +
+		"Key | Summary\n" +
+		"Details\n" +
+		"https://ctreminiom.atlassian.net/browse/KP-1\n" +
+		"---"
+
+	assert.Equal(t, expected, doc.PlainText())
+}
@@ -0,0 +1,177 @@
+package adf
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PlainText returns the text of the node without formatting, e.g. to display a description in a terminal.
+//
+// The blocks are separated by line breaks, the list items are prefixed by "- ", "1. " or "[ ] ",
+// the table cells are separated by " | " and the code blocks keep their text as is.
+// The mentions, the emojis, the status and the dates are replaced by their text,
+// the inline cards by their URL, the media by their alternative text.
+func (n *Node) PlainText() string {
+
+	var lines []string
+	n.plainText("", &lines)
+
+	return strings.Join(lines, "\n")
+}
+
+// plainText appends the lines of the block node, prefixed by the indentation.
+func (n *Node) plainText(indent string, lines *[]string) {
+
+	if n == nil {
+		return
+	}
+
+	switch n.Type {
+	case NodeParagraph, NodeHeading, NodeTaskItem, NodeCodeBlock:
+
+		for _, line := range strings.Split(inlineString(n), "\n") {
+			*lines = append(*lines, indent+line)
+		}
+
+	case NodeBulletList, NodeOrderedList, NodeTaskList:
+
+		order, ok := intAttr(n.Attrs, "order")
+		if !ok {
+			order = 1
+		}
+
+		for _, item := range n.Content {
+
+			var marker string
+
+			switch {
+			case item.Type == NodeTaskList:
+				item.plainText(indent+"  ", lines)
+				continue
+			case n.Type == NodeOrderedList:
+				marker = strconv.Itoa(order) + ". "
+				order++
+			case n.Type == NodeTaskList:
+				marker = "[ ] "
+				if state, _ := stringAttr(item.Attrs, "state"); state == "DONE" {
+					marker = "[x] "
+				}
+			default:
+				marker = "- "
+			}
+
+			var itemLines []string
+			if item.Type == NodeTaskItem {
+				itemLines = strings.Split(inlineString(item), "\n")
+			} else {
+				for _, child := range item.Content {
+					child.plainText("", &itemLines)
+				}
+			}
+
+			for index, line := range itemLines {
+
+				if index == 0 {
+					*lines = append(*lines, indent+marker+line)
+					continue
+				}
+
+				*lines = append(*lines, indent+strings.Repeat(" ", len(marker))+line)
+			}
+		}
+
+	case NodeTable:
+
+		for _, row := range n.Content {
+
+			var cells []string
+			for _, cell := range row.Content {
+				cells = append(cells, strings.ReplaceAll(cell.PlainText(), "\n", " "))
+			}
+
+			*lines = append(*lines, indent+strings.Join(cells, " | "))
+		}
+
+	case NodeExpand, NodeNestedExpand:
+
+		if title, _ := stringAttr(n.Attrs, "title"); title != "" {
+			*lines = append(*lines, indent+title)
+		}
+
+		for _, child := range n.Content {
+			child.plainText(indent, lines)
+		}
+
+	case NodeRule:
+		*lines = append(*lines, indent+"---")
+
+	default:
+
+		if slices.Contains(inlineNodes, n.Type) || n.Type == NodeMedia {
+			*lines = append(*lines, indent+inlineString(n))
+			return
+		}
+
+		for _, child := range n.Content {
+			child.plainText(indent, lines)
+		}
+	}
+}
+
+// inlineString returns the text of an inline node, or of the inline nodes of a block.
+func inlineString(n *Node) string {
+
+	switch n.Type {
+	case NodeText:
+		return n.Text
+	case NodeHardBreak:
+		return "\n"
+	case NodeMention:
+		if text, _ := stringAttr(n.Attrs, "text"); text != "" {
+			return text
+		}
+		id, _ := stringAttr(n.Attrs, "id")
+		return "@" + id
+	case NodeEmoji:
+		if text, _ := stringAttr(n.Attrs, "text"); text != "" {
+			return text
+		}
+		shortName, _ := stringAttr(n.Attrs, "shortName")
+		return shortName
+	case NodeStatus:
+		text, _ := stringAttr(n.Attrs, "text")
+		return text
+	case NodeDate:
+		return dateString(n)
+	case NodeInlineCard:
+		url, _ := stringAttr(n.Attrs, "url")
+		return url
+	case NodeMedia:
+		alt, _ := stringAttr(n.Attrs, "alt")
+		return alt
+	}
+
+	var builder strings.Builder
+	for _, child := range n.Content {
+		if child != nil {
+			builder.WriteString(inlineString(child))
+		}
+	}
+
+	return builder.String()
+}
+
+// dateString returns the date of a date node as YYYY-MM-DD, in UTC.
+func dateString(n *Node) string {
+
+	timestamp, _ := stringAttr(n.Attrs, "timestamp")
+
+	milliseconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return timestamp
+	}
+
+	return time.UnixMilli(milliseconds).UTC().Format(time.DateOnly)
+}
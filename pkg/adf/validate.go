@@ -0,0 +1,432 @@
+package adf
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ValidationError is a violation of the ADF schema by a node.
+type ValidationError struct {
+	Path    string   // The path of the node from the validated one, e.g. content[1].content[0].
+	Type    NodeType // The type of the node.
+	Message string   // The violated rule.
+}
+
+func (e *ValidationError) Error() string {
+
+	path := e.Path
+	if path == "" {
+		path = "root"
+	}
+
+	return fmt.Sprintf("adf: %v (%v): %v", path, e.Type, e.Message)
+}
+
+// Unwrap returns models.ErrInvalidADF, so every violation matches it with errors.Is.
+func (e *ValidationError) Unwrap() error {
+	return model.ErrInvalidADF
+}
+
+// rule is the schema of a node type.
+type rule struct {
+	content []NodeType // The types of the child nodes, the node is a leaf when empty.
+	first   []NodeType // The types allowed as the first child, when restricted.
+	min     int        // The minimum number of child nodes.
+	max     int        // The maximum number of child nodes, unlimited when 0.
+	marks   []MarkType // The marks allowed on the node.
+	attrs   func(*Node) string
+}
+
+var (
+	inlineNodes = []NodeType{NodeText, NodeHardBreak, NodeMention, NodeEmoji, NodeDate, NodeStatus, NodeInlineCard}
+
+	topLevelNodes = []NodeType{
+		NodeBlockquote, NodeBulletList, NodeCodeBlock, NodeExpand, NodeHeading, NodeMediaGroup,
+		NodeMediaSingle, NodeOrderedList, NodePanel, NodeParagraph, NodeRule, NodeTable, NodeTaskList,
+	}
+
+	cellNodes = []NodeType{
+		NodeBlockquote, NodeBulletList, NodeCodeBlock, NodeHeading, NodeMediaGroup, NodeMediaSingle,
+		NodeNestedExpand, NodeOrderedList, NodePanel, NodeParagraph, NodeRule, NodeTaskList,
+	}
+
+	textMarks = []MarkType{
+		MarkBackgroundColor, MarkCode, MarkEm, MarkLink, MarkStrike, MarkStrong, MarkSubSup, MarkTextColor, MarkUnderline,
+	}
+
+	panelTypes    = []string{string(PanelInfo), string(PanelNote), string(PanelWarning), string(PanelSuccess), string(PanelError)}
+	statusColors  = []string{string(StatusNeutral), string(StatusPurple), string(StatusBlue), string(StatusRed), string(StatusYellow), string(StatusGreen)}
+	mediaLayouts  = []string{"wrap-left", "center", "wrap-right", "wide", "full-width", "align-start", "align-end"}
+	colorPattern  = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	digitsPattern = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// rules are the schema rules of the node types, following the ADF documentation.
+var rules = map[NodeType]*rule{
+	NodeDoc:          {content: topLevelNodes},
+	NodeParagraph:    {content: inlineNodes},
+	NodeHeading:      {content: inlineNodes, attrs: headingAttrs},
+	NodeBlockquote:   {content: []NodeType{NodeParagraph, NodeBulletList, NodeOrderedList, NodeCodeBlock, NodeMediaGroup, NodeMediaSingle}, min: 1},
+	NodeBulletList:   {content: []NodeType{NodeListItem}, min: 1},
+	NodeOrderedList:  {content: []NodeType{NodeListItem}, min: 1, attrs: orderedListAttrs},
+	NodeCodeBlock:    {content: []NodeType{NodeText}, attrs: codeBlockAttrs},
+	NodeRule:         {},
+	NodeHardBreak:    {},
+	NodeMediaGroup:   {content: []NodeType{NodeMedia}, min: 1},
+	NodeMediaSingle:  {content: []NodeType{NodeMedia}, min: 1, max: 1, attrs: mediaSingleAttrs},
+	NodeMedia:        {marks: []MarkType{MarkBorder, MarkLink}, attrs: mediaAttrs},
+	NodeTable:        {content: []NodeType{NodeTableRow}, min: 1},
+	NodeTableRow:     {content: []NodeType{NodeTableHeader, NodeTableCell}, min: 1},
+	NodeTableHeader:  {content: cellNodes, min: 1, attrs: cellAttrs},
+	NodeTableCell:    {content: cellNodes, min: 1, attrs: cellAttrs},
+	NodeText:         {marks: textMarks},
+	NodeMention:      {attrs: requiredAttrs("id")},
+	NodeEmoji:        {attrs: requiredAttrs("shortName")},
+	NodeStatus:       {attrs: statusAttrs},
+	NodeDate:         {attrs: dateAttrs},
+	NodeInlineCard:   {attrs: inlineCardAttrs},
+	NodePanel:        {content: []NodeType{NodeParagraph, NodeHeading, NodeBulletList, NodeOrderedList, NodeCodeBlock, NodeMediaGroup, NodeMediaSingle, NodeRule, NodeTaskList}, min: 1, attrs: panelAttrs},
+	NodeExpand:       {content: append(slices.Clone(cellNodes), NodeTable), min: 1, attrs: titleAttrs},
+	NodeNestedExpand: {content: []NodeType{NodeParagraph, NodeHeading, NodeBulletList, NodeOrderedList, NodeCodeBlock, NodeMediaGroup, NodeMediaSingle, NodeBlockquote, NodePanel, NodeRule, NodeTaskList}, min: 1, attrs: titleAttrs},
+	NodeTaskList:     {content: []NodeType{NodeTaskItem, NodeTaskList}, first: []NodeType{NodeTaskItem}, min: 1, attrs: requiredAttrs("localId")},
+	NodeTaskItem:     {content: inlineNodes, attrs: taskItemAttrs},
+	NodeListItem: {
+		content: []NodeType{NodeParagraph, NodeBulletList, NodeOrderedList, NodeCodeBlock, NodeMediaSingle},
+		first:   []NodeType{NodeParagraph, NodeCodeBlock, NodeMediaSingle},
+		min:     1,
+	},
+}
+
+// Validate checks the node and its descendants against the rules of the ADF schema,
+// e.g. which nodes may contain which, the required attributes or the allowed marks.
+//
+// All the violations are returned, joined, each one is a *ValidationError matching models.ErrInvalidADF.
+func (n *Node) Validate() error {
+
+	var violations []error
+	n.validate("", &violations)
+
+	return errors.Join(violations...)
+}
+
+func (n *Node) validate(path string, violations *[]error) {
+
+	report := func(format string, args ...interface{}) {
+		*violations = append(*violations, &ValidationError{Path: path, Type: n.Type, Message: fmt.Sprintf(format, args...)})
+	}
+
+	r, ok := rules[n.Type]
+	if !ok {
+		report("unknown node type")
+		return
+	}
+
+	if n.Type == NodeDoc && n.Version != 1 {
+		report("the version must be 1")
+	}
+
+	switch {
+	case n.Type == NodeText && n.Text == "":
+		report("the text must not be empty")
+	case n.Type != NodeText && n.Text != "":
+		report("only the text nodes have a text")
+	}
+
+	switch {
+	case len(r.content) == 0 && len(n.Content) != 0:
+		report("the node cannot have content")
+	case len(n.Content) < r.min:
+		report("the node must contain at least %d child node(s)", r.min)
+	case r.max != 0 && len(n.Content) > r.max:
+		report("the node must contain at most %d child node(s)", r.max)
+	}
+
+	if r.attrs != nil {
+		if message := r.attrs(n); message != "" {
+			report("%v", message)
+		}
+	}
+
+	n.validateMarks(r, report)
+
+	for index, child := range n.Content {
+
+		childPath := "content[" + strconv.Itoa(index) + "]"
+		if path != "" {
+			childPath = path + "." + childPath
+		}
+
+		if child == nil {
+			*violations = append(*violations, &ValidationError{Path: childPath, Message: "the node is nil"})
+			continue
+		}
+
+		switch {
+		case len(r.content) == 0:
+		case child.Type == NodeDoc:
+			report("a %v can only be the root node", NodeDoc)
+		case index == 0 && len(r.first) != 0 && !slices.Contains(r.first, child.Type):
+			report("the first node must be one of %v, not %v", r.first, child.Type)
+		case !slices.Contains(r.content, child.Type) && rules[child.Type] != nil:
+			report("a %v cannot contain a %v", n.Type, child.Type)
+		}
+
+		child.validate(childPath, violations)
+	}
+}
+
+func (n *Node) validateMarks(r *rule, report func(string, ...interface{})) {
+
+	seen := make(map[MarkType]bool)
+
+	for _, mark := range n.Marks {
+
+		if mark == nil {
+			report("the mark is nil")
+			continue
+		}
+
+		if !slices.Contains(r.marks, mark.Type) {
+			report("the %v mark is not allowed", mark.Type)
+			continue
+		}
+
+		if seen[mark.Type] {
+			report("the %v mark is applied twice", mark.Type)
+		}
+
+		seen[mark.Type] = true
+
+		if message := mark.validate(); message != "" {
+			report("%v", message)
+		}
+	}
+
+	if seen[MarkCode] {
+		for _, mark := range n.Marks {
+			if mark != nil && mark.Type != MarkCode && mark.Type != MarkLink {
+				report("the %v mark can only be combined with the %v mark, not %v", MarkCode, MarkLink, mark.Type)
+			}
+		}
+	}
+}
+
+func (m *Mark) validate() string {
+
+	switch m.Type {
+	case MarkLink:
+		if href, ok := stringAttr(m.Attrs, "href"); !ok || href == "" {
+			return "the link mark requires a href"
+		}
+	case MarkSubSup:
+		if kind, _ := stringAttr(m.Attrs, "type"); kind != "sub" && kind != "sup" {
+			return "the subsup mark type must be sub or sup"
+		}
+	case MarkTextColor, MarkBackgroundColor:
+		if color, _ := stringAttr(m.Attrs, "color"); !colorPattern.MatchString(color) {
+			return fmt.Sprintf("the %v mark requires a hex color, e.g. #ff5630", m.Type)
+		}
+	case MarkBorder:
+		if size, ok := intAttr(m.Attrs, "size"); !ok || size < 1 || size > 3 {
+			return "the border mark size must be from 1 to 3"
+		}
+	}
+
+	return ""
+}
+
+func stringAttr(attrs map[string]interface{}, key string) (string, bool) {
+	value, ok := attrs[key].(string)
+	return value, ok
+}
+
+// intAttr returns an integer attribute, set by the builders or decoded from JSON as a float64.
+func intAttr(attrs map[string]interface{}, key string) (int, bool) {
+
+	switch value := attrs[key].(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case float64:
+		return int(value), value == float64(int(value))
+	default:
+		return 0, false
+	}
+}
+
+func requiredAttrs(keys ...string) func(*Node) string {
+
+	return func(n *Node) string {
+
+		for _, key := range keys {
+			if value, ok := stringAttr(n.Attrs, key); !ok || value == "" {
+				return "the " + key + " attribute is required"
+			}
+		}
+
+		return ""
+	}
+}
+
+func oneOf(n *Node, key string, values []string, required bool) string {
+
+	value, ok := n.Attrs[key]
+	if !ok && !required {
+		return ""
+	}
+
+	if text, _ := value.(string); !slices.Contains(values, text) {
+		return fmt.Sprintf("the %v attribute must be one of %v", key, strings.Join(values, ", "))
+	}
+
+	return ""
+}
+
+func headingAttrs(n *Node) string {
+
+	if level, ok := intAttr(n.Attrs, "level"); !ok || level < 1 || level > 6 {
+		return "the level attribute must be from 1 to 6"
+	}
+
+	return ""
+}
+
+func orderedListAttrs(n *Node) string {
+
+	if _, ok := n.Attrs["order"]; !ok {
+		return ""
+	}
+
+	if order, ok := intAttr(n.Attrs, "order"); !ok || order < 0 {
+		return "the order attribute must be a positive number"
+	}
+
+	return ""
+}
+
+// codeBlockAttrs checks the language, and the text of the code block since it cannot be formatted.
+func codeBlockAttrs(n *Node) string {
+
+	if value, ok := n.Attrs["language"]; ok {
+		if _, ok := value.(string); !ok {
+			return "the language attribute must be a string"
+		}
+	}
+
+	for _, child := range n.Content {
+		if child != nil && len(child.Marks) != 0 {
+			return "the text of a code block cannot have marks"
+		}
+	}
+
+	return ""
+}
+
+func taskItemAttrs(n *Node) string {
+
+	if message := requiredAttrs("localId")(n); message != "" {
+		return message
+	}
+
+	return oneOf(n, "state", []string{"TODO", "DONE"}, true)
+}
+
+func panelAttrs(n *Node) string {
+	return oneOf(n, "panelType", panelTypes, true)
+}
+
+func titleAttrs(n *Node) string {
+
+	if value, ok := n.Attrs["title"]; ok {
+		if _, ok := value.(string); !ok {
+			return "the title attribute must be a string"
+		}
+	}
+
+	return ""
+}
+
+func mediaSingleAttrs(n *Node) string {
+	return oneOf(n, "layout", mediaLayouts, false)
+}
+
+func mediaAttrs(n *Node) string {
+
+	switch kind, _ := stringAttr(n.Attrs, "type"); kind {
+	case "file", "link":
+
+		if id, _ := stringAttr(n.Attrs, "id"); id == "" {
+			return "the id attribute is required"
+		}
+
+		if _, ok := stringAttr(n.Attrs, "collection"); !ok {
+			return "the collection attribute is required"
+		}
+
+	case "external":
+		return requiredAttrs("url")(n)
+
+	default:
+		return "the type attribute must be one of file, link, external"
+	}
+
+	return ""
+}
+
+func cellAttrs(n *Node) string {
+
+	for _, key := range []string{"colspan", "rowspan"} {
+
+		if _, ok := n.Attrs[key]; !ok {
+			continue
+		}
+
+		if span, ok := intAttr(n.Attrs, key); !ok || span < 1 {
+			return "the " + key + " attribute must be a number greater than 0"
+		}
+	}
+
+	if color, ok := n.Attrs["background"]; ok {
+		if _, ok := color.(string); !ok {
+			return "the background attribute must be a string"
+		}
+	}
+
+	return ""
+}
+
+func statusAttrs(n *Node) string {
+
+	if message := requiredAttrs("text")(n); message != "" {
+		return message
+	}
+
+	return oneOf(n, "color", statusColors, true)
+}
+
+// dateAttrs checks the timestamp, the milliseconds since the epoch as a string.
+func dateAttrs(n *Node) string {
+
+	if timestamp, _ := stringAttr(n.Attrs, "timestamp"); !digitsPattern.MatchString(timestamp) {
+		return "the timestamp attribute must be the milliseconds since the epoch, as a string"
+	}
+
+	return ""
+}
+
+func inlineCardAttrs(n *Node) string {
+
+	url, _ := stringAttr(n.Attrs, "url")
+	if url == "" && n.Attrs["data"] == nil {
+		return "the url or the data attribute is required"
+	}
+
+	return ""
+}
@@ -0,0 +1,150 @@
+package adf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestNode_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		node    *Node
+		wantErr []string
+	}{
+		{
+			name: "when the document is valid",
+			node: Doc(Paragraph(Text("Hello")), BulletList(ListItem(Paragraph(Text("item")), BulletList(ListItem(Paragraph()))))),
+		},
+		{
+			name: "when the fragment is valid",
+			node: Paragraph(Text("inline", Code(), Link("https://example.com"))),
+		},
+		{
+			name:    "when the version of the document is missing",
+			node:    &Node{Type: NodeDoc},
+			wantErr: []string{"adf: root (doc): the version must be 1"},
+		},
+		{
+			name:    "when the node type is unknown",
+			node:    Doc(&Node{Type: "carousel"}),
+			wantErr: []string{"adf: content[0] (carousel): unknown node type"},
+		},
+		{
+			name:    "when a block node is in a paragraph",
+			node:    Doc(Paragraph(Heading(1, Text("title")))),
+			wantErr: []string{"adf: content[0] (paragraph): a paragraph cannot contain a heading"},
+		},
+		{
+			name:    "when an inline node is at the top level",
+			node:    Doc(Text("loose")),
+			wantErr: []string{"adf: root (doc): a doc cannot contain a text"},
+		},
+		{
+			name:    "when a document is nested",
+			node:    Doc(Doc()),
+			wantErr: []string{"adf: root (doc): a doc can only be the root node"},
+		},
+		{
+			name:    "when the list item does not start with a paragraph",
+			node:    Doc(BulletList(ListItem(BulletList(ListItem(Paragraph()))))),
+			wantErr: []string{"adf: content[0].content[0] (listItem): the first node must be one of [paragraph codeBlock mediaSingle], not bulletList"},
+		},
+		{
+			name: "when the lists and the tables are empty",
+			node: Doc(BulletList(), Table(TableRow())),
+			wantErr: []string{
+				"adf: content[0] (bulletList): the node must contain at least 1 child node(s)",
+				"adf: content[1].content[0] (tableRow): the node must contain at least 1 child node(s)",
+			},
+		},
+		{
+			name:    "when a single media holds two media",
+			node:    Doc(MediaSingle(Media("1", "")).Append(ExternalMedia("https://example.com/logo.png"))),
+			wantErr: []string{"adf: content[0] (mediaSingle): the node must contain at most 1 child node(s)"},
+		},
+		{
+			name: "when the attributes are invalid",
+			node: Doc(
+				Heading(7, Text("deep")),
+				Panel("purple", Paragraph()),
+				Paragraph(Mention("", ""), Status("", StatusBlue), Emoji(""), InlineCard(""), &Node{Type: NodeDate, Attrs: map[string]interface{}{"timestamp": "yesterday"}}),
+				MediaGroup(&Node{Type: NodeMedia, Attrs: map[string]interface{}{"type": "file", "id": "1"}}),
+				Table(TableRow(TableCell(Paragraph()).Attr("rowspan", 0))),
+			),
+			wantErr: []string{
+				"adf: content[0] (heading): the level attribute must be from 1 to 6",
+				"adf: content[1] (panel): the panelType attribute must be one of info, note, warning, success, error",
+				"adf: content[2].content[0] (mention): the id attribute is required",
+				"adf: content[2].content[1] (status): the text attribute is required",
+				"adf: content[2].content[2] (emoji): the shortName attribute is required",
+				"adf: content[2].content[3] (inlineCard): the url or the data attribute is required",
+				"adf: content[2].content[4] (date): the timestamp attribute must be the milliseconds since the epoch, as a string",
+				"adf: content[3].content[0] (media): the collection attribute is required",
+				"adf: content[4].content[0].content[0] (tableCell): the rowspan attribute must be a number greater than 0",
+			},
+		},
+		{
+			name: "when the marks are invalid",
+			node: Paragraph(
+				Text("code", Code(), Strong()),
+				Text("twice", Em(), Em()),
+				Text("link", Link("")),
+				Text("color", TextColor("red")),
+				Emoji(":tada:").Mark(Strong()),
+			),
+			wantErr: []string{
+				"adf: content[0] (text): the code mark can only be combined with the link mark, not strong",
+				"adf: content[1] (text): the em mark is applied twice",
+				"adf: content[2] (text): the link mark requires a href",
+				"adf: content[3] (text): the textColor mark requires a hex color, e.g. #ff5630",
+				"adf: content[4] (emoji): the strong mark is not allowed",
+			},
+		},
+		{
+			name: "when the text is misplaced",
+			node: Doc(CodeBlock("go", "").Append(Text("x", Strong())), Paragraph(Text("")), &Node{Type: NodeRule, Text: "---"}),
+			wantErr: []string{
+				"adf: content[0] (codeBlock): the text of a code block cannot have marks",
+				"adf: content[1].content[0] (text): the text must not be empty",
+				"adf: content[2] (rule): only the text nodes have a text",
+			},
+		},
+		{
+			name:    "when a leaf node has content",
+			node:    Paragraph(Mention("1", "").Append(Text("x"))),
+			wantErr: []string{"adf: content[0] (mention): the node cannot have content"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := testCase.node.Validate()
+
+			if len(testCase.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, model.ErrInvalidADF)
+
+			var messages []string
+			for _, violation := range err.(interface{ Unwrap() []error }).Unwrap() {
+
+				var validationError *ValidationError
+				require.True(t, errors.As(violation, &validationError))
+
+				messages = append(messages, violation.Error())
+			}
+
+			assert.Equal(t, testCase.wantErr, messages)
+		})
+	}
+}
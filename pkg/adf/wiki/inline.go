@@ -0,0 +1,450 @@
+package wiki
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+var (
+	urlPattern          = regexp.MustCompile(`^(?:(?:https?|ftp)://|mailto:)[^\s\]|!]+`)
+	entityPattern       = regexp.MustCompile(`^&#([0-9]{1,7});`)
+	colorPattern        = regexp.MustCompile(`^\{color:([#A-Za-z0-9]+)\}`)
+	bracedMarkPattern   = regexp.MustCompile(`^\{([*_\-+^~]|\?\?)\}`)
+	trailingPunctuation = ".,;:!?)'\""
+)
+
+// specialCharacters are the characters a backslash escapes.
+const specialCharacters = `\*_-+^~?{}[]|!#&(:;`
+
+// delimiterMarks are the marks of the inline delimiters, e.g. *bold*.
+var delimiterMarks = map[string]func() *adf.Mark{
+	"*":  adf.Strong,
+	"_":  adf.Em,
+	"??": adf.Em,
+	"-":  adf.Strike,
+	"+":  adf.Underline,
+	"^":  adf.Sup,
+	"~":  adf.Sub,
+}
+
+// colorNames are the hex codes of the color names accepted by the {color} macro.
+var colorNames = map[string]string{
+	"black":  "#000000",
+	"white":  "#ffffff",
+	"red":    "#ff0000",
+	"green":  "#008000",
+	"blue":   "#0000ff",
+	"yellow": "#ffff00",
+	"orange": "#ffa500",
+	"purple": "#800080",
+	"gray":   "#808080",
+	"grey":   "#808080",
+}
+
+// emoticons are the Atlassian emojis of the wiki emoticons.
+var emoticons = []struct {
+	text, name string
+}{
+	{"(/)", "check_mark"},
+	{"(x)", "cross_mark"},
+	{"(!)", "warning"},
+	{"(i)", "info"},
+	{"(?)", "question"},
+	{"(y)", "thumbs_up"},
+	{"(n)", "thumbs_down"},
+	{"(*)", "yellow_star"},
+	{":)", "smile"},
+	{":(", "sad"},
+	{":P", "tongue"},
+	{":D", "biggrin"},
+	{";)", "wink"},
+}
+
+// unclosed records, by delimiter, the span of a line scanned without finding a closing delimiter. The opening
+// delimiters found later in the span have no closing delimiter either, they are not scanned again.
+type unclosed map[string]struct{ from, to int }
+
+// inline parses the inline content of a block, e.g. the text of a paragraph, the line endings are hard breaks.
+func inline(source string) []*adf.Node {
+
+	var (
+		nodes   []*adf.Node
+		text    strings.Builder
+		scanned = make(unclosed)
+	)
+
+	push := func(parsed ...*adf.Node) {
+
+		if text.Len() != 0 {
+			nodes = append(nodes, adf.Text(text.String()))
+			text.Reset()
+		}
+
+		nodes = append(nodes, parsed...)
+	}
+
+	for index := 0; index < len(source); {
+
+		char := source[index]
+
+		if parsed, end, ok := inlineNode(source, index, scanned); ok {
+			push(parsed...)
+			index = end
+			continue
+		}
+
+		switch {
+		case char == '\\' && index+1 < len(source) && source[index+1] == '\\':
+			push(adf.HardBreak())
+			index += 2
+
+		case char == '\\' && index+1 < len(source) && strings.IndexByte(specialCharacters, source[index+1]) != -1:
+			text.WriteByte(source[index+1])
+			index += 2
+
+		case char == '\n':
+			push(adf.HardBreak())
+			index++
+
+		case char == '&' && entityPattern.MatchString(source[index:]):
+			match := entityPattern.FindStringSubmatch(source[index:])
+			code, _ := strconv.Atoi(match[1])
+			text.WriteRune(rune(code))
+			index += len(match[0])
+
+		default:
+			text.WriteByte(char)
+			index++
+		}
+	}
+
+	push()
+
+	return merge(nodes)
+}
+
+// inlineNode parses the formatted text, the link, the image or the emoticon at the index, and returns the index following it.
+func inlineNode(source string, index int, scanned unclosed) ([]*adf.Node, int, bool) {
+
+	rest := source[index:]
+
+	switch {
+	case strings.HasPrefix(rest, "{{"):
+
+		end := strings.Index(rest[2:], "}}")
+		if end <= 0 {
+			return nil, 0, false
+		}
+
+		return []*adf.Node{adf.Text(rest[2:end+2], adf.Code())}, index + end + 4, true
+
+	case colorPattern.MatchString(rest):
+
+		match := colorPattern.FindStringSubmatch(rest)
+
+		end := strings.Index(rest[len(match[0]):], "{color}")
+		if end == -1 {
+			return nil, 0, false
+		}
+
+		nodes := inline(rest[len(match[0]) : len(match[0])+end])
+
+		if color := hexColor(match[1]); color != "" {
+			for _, node := range nodes {
+				addMark(node, adf.TextColor(color))
+			}
+		}
+
+		return nodes, index + len(match[0]) + end + len("{color}"), true
+
+	case rest[0] == '[':
+		return link(source, index)
+
+	case rest[0] == '!':
+		return image(source, index)
+
+	case atWordStart(source, index) && urlPattern.MatchString(rest):
+
+		url := strings.TrimRight(urlPattern.FindString(rest), trailingPunctuation)
+		return []*adf.Node{adf.Text(url, adf.Link(url))}, index + len(url), true
+	}
+
+	if emoji, ok := emoticon(source, index); ok {
+		return []*adf.Node{adf.Emoji(":"+emoji.name+":").Attr("id", "atlassian-"+emoji.name).Attr("text", emoji.text)}, index + len(emoji.text), true
+	}
+
+	return formatted(source, index, scanned)
+}
+
+func isAlphanumeric(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
+
+// atWordStart reports if the index follows the start of the source, or a character which is not a letter or a digit.
+func atWordStart(source string, index int) bool {
+	before, _ := utf8.DecodeLastRuneInString(source[:index])
+	return index == 0 || !isAlphanumeric(before)
+}
+
+// atWordEnd reports if the index is the end of the source, or a character which is not a letter or a digit.
+func atWordEnd(source string, index int) bool {
+	after, _ := utf8.DecodeRuneInString(source[index:])
+	return index == len(source) || !isAlphanumeric(after)
+}
+
+func hexColor(color string) string {
+
+	if strings.HasPrefix(color, "#") && len(color) == 7 {
+		return strings.ToLower(color)
+	}
+
+	return colorNames[strings.ToLower(color)]
+}
+
+func emoticon(source string, index int) (struct{ text, name string }, bool) {
+
+	for _, emoji := range emoticons {
+
+		end := index + len(emoji.text)
+		if strings.HasPrefix(source[index:], emoji.text) && atWordStart(source, index) && atWordEnd(source, end) {
+			return emoji, true
+		}
+	}
+
+	return struct{ text, name string }{}, false
+}
+
+// formatted parses a text between delimiters, e.g. *bold*, or between braced delimiters, e.g. {*}bold{*},
+// which can be used inside a word.
+func formatted(source string, index int, scanned unclosed) ([]*adf.Node, int, bool) {
+
+	rest := source[index:]
+
+	var delimiter string
+	start := index
+
+	if match := bracedMarkPattern.FindStringSubmatch(rest); match != nil {
+		delimiter, start = match[1], index+len(match[0])
+	} else {
+		for candidate := range delimiterMarks {
+			if strings.HasPrefix(rest, candidate) && len(candidate) > len(delimiter) {
+				delimiter = candidate
+			}
+		}
+
+		if delimiter == "" || !atWordStart(source, index) {
+			return nil, 0, false
+		}
+
+		start = index + len(delimiter)
+		if next, _ := utf8.DecodeRuneInString(source[start:]); start == len(source) || unicode.IsSpace(next) {
+			return nil, 0, false
+		}
+	}
+
+	if span, ok := scanned[delimiter]; ok && start >= span.from && start < span.to {
+		return nil, 0, false
+	}
+
+	braced := "{" + delimiter + "}"
+
+	// The closing delimiter is on the same line, it follows a character which is not a space and ends a word.
+	end := start
+	for ; end < len(source) && source[end] != '\n'; end++ {
+
+		switch {
+		case source[end] == '\\':
+			end++
+
+		case strings.HasPrefix(source[end:], braced) && end > start:
+			return markedNodes(source[start:end], delimiter), end + len(braced), true
+
+		case strings.HasPrefix(source[end:], delimiter) && end > start:
+
+			before, _ := utf8.DecodeLastRuneInString(source[:end])
+			if !unicode.IsSpace(before) && atWordEnd(source, end+len(delimiter)) {
+				return markedNodes(source[start:end], delimiter), end + len(delimiter), true
+			}
+		}
+	}
+
+	scanned[delimiter] = struct{ from, to int }{start, end}
+
+	return nil, 0, false
+}
+
+func markedNodes(source, delimiter string) []*adf.Node {
+
+	nodes := inline(source)
+	for _, node := range nodes {
+		addMark(node, delimiterMarks[delimiter]())
+	}
+
+	return nodes
+}
+
+// link parses a link, e.g. [text|url], a mention, e.g. [~accountid:5b10ac8d82e05b22cc7d4ef5], or a smart link,
+// e.g. [url|url|smart-link], which is converted to an inline card.
+func link(source string, index int) ([]*adf.Node, int, bool) {
+
+	end := strings.IndexByte(source[index:], ']')
+	if end == -1 || strings.Contains(source[index:index+end], "\n") {
+		return nil, 0, false
+	}
+
+	inner, next := source[index+1:index+end], index+end+1
+
+	// Only the account IDs can be mentioned in ADF, the other mentions, e.g. [~john.doe], are kept as text.
+	if user, ok := strings.CutPrefix(inner, "~"); ok {
+		accountID, ok := strings.CutPrefix(user, "accountid:")
+		return []*adf.Node{adf.Mention(accountID, "")}, next, ok && accountID != ""
+	}
+
+	parts := strings.Split(inner, "|")
+
+	if len(parts) == 1 {
+
+		url := strings.TrimSpace(parts[0])
+		if !urlPattern.MatchString(url) {
+			return nil, 0, false
+		}
+
+		return []*adf.Node{adf.Text(url, adf.Link(url))}, next, true
+	}
+
+	text, url := parts[0], strings.TrimSpace(parts[1])
+	if url == "" {
+		return nil, 0, false
+	}
+
+	if len(parts) > 2 && strings.TrimSpace(parts[2]) == "smart-link" {
+		return []*adf.Node{adf.InlineCard(url)}, next, true
+	}
+
+	nodes := inline(text)
+	if len(nodes) == 0 {
+		nodes = []*adf.Node{adf.Text(url)}
+	}
+
+	for _, node := range nodes {
+		addMark(node, adf.Link(url))
+	}
+
+	return nodes, next, true
+}
+
+// image parses an image, e.g. !diagram.png|thumbnail! or !https://example.com/diagram.png!, the parameters are ignored.
+func image(source string, index int) ([]*adf.Node, int, bool) {
+
+	end := strings.IndexByte(source[index+1:], '!')
+	if end <= 0 {
+		return nil, 0, false
+	}
+
+	inner := source[index+1 : index+1+end]
+	if strings.Contains(inner, "\n") || strings.TrimSpace(inner) != inner {
+		return nil, 0, false
+	}
+
+	name, _, _ := strings.Cut(inner, "|")
+	if name == "" {
+		return nil, 0, false
+	}
+
+	if strings.Contains(name, "://") {
+		return []*adf.Node{adf.ExternalMedia(name)}, index + end + 2, true
+	}
+
+	return []*adf.Node{adf.Media(name, "").Attr("alt", name)}, index + end + 2, true
+}
+
+func hasMark(node *adf.Node, markType adf.MarkType) bool {
+	return slices.ContainsFunc(node.Marks, func(mark *adf.Mark) bool { return mark.Type == markType })
+}
+
+// addMark adds the mark to a text node, unless it is already set or the text is code.
+func addMark(node *adf.Node, mark *adf.Mark) {
+
+	if node.Type != adf.NodeText || hasMark(node, mark.Type) || hasMark(node, adf.MarkCode) && mark.Type != adf.MarkLink {
+		return
+	}
+
+	node.Marks = append(node.Marks, mark)
+}
+
+// merge joins the consecutive text nodes with the same marks, the empty text nodes are dropped.
+func merge(nodes []*adf.Node) []*adf.Node {
+
+	var merged []*adf.Node
+
+	for _, node := range nodes {
+
+		if node.Type == adf.NodeText && node.Text == "" {
+			continue
+		}
+
+		if len(merged) != 0 {
+
+			last := merged[len(merged)-1]
+			if last.Type == adf.NodeText && node.Type == adf.NodeText && sameMarks(last.Marks, node.Marks) {
+				last.Text += node.Text
+				continue
+			}
+		}
+
+		merged = append(merged, node)
+	}
+
+	return merged
+}
+
+func sameMarks(a, b []*adf.Mark) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, mark := range a {
+
+		found := slices.ContainsFunc(b, func(other *adf.Mark) bool {
+			return sameMark(mark, other)
+		})
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameMark(a, b *adf.Mark) bool {
+	return a.Type == b.Type && a.Attrs["href"] == b.Attrs["href"] && a.Attrs["color"] == b.Attrs["color"] && a.Attrs["type"] == b.Attrs["type"]
+}
+
+// inlineImages replaces the images of a paragraph by links or file names, since ADF only has block images.
+func inlineImages(nodes []*adf.Node) []*adf.Node {
+
+	for index, node := range nodes {
+
+		if node.Type != adf.NodeMedia {
+			continue
+		}
+
+		if url, _ := node.Attrs["url"].(string); url != "" {
+			nodes[index] = adf.Text(url, adf.Link(url))
+			continue
+		}
+
+		name, _ := node.Attrs["alt"].(string)
+		nodes[index] = adf.Text(name)
+	}
+
+	return merge(nodes)
+}
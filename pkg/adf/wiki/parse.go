@@ -0,0 +1,418 @@
+package wiki
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`^h([1-6])\.(?:\s+(.*))?$`)
+	quotePattern    = regexp.MustCompile(`^bq\.(?:\s+(.*))?$`)
+	rulePattern     = regexp.MustCompile(`^-{4,}$`)
+	listItemPattern = regexp.MustCompile(`^([*#]+|-)(?:\s+|$)`)
+	macroPattern    = regexp.MustCompile(`^\{(code|noformat|quote|panel|info|tip|note|warning)(?::([^}]*))?\}`)
+)
+
+// macroPanels are the panels of the {info}, {tip}, {note} and {warning} macros.
+var macroPanels = map[string]adf.PanelType{
+	"info":    adf.PanelInfo,
+	"tip":     adf.PanelSuccess,
+	"note":    adf.PanelWarning,
+	"warning": adf.PanelError,
+}
+
+// colorPanels are the panels of the {panel} macros by their background color, the default Jira colors of the panels.
+var colorPanels = map[string]adf.PanelType{
+	"#deebff": adf.PanelInfo,
+	"#eae6ff": adf.PanelNote,
+	"#e3fcef": adf.PanelSuccess,
+	"#fffae6": adf.PanelWarning,
+	"#ffebe6": adf.PanelError,
+}
+
+// Parse converts the wiki markup to an ADF document.
+func Parse(source string) *adf.Node {
+
+	source = strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(source)
+
+	return adf.Doc(adf.Fit(adf.NodeDoc, blocks(source)...)...)
+}
+
+// startsBlock reports if the trimmed line starts a block which interrupts a paragraph.
+func startsBlock(line string) bool {
+	return headingPattern.MatchString(line) || quotePattern.MatchString(line) || rulePattern.MatchString(line) ||
+		listItemPattern.MatchString(line) || strings.HasPrefix(line, "|") || macroPattern.MatchString(line)
+}
+
+// blocks parses the source as a sequence of blocks, which the caller fits in its node.
+func blocks(source string) []*adf.Node {
+
+	var (
+		nodes []*adf.Node
+		lines = strings.Split(source, "\n")
+	)
+
+	for index := 0; index < len(lines); {
+
+		line := strings.TrimSpace(lines[index])
+
+		var (
+			parsed   []*adf.Node
+			consumed int
+		)
+
+		switch {
+		case line == "":
+			index++
+			continue
+		case macroPattern.MatchString(line):
+			// The macro can end on another line, the text following it is parsed again.
+			var rest string
+			parsed, rest = macro(strings.Join(append([]string{line}, lines[index+1:]...), "\n"))
+			nodes = append(nodes, parsed...)
+			lines, index = strings.Split(rest, "\n"), 0
+			continue
+		case headingPattern.MatchString(line):
+			match := headingPattern.FindStringSubmatch(line)
+			parsed, consumed = []*adf.Node{adf.Heading(int(match[1][0]-'0'), inlineImages(inline(match[2]))...)}, 1
+		case quotePattern.MatchString(line):
+			match := quotePattern.FindStringSubmatch(line)
+			parsed, consumed = []*adf.Node{adf.Blockquote(adf.Paragraph(inlineImages(inline(match[1]))...))}, 1
+		case rulePattern.MatchString(line):
+			parsed, consumed = []*adf.Node{adf.Rule()}, 1
+		case listItemPattern.MatchString(line):
+			parsed, consumed = list(lines[index:])
+		case strings.HasPrefix(line, "|"):
+			parsed, consumed = table(lines[index:])
+		default:
+			parsed, consumed = paragraph(lines[index:])
+		}
+
+		nodes = append(nodes, parsed...)
+		index += consumed
+	}
+
+	return nodes
+}
+
+// macro parses the block macro starting the source, e.g. {code:go}, and returns the source following its closing tag.
+func macro(source string) ([]*adf.Node, string) {
+
+	match := macroPattern.FindStringSubmatch(source)
+	name, params := match[1], parameters(match[2])
+
+	body, rest := source[len(match[0]):], ""
+	if end := strings.Index(body, "{"+name+"}"); end != -1 {
+		body, rest = body[:end], body[end+len(name)+2:]
+	}
+
+	switch name {
+	case "code", "noformat":
+
+		code := strings.TrimSuffix(strings.TrimPrefix(body, "\n"), "\n")
+
+		var language string
+		if name == "code" {
+			language = params["language"]
+			if language == "" {
+				language = params[""]
+			}
+		}
+
+		return []*adf.Node{adf.CodeBlock(language, code)}, rest
+
+	case "quote":
+
+		content := adf.Fit(adf.NodeBlockquote, blocks(body)...)
+		if len(content) == 0 {
+			return nil, rest
+		}
+
+		return []*adf.Node{adf.Blockquote(content...)}, rest
+	}
+
+	panelType, ok := macroPanels[name]
+	if !ok {
+		if panelType, ok = colorPanels[strings.ToLower(params["bgColor"])]; !ok {
+			panelType = adf.PanelInfo
+		}
+	}
+
+	// ADF panels do not have a title, it is kept as the first paragraph.
+	var content []*adf.Node
+	if title := params["title"]; title != "" {
+		content = append(content, adf.Paragraph(adf.Text(title, adf.Strong())))
+	}
+
+	content = adf.Fit(adf.NodePanel, append(content, blocks(body)...)...)
+	if len(content) == 0 {
+		return nil, rest
+	}
+
+	return []*adf.Node{adf.Panel(panelType, content...)}, rest
+}
+
+// parameters returns the parameters of a macro, e.g. title=Notes|bgColor=#deebff,
+// the first parameter without name, e.g. the language of {code:go}, is stored with an empty name.
+func parameters(source string) map[string]string {
+
+	params := make(map[string]string)
+
+	for _, param := range strings.Split(source, "|") {
+
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			if _, set := params[""]; !set {
+				params[""] = strings.TrimSpace(param)
+			}
+			continue
+		}
+
+		params[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+
+	return params
+}
+
+// paragraph parses the lines up to a blank line or a block, the line endings are hard breaks.
+// A paragraph holding only images is converted to single media nodes.
+func paragraph(lines []string) ([]*adf.Node, int) {
+
+	var (
+		text     []string
+		consumed int
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := strings.TrimSpace(lines[consumed])
+		if line == "" || len(text) != 0 && startsBlock(line) {
+			break
+		}
+
+		text = append(text, line)
+	}
+
+	content := inline(strings.Join(text, "\n"))
+
+	var media []*adf.Node
+	for _, node := range content {
+
+		switch {
+		case node.Type == adf.NodeMedia:
+			media = append(media, adf.MediaSingle(node))
+		case node.Type == adf.NodeText && strings.TrimSpace(node.Text) == "":
+		default:
+			return []*adf.Node{adf.Paragraph(inlineImages(content)...)}, consumed
+		}
+	}
+
+	return media, consumed
+}
+
+type listEntry struct {
+	markers string
+	text    string
+}
+
+// list parses the consecutive list items, the lines following an item without marker continue its text.
+func list(lines []string) ([]*adf.Node, int) {
+
+	var (
+		entries  []*listEntry
+		consumed int
+	)
+
+	for ; consumed < len(lines); consumed++ {
+
+		line := strings.TrimSpace(lines[consumed])
+
+		if match := listItemPattern.FindStringSubmatch(line); match != nil && !rulePattern.MatchString(line) {
+			entries = append(entries, &listEntry{markers: strings.ReplaceAll(match[1], "-", "*"), text: line[len(match[0]):]})
+			continue
+		}
+
+		if line == "" || startsBlock(line) {
+			break
+		}
+
+		last := entries[len(entries)-1]
+		last.text += "\n" + line
+	}
+
+	return nestedList(entries, 0), consumed
+}
+
+// nestedList returns the lists of the entries at the depth, the deeper entries are nested in the previous item.
+func nestedList(entries []*listEntry, depth int) []*adf.Node {
+
+	var (
+		nodes   []*adf.Node
+		current *adf.Node
+	)
+
+	for index := 0; index < len(entries); {
+
+		entry := entries[index]
+
+		end := index + 1
+		if len(entry.markers) > depth+1 {
+			end = index
+		}
+
+		for end < len(entries) && len(entries[end].markers) > depth+1 {
+			end++
+		}
+
+		var content []*adf.Node
+		if len(entry.markers) == depth+1 {
+			content = append([]*adf.Node{adf.Paragraph(inlineImages(inline(entry.text))...)}, nestedList(entries[index+1:end], depth+1)...)
+		} else {
+			// The entry skips a level, e.g. ** following *, it is nested in an empty item.
+			content = nestedList(entries[index:end], depth+1)
+		}
+
+		listType := adf.NodeBulletList
+		if entry.markers[depth] == '#' {
+			listType = adf.NodeOrderedList
+		}
+
+		if current == nil || current.Type != listType {
+
+			current = adf.BulletList()
+			if listType == adf.NodeOrderedList {
+				current = adf.OrderedList()
+			}
+
+			nodes = append(nodes, current)
+		}
+
+		current.Append(adf.ListItem(adf.Fit(adf.NodeListItem, content...)...))
+		index = end
+	}
+
+	return nodes
+}
+
+// table parses the consecutive rows of a table, a row not ending with a pipe continues on the next lines.
+func table(lines []string) ([]*adf.Node, int) {
+
+	var (
+		rows     []*adf.Node
+		consumed int
+	)
+
+	for consumed < len(lines) {
+
+		line := strings.TrimSpace(lines[consumed])
+		if !strings.HasPrefix(line, "|") {
+			break
+		}
+
+		row := line
+		for consumed++; !endsRow(row) && consumed < len(lines); consumed++ {
+
+			next := strings.TrimSpace(lines[consumed])
+			if next == "" || strings.HasPrefix(next, "|") {
+				break
+			}
+
+			row += "\n" + next
+		}
+
+		var cells []*adf.Node
+		for _, cell := range splitRow(row) {
+
+			content := adf.Fit(adf.NodeTableCell, blocks(cell.text)...)
+			if len(content) == 0 {
+				content = []*adf.Node{adf.Paragraph()}
+			}
+
+			if cell.header {
+				cells = append(cells, adf.TableHeader(content...))
+				continue
+			}
+
+			cells = append(cells, adf.TableCell(content...))
+		}
+
+		if len(cells) != 0 {
+			rows = append(rows, adf.TableRow(cells...))
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, consumed
+	}
+
+	return []*adf.Node{adf.Table(rows...)}, consumed
+}
+
+func endsRow(row string) bool {
+	return strings.HasSuffix(row, "|") && !strings.HasSuffix(row, `\|`)
+}
+
+type tableCell struct {
+	header bool
+	text   string
+}
+
+// splitRow returns the cells of a row, the cells starting with || are headers.
+// The pipes of the links, e.g. [text|url], and the escaped pipes do not separate the cells.
+func splitRow(row string) []*tableCell {
+
+	var (
+		cells    []*tableCell
+		current  *tableCell
+		text     strings.Builder
+		brackets int
+	)
+
+	end := func() {
+		if current != nil {
+			current.text = strings.TrimSpace(text.String())
+			cells = append(cells, current)
+		}
+		text.Reset()
+	}
+
+	for index := 0; index < len(row); index++ {
+
+		char := row[index]
+
+		switch {
+		case char == '\\' && index+1 < len(row):
+			text.WriteString(row[index : index+2])
+			index++
+
+		case char == '[' && strings.IndexByte(row[index:], ']') != -1:
+			brackets++
+			text.WriteByte(char)
+
+		case char == ']' && brackets != 0:
+			brackets--
+			text.WriteByte(char)
+
+		case char == '|' && brackets == 0:
+			end()
+
+			current = &tableCell{}
+			if index+1 < len(row) && row[index+1] == '|' {
+				current.header = true
+				index++
+			}
+
+		default:
+			text.WriteByte(char)
+		}
+	}
+
+	// The delimiter closing the row opens an empty cell.
+	if current != nil && strings.TrimSpace(text.String()) != "" {
+		end()
+	}
+
+	return cells
+}
@@ -0,0 +1,606 @@
+package wiki
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+)
+
+// lineStartPattern matches the text at the start of a line which would be parsed as a block marker.
+var lineStartPattern = regexp.MustCompile(`^(?:(?:h[1-6]|bq)\.(?:\s|$)|[*#-]+(?:\s|$))`)
+
+// panelMacros are the macros of the panels, the note panel has no macro, it is a {panel} with its background color.
+var panelMacros = map[string]string{
+	string(adf.PanelInfo):    "info",
+	string(adf.PanelSuccess): "tip",
+	string(adf.PanelWarning): "note",
+	string(adf.PanelError):   "warning",
+}
+
+// Render converts the ADF node, usually a document, to wiki markup.
+func Render(node *adf.Node) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch {
+	case node.Type == adf.NodeDoc:
+		return strings.Join(renderBlocks(node.Content), "\n")
+	case isBlock(node):
+		return strings.Join(renderBlocks([]*adf.Node{node}), "\n")
+	default:
+		return renderInline([]*adf.Node{node}, false)
+	}
+}
+
+func isBlock(node *adf.Node) bool {
+	return !adf.CanContain(adf.NodeParagraph, node.Type) && node.Type != adf.NodeMedia
+}
+
+// renderBlocks returns the lines of the blocks, separated by a blank line.
+func renderBlocks(nodes []*adf.Node) []string {
+
+	var lines []string
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		block := renderBlock(node)
+		if len(block) == 0 {
+			continue
+		}
+
+		if len(lines) != 0 {
+			lines = append(lines, "")
+		}
+
+		lines = append(lines, block...)
+	}
+
+	return lines
+}
+
+func renderBlock(node *adf.Node) []string {
+
+	switch node.Type {
+	case adf.NodeParagraph:
+
+		text := renderInline(node.Content, false)
+		if strings.TrimSpace(text) == "" {
+			return nil
+		}
+
+		lines := strings.Split(text, "\n")
+		for index, line := range lines {
+			lines[index] = escapeLineStart(line)
+		}
+
+		return lines
+
+	case adf.NodeHeading:
+		level := intAttr(node.Attrs["level"], 1)
+		return []string{"h" + strconv.Itoa(min(max(level, 1), 6)) + ". " + renderInline(node.Content, true)}
+
+	case adf.NodeBulletList, adf.NodeOrderedList, adf.NodeTaskList:
+		return renderList(node, "")
+
+	case adf.NodeCodeBlock:
+
+		tag := "{noformat}"
+		if language, _ := node.Attrs["language"].(string); language != "" {
+			tag = "{code:" + language + "}"
+		}
+
+		closing := tag
+		if tag != "{noformat}" {
+			closing = "{code}"
+		}
+
+		return append(append([]string{tag}, strings.Split(node.PlainText(), "\n")...), closing)
+
+	case adf.NodeBlockquote:
+		return append(append([]string{"{quote}"}, renderBlocks(node.Content)...), "{quote}")
+
+	case adf.NodePanel:
+		panelType, _ := node.Attrs["panelType"].(string)
+
+		opening, closing := "{panel:bgColor=#eae6ff}", "{panel}"
+		if name, ok := panelMacros[panelType]; ok {
+			opening, closing = "{"+name+"}", "{"+name+"}"
+		}
+
+		return append(append([]string{opening}, renderBlocks(node.Content)...), closing)
+
+	case adf.NodeExpand, adf.NodeNestedExpand:
+
+		var blocks []*adf.Node
+		if title, _ := node.Attrs["title"].(string); title != "" {
+			blocks = append(blocks, adf.Paragraph(adf.Text(title, adf.Strong())))
+		}
+
+		return renderBlocks(append(blocks, node.Content...))
+
+	case adf.NodeRule:
+		return []string{"----"}
+
+	case adf.NodeTable:
+		return renderTable(node)
+
+	case adf.NodeMediaSingle, adf.NodeMediaGroup:
+
+		var media []string
+		for _, child := range node.Content {
+			if image := renderMedia(child); image != "" {
+				media = append(media, image)
+			}
+		}
+
+		if len(media) == 0 {
+			return nil
+		}
+
+		return []string{strings.Join(media, " ")}
+
+	case adf.NodeMedia:
+		return []string{renderMedia(node)}
+	}
+
+	if !isBlock(node) {
+		return []string{renderInline([]*adf.Node{node}, false)}
+	}
+
+	return renderBlocks(node.Content)
+}
+
+func intAttr(value interface{}, fallback int) int {
+
+	switch value := value.(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	default:
+		return fallback
+	}
+}
+
+// renderMedia returns an external image by its URL, and an attachment by its alternative text, or its file ID.
+func renderMedia(node *adf.Node) string {
+
+	name, _ := node.Attrs["url"].(string)
+	if name == "" {
+		name, _ = node.Attrs["alt"].(string)
+	}
+
+	if name == "" {
+		name, _ = node.Attrs["id"].(string)
+	}
+
+	if name == "" {
+		return ""
+	}
+
+	return "!" + name + "!"
+}
+
+// renderList renders the items prefixed by the markers of their level, e.g. *# for a numbered list in a bullet list.
+func renderList(node *adf.Node, markers string) []string {
+
+	if node.Type == adf.NodeOrderedList {
+		markers += "#"
+	} else {
+		markers += "*"
+	}
+
+	var lines []string
+
+	for _, item := range node.Content {
+
+		if item == nil {
+			continue
+		}
+
+		switch {
+		case item.Type == adf.NodeTaskList:
+			// A nested task list belongs to the previous item.
+			lines = append(lines, renderList(item, markers)...)
+
+		case node.Type == adf.NodeTaskList:
+			box := "[ ] "
+			if state, _ := item.Attrs["state"].(string); state == "DONE" {
+				box = "[x] "
+			}
+			lines = append(lines, strings.TrimRight(markers+" "+box+renderInline(item.Content, true), " "))
+
+		default:
+			lines = append(lines, renderItem(item.Content, markers)...)
+		}
+	}
+
+	return lines
+}
+
+// renderItem renders the blocks of a list item, the paragraphs following the first one are separated by line breaks.
+func renderItem(nodes []*adf.Node, markers string) []string {
+
+	var (
+		texts  []string
+		nested []string
+	)
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		switch node.Type {
+		case adf.NodeParagraph, adf.NodeHeading:
+			texts = append(texts, renderInline(node.Content, true))
+		case adf.NodeBulletList, adf.NodeOrderedList, adf.NodeTaskList:
+			nested = append(nested, renderList(node, markers)...)
+		default:
+			if text := node.PlainText(); text != "" {
+				texts = append(texts, escapeText(strings.ReplaceAll(text, "\n", " ")))
+			}
+		}
+	}
+
+	return append([]string{strings.TrimRight(markers+" "+strings.Join(texts, `\\`), " ")}, nested...)
+}
+
+// renderTable renders the rows of the table, the header cells start with ||, the cells spanning several columns are padded.
+func renderTable(node *adf.Node) []string {
+
+	var lines []string
+
+	for _, row := range node.Content {
+
+		var builder strings.Builder
+		delimiter := "|"
+
+		for _, cell := range row.Content {
+
+			delimiter = "|"
+			if cell.Type == adf.NodeTableHeader {
+				delimiter = "||"
+			}
+
+			builder.WriteString(delimiter + renderCell(cell.Content))
+
+			for span := intAttr(cell.Attrs["colspan"], 1); span > 1; span-- {
+				builder.WriteString(delimiter + " ")
+			}
+		}
+
+		if builder.Len() != 0 {
+			lines = append(lines, builder.String()+delimiter)
+		}
+	}
+
+	return lines
+}
+
+// renderCell renders the blocks of a cell on a line each, a cell cannot hold blank lines.
+func renderCell(nodes []*adf.Node) string {
+
+	var lines []string
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		if node.Type == adf.NodeParagraph || node.Type == adf.NodeHeading {
+			lines = append(lines, escapeLineStart(renderInline(node.Content, true)))
+			continue
+		}
+
+		for _, line := range renderBlock(node) {
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	if text := strings.Join(lines, "\n"); text != "" {
+		return text
+	}
+
+	return " "
+}
+
+// inlineMarks are the marks rendered in wiki markup, from the outermost to the innermost.
+var inlineMarks = []adf.MarkType{adf.MarkLink, adf.MarkTextColor, adf.MarkStrong, adf.MarkEm, adf.MarkUnderline, adf.MarkStrike, adf.MarkSubSup}
+
+// renderInline renders the inline nodes, the marks spanning several nodes are opened and closed once.
+// The hard breaks are rendered as line endings, or as \\ on a single line.
+func renderInline(nodes []*adf.Node, singleLine bool) string {
+
+	var (
+		builder strings.Builder
+		open    []*adf.Mark
+		closed  []*adf.Mark
+		pending string
+	)
+
+	// The delimiters next to a letter or a digit are braced, e.g. {*}, otherwise they would not be parsed.
+	write := func(text string) {
+
+		if text == "" {
+			return
+		}
+
+		next, _ := utf8.DecodeRuneInString(text)
+		for _, mark := range closed {
+			builder.WriteString(brace(closing(mark), isAlphanumeric(next)))
+		}
+
+		closed = nil
+		builder.WriteString(text)
+	}
+
+	closeTo := func(depth int) {
+		for len(open) > depth {
+			closed = append(closed, open[len(open)-1])
+			open = open[:len(open)-1]
+		}
+	}
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		marks := orderedMarks(node)
+
+		// The marks are closed from the first one differing from the marks of the node.
+		depth := 0
+		for depth < len(open) && depth < len(marks) && sameMark(open[depth], marks[depth]) {
+			depth++
+		}
+
+		closeTo(depth)
+
+		text := renderNode(node, singleLine)
+		trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+
+		// The formatting cannot start or end with a space, the spaces are moved out of the marks.
+		write(pending)
+		write(text[:len(text)-len(trimmed)])
+		pending = ""
+
+		if trimmed == "" && depth < len(marks) {
+			continue
+		}
+
+		for _, mark := range marks[depth:] {
+			last, _ := utf8.DecodeLastRuneInString(builder.String())
+			write(brace(opening(mark), len(closed) == 0 && isAlphanumeric(last)))
+			open = append(open, mark)
+		}
+
+		core := strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		write(core)
+		pending = trimmed[len(core):]
+	}
+
+	closeTo(0)
+	write(pending)
+
+	for _, mark := range closed {
+		builder.WriteString(closing(mark))
+	}
+
+	return builder.String()
+}
+
+func orderedMarks(node *adf.Node) []*adf.Mark {
+
+	if node.Type != adf.NodeText || hasMark(node, adf.MarkCode) {
+
+		// The code is rendered by the node, only a link can wrap it.
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == adf.MarkLink {
+				return []*adf.Mark{mark}
+			}
+		}
+
+		return nil
+	}
+
+	var marks []*adf.Mark
+	for _, markType := range inlineMarks {
+		for _, mark := range node.Marks {
+			if mark != nil && mark.Type == markType {
+				marks = append(marks, mark)
+				break
+			}
+		}
+	}
+
+	return marks
+}
+
+// brace returns the delimiter of a formatting braced, e.g. {*}, the links and the colors are already braced.
+func brace(delimiter string, braced bool) string {
+
+	if !braced || strings.ContainsAny(delimiter, "[]{") {
+		return delimiter
+	}
+
+	return "{" + delimiter + "}"
+}
+
+func opening(mark *adf.Mark) string {
+
+	switch mark.Type {
+	case adf.MarkLink:
+		return "["
+	case adf.MarkTextColor:
+		color, _ := mark.Attrs["color"].(string)
+		return "{color:" + color + "}"
+	}
+
+	return closing(mark)
+}
+
+func closing(mark *adf.Mark) string {
+
+	switch mark.Type {
+	case adf.MarkLink:
+		href, _ := mark.Attrs["href"].(string)
+		return "|" + href + "]"
+	case adf.MarkTextColor:
+		return "{color}"
+	case adf.MarkStrong:
+		return "*"
+	case adf.MarkEm:
+		return "_"
+	case adf.MarkUnderline:
+		return "+"
+	case adf.MarkStrike:
+		return "-"
+	}
+
+	if kind, _ := mark.Attrs["type"].(string); kind == "sub" {
+		return "~"
+	}
+
+	return "^"
+}
+
+// renderNode renders an inline node, the nodes without wiki equivalent degrade to text.
+func renderNode(node *adf.Node, singleLine bool) string {
+
+	switch node.Type {
+	case adf.NodeText:
+
+		if hasMark(node, adf.MarkCode) {
+			return "{{" + node.Text + "}}"
+		}
+
+		text := escapeText(node.Text)
+		if singleLine {
+			text = strings.ReplaceAll(text, "\n", " ")
+		}
+
+		return text
+
+	case adf.NodeHardBreak:
+
+		if singleLine {
+			return `\\`
+		}
+
+		return "\n"
+
+	case adf.NodeMention:
+		id, _ := node.Attrs["id"].(string)
+		return "[~accountid:" + id + "]"
+
+	case adf.NodeEmoji:
+
+		shortName, _ := node.Attrs["shortName"].(string)
+		for _, emoji := range emoticons {
+			if shortName == ":"+emoji.name+":" {
+				return emoji.text
+			}
+		}
+
+	case adf.NodeStatus:
+		return "{{" + node.PlainText() + "}}"
+
+	case adf.NodeInlineCard:
+
+		url, _ := node.Attrs["url"].(string)
+		if url == "" {
+			return ""
+		}
+
+		return "[" + url + "|" + url + "|smart-link]"
+
+	case adf.NodeMedia:
+		return renderMedia(node)
+	}
+
+	return escapeText(node.PlainText())
+}
+
+// escapeText escapes the characters of the text which the wiki markup would parse as formatting.
+// The delimiters which can neither start nor end a formatting are not escaped, e.g. the hyphen of well-known.
+func escapeText(text string) string {
+
+	var builder strings.Builder
+
+	for index := 0; index < len(text); index++ {
+
+		char := text[index]
+		next := byte(0)
+		if index+1 < len(text) {
+			next = text[index+1]
+		}
+
+		switch {
+		case char == '\\' && (next == 0 || strings.IndexByte(specialCharacters, next) != -1):
+			// A backslash cannot escape itself, two backslashes are a line break.
+			builder.WriteString("&#92;")
+			continue
+
+		case char == '[' || char == '{' || char == '|',
+			char == '!' && next != 0 && next != ' ',
+			char == '?' && next == '?',
+			char == '&' && next == '#':
+			builder.WriteByte('\\')
+
+		case strings.IndexByte("*_-+^~", char) != -1:
+
+			before, _ := utf8.DecodeLastRuneInString(text[:index])
+			after, _ := utf8.DecodeRuneInString(text[index+1:])
+			canOpen := atWordStart(text, index) && next != 0 && !unicode.IsSpace(after)
+			canClose := index != 0 && !unicode.IsSpace(before) && atWordEnd(text, index+1)
+
+			if canOpen || canClose {
+				builder.WriteByte('\\')
+			}
+
+		default:
+			if _, ok := emoticon(text, index); ok {
+				builder.WriteByte('\\')
+			}
+		}
+
+		builder.WriteByte(char)
+	}
+
+	return builder.String()
+}
+
+// escapeLineStart escapes the start of a line which would be parsed as a heading, a quote, a list item or a rule.
+func escapeLineStart(line string) string {
+
+	match := lineStartPattern.FindString(line)
+	if match == "" && !rulePattern.MatchString(line) {
+		return line
+	}
+
+	if dot := strings.IndexByte(match, '.'); dot != -1 {
+		return line[:dot] + "&#46;" + line[dot+1:]
+	}
+
+	if strings.HasPrefix(line, `\`) {
+		return line
+	}
+
+	return `\` + line
+}
@@ -0,0 +1,42 @@
+// Package wiki converts between the Jira wiki markup, used by the Jira v2 payloads, and the Atlassian Document Format,
+// used by the Jira v3 payloads.
+//
+// Parse reads the headings, e.g. h1., the quotes, e.g. bq. or {quote}, the bullet and numbered lists, e.g. * or #,
+// and their nested levels, e.g. *#, the tables, e.g. ||header|| and |cell|, the {code} and {noformat} blocks,
+// the {panel}, {info}, {tip}, {note} and {warning} panels, the horizontal rules, e.g. ----, and the inline formatting:
+// *bold*, _italic_, -strike-, +underline+, ^superscript^, ~subscript~, ??citation??, {{monospace}}, {color},
+// the links, e.g. [text|url], the mentions, e.g. [~accountid:5b10ac8d82e05b22cc7d4ef5], the emoticons, e.g. (/),
+// and the images, e.g. !diagram.png!.
+//
+// The wiki markup references the attachments by their file name, while ADF references them by their media ID:
+// an attachment image is converted to a media whose ID and alternative text are the file name,
+// the caller replaces the ID with the media ID of the attachment before sending the document to Jira.
+//
+// Render writes the ADF nodes as wiki markup, the nodes without wiki equivalent degrade as follows:
+//
+//   - a status is written as monospace, e.g. {{IN PROGRESS}};
+//   - a date is written as YYYY-MM-DD, in UTC;
+//   - an inline card is written as a smart link, e.g. [https://ctreminiom.atlassian.net/browse/KP-1|https://ctreminiom.atlassian.net/browse/KP-1|smart-link];
+//   - an emoji without emoticon is written as its text, or its short name, e.g. :tada:;
+//   - an expand is written as its title in bold, followed by its content;
+//   - a task list is written as a bullet list, the items start with [ ] or [x];
+//   - the start of an ordered list, the background colors and the table cell spans are dropped;
+//   - the list items holding other blocks than paragraphs and lists write their plain text.
+package wiki
+
+import (
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ToCommentNode converts the wiki markup, e.g. the description of an issue read with the Jira v2 client,
+// to the ADF document of the Jira v3 payloads.
+func ToCommentNode(source string) *model.CommentNodeScheme {
+	return Parse(source).CommentNode()
+}
+
+// FromCommentNode converts the ADF document read from Jira, e.g. the body of a comment,
+// to the wiki markup of the Jira v2 payloads.
+func FromCommentNode(node *model.CommentNodeScheme) string {
+	return Render(adf.FromCommentNode(node))
+}
@@ -0,0 +1,297 @@
+package wiki
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/adf"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestParse(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		wiki string
+		want *adf.Node
+	}{
+		{
+			name: "when the text is formatted",
+			wiki: "Some *bold*, _italic_, -struck-, +under+, H{~}2{~}O, x{^}2{^}, ??cited??, {{code}} and {color:red}red{color} text with well-known snake_case_names.",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("Some "), adf.Text("bold", adf.Strong()), adf.Text(", "), adf.Text("italic", adf.Em()), adf.Text(", "),
+				adf.Text("struck", adf.Strike()), adf.Text(", "), adf.Text("under", adf.Underline()), adf.Text(", H"),
+				adf.Text("2", adf.Sub()), adf.Text("O, x"), adf.Text("2", adf.Sup()), adf.Text(", "), adf.Text("cited", adf.Em()), adf.Text(", "),
+				adf.Text("code", adf.Code()), adf.Text(" and "), adf.Text("red", adf.TextColor("#ff0000")),
+				adf.Text(" text with well-known snake_case_names."),
+			)),
+		},
+		{
+			name: "when the formatting is nested or braced",
+			wiki: "*bold _and italic_* and {*}in{*}side, 2 * 3 = 6",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("bold ", adf.Strong()), adf.Text("and italic", adf.Em(), adf.Strong()), adf.Text(" and "),
+				adf.Text("in", adf.Strong()), adf.Text("side, 2 * 3 = 6"),
+			)),
+		},
+		{
+			name: "when the text has links, mentions and emoticons",
+			wiki: "See [the docs|https://example.com/docs], [https://example.com], https://example.com/a. " +
+				"[~accountid:5b10ac8d82e05b22cc7d4ef5] (/) [https://ctreminiom.atlassian.net/browse/KP-1|https://ctreminiom.atlassian.net/browse/KP-1|smart-link]",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("See "), adf.Text("the docs", adf.Link("https://example.com/docs")), adf.Text(", "),
+				adf.Text("https://example.com", adf.Link("https://example.com")), adf.Text(", "),
+				adf.Text("https://example.com/a", adf.Link("https://example.com/a")), adf.Text(". "),
+				adf.Mention("5b10ac8d82e05b22cc7d4ef5", ""), adf.Text(" "),
+				adf.Emoji(":check_mark:").Attr("id", "atlassian-check_mark").Attr("text", "(/)"), adf.Text(" "),
+				adf.InlineCard("https://ctreminiom.atlassian.net/browse/KP-1"),
+			)),
+		},
+		{
+			name: "when the text has escapes and breaks",
+			wiki: "\\*not bold\\* C:\\path &#92;\\\\second line\nthird line",
+			want: adf.Doc(adf.Paragraph(
+				adf.Text("*not bold* C:\\path \\"), adf.HardBreak(), adf.Text("second line"), adf.HardBreak(), adf.Text("third line"),
+			)),
+		},
+		{
+			name: "when the document has headings, quotes and rules",
+			wiki: "h1. Release *1.2*\nbq. Quoted\n----\n{quote}\nLong quote\n\nh3. Inside\n{quote}",
+			want: adf.Doc(
+				adf.Heading(1, adf.Text("Release "), adf.Text("1.2", adf.Strong())),
+				adf.Blockquote(adf.Paragraph(adf.Text("Quoted"))),
+				adf.Rule(),
+				adf.Blockquote(adf.Paragraph(adf.Text("Long quote")), adf.Paragraph(adf.Text("Inside", adf.Strong()))),
+			),
+		},
+		{
+			name: "when the document has code blocks",
+			wiki: "{code:go}\nfmt.Println(\"*\")\n{code}\n{code:language=yaml|title=Config}a: 1{code} after\n{noformat}\nraw *text*\n{noformat}",
+			want: adf.Doc(
+				adf.CodeBlock("go", "fmt.Println(\"*\")"),
+				adf.CodeBlock("yaml", "a: 1"),
+				adf.Paragraph(adf.Text("after")),
+				adf.CodeBlock("", "raw *text*"),
+			),
+		},
+		{
+			name: "when the document has panels",
+			wiki: "{info}Restart the workers.{info}\n{warning}Data loss{warning}\n{panel:title=Notes|bgColor=#EAE6FF}\n* item\n{panel}",
+			want: adf.Doc(
+				adf.Panel(adf.PanelInfo, adf.Paragraph(adf.Text("Restart the workers."))),
+				adf.Panel(adf.PanelError, adf.Paragraph(adf.Text("Data loss"))),
+				adf.Panel(adf.PanelNote, adf.Paragraph(adf.Text("Notes", adf.Strong())), adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("item"))))),
+			),
+		},
+		{
+			name: "when the document has nested lists",
+			wiki: "* first\ncontinued\n** nested\n*# numbered\n* second\n# one\n- dash",
+			want: adf.Doc(
+				adf.BulletList(
+					adf.ListItem(
+						adf.Paragraph(adf.Text("first"), adf.HardBreak(), adf.Text("continued")),
+						adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("nested")))),
+						adf.OrderedList(adf.ListItem(adf.Paragraph(adf.Text("numbered")))),
+					),
+					adf.ListItem(adf.Paragraph(adf.Text("second"))),
+				),
+				adf.OrderedList(adf.ListItem(adf.Paragraph(adf.Text("one")))),
+				adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("dash")))),
+			),
+		},
+		{
+			name: "when the document has a table",
+			wiki: "||Key||Summary||\n|KP-1|[Login|https://example.com/login]|\n|KP-2|* a\n* b|",
+			want: adf.Doc(adf.Table(
+				adf.TableRow(adf.TableHeader(adf.Paragraph(adf.Text("Key"))), adf.TableHeader(adf.Paragraph(adf.Text("Summary")))),
+				adf.TableRow(adf.TableCell(adf.Paragraph(adf.Text("KP-1"))), adf.TableCell(adf.Paragraph(adf.Text("Login", adf.Link("https://example.com/login"))))),
+				adf.TableRow(
+					adf.TableCell(adf.Paragraph(adf.Text("KP-2"))),
+					adf.TableCell(adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("a"))), adf.ListItem(adf.Paragraph(adf.Text("b"))))),
+				),
+			)),
+		},
+		{
+			name: "when the document has images",
+			wiki: "!diagram.png|thumbnail!\n\n!https://example.com/logo.png!\n\nSee !diagram.png! here. Wow! Great!",
+			want: adf.Doc(
+				adf.MediaSingle(adf.Media("diagram.png", "").Attr("alt", "diagram.png")),
+				adf.MediaSingle(adf.ExternalMedia("https://example.com/logo.png")),
+				adf.Paragraph(adf.Text("See diagram.png here. Wow! Great!")),
+			),
+		},
+		{
+			name: "when the mentions are not account IDs",
+			wiki: "Ping [~john.doe] and [~accountid:]",
+			want: adf.Doc(adf.Paragraph(adf.Text("Ping [~john.doe] and [~accountid:]"))),
+		},
+		{
+			name: "when the images are in a heading or without a name",
+			wiki: "h2. The !diagram.png! flow\n\n!|thumbnail!",
+			want: adf.Doc(adf.Heading(2, adf.Text("The diagram.png flow")), adf.Paragraph(adf.Text("!|thumbnail!"))),
+		},
+		{
+			name: "when a block has no text",
+			wiki: "{panel}||||\n#",
+			want: adf.Doc(adf.Panel("info", adf.OrderedList(adf.ListItem(adf.Paragraph())))),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			doc := Parse(testCase.wiki)
+
+			assert.Equal(t, testCase.want, doc)
+			assert.NoError(t, doc.Validate())
+		})
+	}
+}
+
+func TestParse_LargeInput(t *testing.T) {
+
+	// Each input is parsed in linear time, a quadratic parsing takes seconds.
+	inputs := map[string]string{
+		"backticks":           strings.Repeat("`", 20000),
+		"unclosed emphasis":   strings.Repeat("_a", 10000),
+		"unclosed strong":     strings.Repeat("*a ", 10000),
+		"unclosed links":      strings.Repeat("[", 20000),
+		"urls":                strings.Repeat("http://example.com ", 5000),
+		"unclosed citations":  strings.Repeat("??a", 8000),
+		"unclosed monospaces": strings.Repeat("{{", 10000),
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+
+			start := time.Now()
+			document := Parse(input)
+
+			assert.NotNil(t, document)
+			assert.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		doc  *adf.Node
+		want string
+	}{
+		{
+			name: "when the marks span several nodes",
+			doc: adf.Doc(adf.Paragraph(
+				adf.Text("bold ", adf.Strong()), adf.Text("and italic", adf.Strong(), adf.Em()), adf.Text(" text"),
+				adf.Text(" link", adf.Link("https://example.com")), adf.Text("in", adf.Underline()), adf.Text("side"),
+			)),
+			want: "*bold _and italic_* text [link|https://example.com]+in{+}side",
+		},
+		{
+			name: "when the text needs escaping",
+			doc: adf.Doc(
+				adf.Paragraph(adf.Text("* not a list, *not bold*, well-known [link] {macro} a|b C:\\path \\* (/)")),
+				adf.Paragraph(adf.Text("h1. not a heading")),
+			),
+			want: "\\* not a list, \\*not bold\\*, well-known \\[link] \\{macro} a\\|b C:\\path &#92;\\* \\(/)\n\nh1&#46; not a heading",
+		},
+		{
+			name: "when the nodes have no wiki equivalent",
+			doc: adf.Doc(
+				adf.Paragraph(
+					adf.Mention("5b10ac8d82e05b22cc7d4ef5", "@Carlos"), adf.Text(" "), adf.Status("DONE", adf.StatusGreen), adf.Text(" "),
+					adf.Date(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)), adf.Text(" "), adf.Emoji(":tada:"), adf.HardBreak(),
+					adf.InlineCard("https://ctreminiom.atlassian.net/browse/KP-1"),
+				),
+				adf.Expand("Details", adf.Paragraph(adf.Text("hidden"))),
+				adf.TaskList(adf.TaskItem(true, adf.Text("done")), adf.TaskList(adf.TaskItem(false, adf.Text("todo")))),
+				adf.Panel(adf.PanelNote, adf.Paragraph(adf.Text("note"))),
+			),
+			want: "[~accountid:5b10ac8d82e05b22cc7d4ef5] {{DONE}} 2024-03-01 :tada:\n" +
+				"[https://ctreminiom.atlassian.net/browse/KP-1|https://ctreminiom.atlassian.net/browse/KP-1|smart-link]\n\n" +
+				"*Details*\n\nhidden\n\n" +
+				"* [x] done\n** [ ] todo\n\n" +
+				"{panel:bgColor=#eae6ff}\nnote\n{panel}",
+		},
+		{
+			name: "when the table has spans and blocks",
+			doc: adf.Doc(adf.Table(
+				adf.TableRow(adf.TableHeader(adf.Paragraph(adf.Text("Key"))).Attr("colspan", 2)),
+				adf.TableRow(
+					adf.TableCell(adf.Paragraph(adf.Text("a|b"), adf.HardBreak(), adf.Text("c"))),
+					adf.TableCell(adf.BulletList(adf.ListItem(adf.Paragraph(adf.Text("x"))))),
+				),
+			)),
+			want: "||Key|| ||\n|a\\|b\\\\c|* x|",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, Render(testCase.doc))
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+
+	source := `h1. Release 1.2
+
+Ships *SSO* for [KP-1|https://ctreminiom.atlassian.net/browse/KP-1], see -the old- _new_ docs.
+Assigned to [~accountid:5b10ac8d82e05b22cc7d4ef5] (/)
+
+h2. Changes
+
+# Login with the identity provider
+# Logout button
+#* on every page
+#* in the menu
+
+{info}
+Restart the workers after the upgrade.
+{info}
+
+{quote}
+Quoted text
+{quote}
+
+||Service||Owner||
+|auth|{color:#ff5630}Carlos{color}|
+
+{code:yaml}
+sso:
+  enabled: true
+{code}
+
+----
+
+!diagram.png!`
+
+	doc := Parse(source)
+	require.NoError(t, doc.Validate())
+
+	assert.Equal(t, source, Render(doc))
+	assert.Equal(t, source, FromCommentNode(ToCommentNode(source)))
+}
+
+func TestFromCommentNode(t *testing.T) {
+
+	body := &model.CommentNodeScheme{
+		Version: 1,
+		Type:    "doc",
+		Content: []*model.CommentNodeScheme{
+			{Type: "paragraph", Content: []*model.CommentNodeScheme{
+				{Type: "text", Text: "Deployed by "},
+				{Type: "mention", Attrs: map[string]interface{}{"id": "5b10ac8d82e05b22cc7d4ef5", "text": "@Carlos"}},
+			}},
+			{Type: "heading", Attrs: map[string]interface{}{"level": float64(2)}, Content: []*model.CommentNodeScheme{{Type: "text", Text: "Notes"}}},
+		},
+	}
+
+	assert.Equal(t, "Deployed by [~accountid:5b10ac8d82e05b22cc7d4ef5]\n\nh2. Notes", FromCommentNode(body))
+}
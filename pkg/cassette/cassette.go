@@ -0,0 +1,143 @@
+package cassette
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// version is the version of the cassette format, written in every cassette.
+const version = 1
+
+// base64Encoding marks the bodies which are not valid UTF-8 and are stored base64 encoded, e.g. attachments.
+const base64Encoding = "base64"
+
+// Cassette is the list of the request/response pairs recorded in a file.
+type Cassette struct {
+	Version      int            `json:"version" yaml:"version"`
+	Interactions []*Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a recorded request and the response the API returned to it.
+type Interaction struct {
+	Request  *Request  `json:"request" yaml:"request"`
+	Response *Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method   string      `json:"method" yaml:"method"`
+	URL      string      `json:"url" yaml:"url"`
+	Header   http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body     string      `json:"body,omitempty" yaml:"body,omitempty"`
+	Encoding string      `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Header     http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+	Encoding   string      `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// Bytes returns the decoded body of the request.
+func (r *Request) Bytes() ([]byte, error) {
+	return decodeBody(r.Body, r.Encoding)
+}
+
+// Bytes returns the decoded body of the response.
+func (r *Response) Bytes() ([]byte, error) {
+	return decodeBody(r.Body, r.Encoding)
+}
+
+// encodeBody returns the body as a string, and the encoding of it when it is not valid UTF-8.
+func encodeBody(body []byte) (string, string) {
+
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(body), base64Encoding
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case base64Encoding:
+		return base64.StdEncoding.DecodeString(body)
+	default:
+		return nil, fmt.Errorf("cassette: unknown body encoding %q", encoding)
+	}
+}
+
+// isYAML reports if the cassette file is written in YAML, based on its extension, JSON is used otherwise.
+func isYAML(path string) bool {
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// Load reads the cassette stored in the file, in YAML or JSON depending on its extension.
+func Load(path string) (*Cassette, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := new(Cassette)
+
+	if isYAML(path) {
+		err = yaml.Unmarshal(content, cassette)
+	} else {
+		err = json.Unmarshal(content, cassette)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cassette: unable to read %v: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette to the file, in YAML or JSON depending on its extension.
+// The missing directories of the path are created.
+func (c *Cassette) Save(path string) error {
+
+	c.Version = version
+
+	var (
+		content []byte
+		err     error
+	)
+
+	if isYAML(path) {
+		content, err = yaml.Marshal(c)
+	} else {
+		content, err = json.MarshalIndent(c, "", "  ")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
@@ -0,0 +1,99 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Matcher reports if a recorded request matches the request sent by a client.
+// Both requests are scrubbed before they are compared.
+type Matcher func(request, recorded *Request) bool
+
+// Match is a part of the requests compared by the matcher built with MatchOn.
+type Match int
+
+const (
+	// MatchMethod compares the HTTP methods.
+	MatchMethod Match = iota
+	// MatchPath compares the paths of the URLs.
+	MatchPath
+	// MatchQuery compares the query parameters, regardless of their order.
+	MatchQuery
+	// MatchBody compares the bodies, the JSON bodies are compared regardless of their formatting and key order.
+	MatchBody
+)
+
+// DefaultMatcher compares the methods, the paths and the query parameters.
+var DefaultMatcher = MatchOn(MatchMethod, MatchPath, MatchQuery)
+
+// MatchOn returns the matcher comparing the given parts of the requests.
+func MatchOn(matches ...Match) Matcher {
+
+	return func(request, recorded *Request) bool {
+
+		for _, match := range matches {
+
+			var ok bool
+
+			switch match {
+			case MatchMethod:
+				ok = strings.EqualFold(request.Method, recorded.Method)
+			case MatchPath:
+				ok = sameURL(request.URL, recorded.URL, func(u *url.URL) interface{} { return strings.TrimSuffix(u.Path, "/") })
+			case MatchQuery:
+				ok = sameURL(request.URL, recorded.URL, func(u *url.URL) interface{} { return u.Query() })
+			case MatchBody:
+				ok = sameBody(request, recorded)
+			}
+
+			if !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// sameURL compares a part of the two URLs.
+func sameURL(a, b string, part func(*url.URL) interface{}) bool {
+
+	first, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+
+	second, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(part(first), part(second))
+}
+
+func sameBody(request, recorded *Request) bool {
+
+	first, err := request.Bytes()
+	if err != nil {
+		return false
+	}
+
+	second, err := recorded.Bytes()
+	if err != nil {
+		return false
+	}
+
+	if bytes.Equal(first, second) {
+		return true
+	}
+
+	var a, b interface{}
+	if json.Unmarshal(first, &a) != nil || json.Unmarshal(second, &b) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,276 @@
+// Package cassette records the HTTP interactions of the clients to cassette files and replays them offline.
+//
+// The Recorder implements common.HTTPClient, it is passed to the constructor of any client.
+// The first run sends the requests to Atlassian and records the responses, the next runs
+// replay them without network or credentials, which keeps the integration tests deterministic:
+//
+//	recorder, err := cassette.New("testdata/issues.yaml", cassette.WithMode(cassette.ModeAuto))
+//	if err != nil {
+//		return err
+//	}
+//	defer recorder.Stop()
+//
+//	client, err := v3.New(recorder, "https://ctreminiom.atlassian.net", nil)
+//
+// The credentials, the emails and the tokens are scrubbed before anything is written to a cassette.
+// The cassettes are written in YAML when the file has a .yaml or .yml extension, in JSON otherwise.
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Mode defines if the recorder sends the requests to the API or replays the recorded responses.
+type Mode int
+
+const (
+	// ModeAuto replays the cassette when the file exists, it records it otherwise.
+	ModeAuto Mode = iota
+	// ModeReplay only replays the cassette, a missing file or an unmatched request is an error.
+	ModeReplay
+	// ModeRecord sends every request to the API and records the cassette again, overwriting the file.
+	ModeRecord
+)
+
+// Option configures the Recorder.
+type Option func(*Recorder)
+
+// WithMode sets the mode of the recorder, ModeAuto by default.
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithClient sets the client sending the requests while recording, http.DefaultClient by default.
+func WithClient(client common.HTTPClient) Option {
+	return func(r *Recorder) {
+		r.client = client
+	}
+}
+
+// WithMatcher sets how the requests are matched to the recorded ones, DefaultMatcher by default.
+func WithMatcher(matcher Matcher) Option {
+	return func(r *Recorder) {
+		r.matcher = matcher
+	}
+}
+
+// WithScrubber adds a scrubber applied after the default ones, which remove the credentials, the emails and the tokens.
+func WithScrubber(scrubber Scrubber) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrubber)
+	}
+}
+
+// Recorder is a common.HTTPClient recording the interactions to a cassette or replaying them.
+type Recorder struct {
+	path      string
+	mode      Mode
+	client    common.HTTPClient
+	matcher   Matcher
+	scrubbers []Scrubber
+
+	mu        sync.Mutex
+	recording bool
+	cassette  *Cassette
+	replayed  []bool
+}
+
+// New returns a recorder of the cassette stored in the file.
+//
+// The cassette is loaded unless the recorder records it, the recorded interactions
+// are only written to the file when Stop is called.
+func New(path string, options ...Option) (*Recorder, error) {
+
+	if path == "" {
+		return nil, model.ErrNoCassettePath
+	}
+
+	recorder := &Recorder{
+		path:      path,
+		client:    http.DefaultClient,
+		matcher:   DefaultMatcher,
+		scrubbers: append([]Scrubber{}, defaultScrubbers...),
+	}
+
+	for _, option := range options {
+		option(recorder)
+	}
+
+	_, err := os.Stat(path)
+
+	if recorder.mode == ModeRecord || recorder.mode == ModeAuto && errors.Is(err, fs.ErrNotExist) {
+		recorder.recording = true
+		recorder.cassette = &Cassette{Version: version}
+		return recorder, nil
+	}
+
+	cassette, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.cassette = cassette
+	recorder.replayed = make([]bool, len(cassette.Interactions))
+
+	return recorder, nil
+}
+
+// Recording reports if the recorder sends the requests to the API, or replays the cassette.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Do sends the request to the API and records the interaction, or returns the recorded response matching the request.
+//
+// While replaying, each recorded interaction is returned once, in the order they were recorded,
+// so the repeated requests get the successive responses.
+func (r *Recorder) Do(request *http.Request) (*http.Response, error) {
+
+	body, err := readBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.recording {
+		return r.record(request, body)
+	}
+
+	return r.replay(request, body)
+}
+
+// Stop writes the recorded interactions to the cassette file, it does nothing while replaying.
+func (r *Recorder) Stop() error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.recording {
+		return nil
+	}
+
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) record(request *http.Request, body []byte) (*http.Response, error) {
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request:  newRequest(request, body),
+		Response: &Response{StatusCode: response.StatusCode, Header: response.Header.Clone()},
+	}
+	interaction.Response.Body, interaction.Response.Encoding = encodeBody(responseBody)
+
+	r.scrub(interaction)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return response, nil
+}
+
+func (r *Recorder) replay(request *http.Request, body []byte) (*http.Response, error) {
+
+	// The request is scrubbed like the recorded ones before they are compared.
+	interaction := &Interaction{Request: newRequest(request, body), Response: &Response{}}
+	r.scrub(interaction)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for index, recorded := range r.cassette.Interactions {
+
+		if r.replayed[index] || !r.matcher(interaction.Request, recorded.Request) {
+			continue
+		}
+
+		r.replayed[index] = true
+
+		return newResponse(request, recorded.Response)
+	}
+
+	return nil, fmt.Errorf("%w: %v %v", model.ErrNoCassetteInteraction, request.Method, interaction.Request.URL)
+}
+
+func (r *Recorder) scrub(interaction *Interaction) {
+
+	for _, scrubber := range r.scrubbers {
+		scrubber(interaction)
+	}
+}
+
+// readBody reads the body of the request, and replaces it so the request can still be sent.
+func readBody(request *http.Request) ([]byte, error) {
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	_ = request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func newRequest(request *http.Request, body []byte) *Request {
+
+	recorded := &Request{Method: request.Method, URL: request.URL.String(), Header: request.Header.Clone()}
+	recorded.Body, recorded.Encoding = encodeBody(body)
+
+	return recorded
+}
+
+func newResponse(request *http.Request, recorded *Response) (*http.Response, error) {
+
+	body, err := recorded.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(recorded.StatusCode) + " " + http.StatusText(recorded.StatusCode),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
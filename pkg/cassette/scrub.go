@@ -0,0 +1,114 @@
+package cassette
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Scrubber removes the sensitive data of an interaction before it is written to a cassette.
+//
+// The scrubbers are applied to the requests sent during a replay too, so the
+// requests carrying scrubbed values still match the recorded ones.
+type Scrubber func(*Interaction)
+
+const (
+	// ScrubbedEmail replaces the email addresses.
+	ScrubbedEmail = "user@example.com"
+	// ScrubbedToken replaces the tokens, the secrets and the passwords.
+	ScrubbedToken = "REDACTED"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	// secretPattern matches the JSON members holding a credential, e.g. "access_token", "client_secret" or "password".
+	secretPattern = regexp.MustCompile(`(?i)("[\w\-]*(?:token|secret|password|jwt)[\w\-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// jwtPattern matches the JSON Web Tokens, whatever the field holding them.
+	jwtPattern = regexp.MustCompile(`eyJ[\w\-]+\.eyJ[\w\-]+\.[\w\-]*`)
+
+	// credentialParameterPattern matches the form parameters holding a credential.
+	credentialParameterPattern = regexp.MustCompile(`(?i)((?:^|&)[\w\-]*(?:token|secret|password|jwt)[\w\-]*=)[^&]*`)
+)
+
+// defaultScrubbers are always applied, they remove the credentials, the emails and the tokens.
+var defaultScrubbers = []Scrubber{scrubHeaders, scrubURL, scrubBodies}
+
+// scrubHeaders redacts the credential headers, e.g. Authorization or Set-Cookie, and the emails of the headers.
+func scrubHeaders(interaction *Interaction) {
+
+	scrub := func(header http.Header) http.Header {
+
+		if header == nil {
+			return nil
+		}
+
+		header = common.RedactHeader(header)
+		for name, values := range header {
+			for index, value := range values {
+				header[name][index] = scrubText(value)
+			}
+		}
+
+		return header
+	}
+
+	interaction.Request.Header = scrub(interaction.Request.Header)
+	interaction.Response.Header = scrub(interaction.Response.Header)
+}
+
+// scrubURL redacts the credential query parameters, strips the user info and replaces the emails of the URL.
+func scrubURL(interaction *Interaction) {
+
+	parsed, err := url.Parse(interaction.Request.URL)
+	if err != nil {
+		return
+	}
+
+	redacted, err := url.Parse(common.RedactURL(parsed))
+	if err != nil {
+		return
+	}
+
+	if redacted.RawQuery != "" {
+
+		query := redacted.Query()
+		for name, values := range query {
+			for index, value := range values {
+				query[name][index] = scrubText(value)
+			}
+		}
+
+		redacted.RawQuery = query.Encode()
+	}
+
+	redacted.Path = emailPattern.ReplaceAllString(redacted.Path, ScrubbedEmail)
+	redacted.RawPath = ""
+
+	interaction.Request.URL = redacted.String()
+}
+
+// scrubBodies replaces the emails and the tokens of the bodies, the base64 encoded bodies are left as is.
+func scrubBodies(interaction *Interaction) {
+
+	if interaction.Request.Encoding == "" {
+		body := credentialParameterPattern.ReplaceAllString(interaction.Request.Body, "${1}"+ScrubbedToken)
+		interaction.Request.Body = scrubText(body)
+	}
+
+	if interaction.Response.Encoding == "" {
+		interaction.Response.Body = scrubText(interaction.Response.Body)
+	}
+}
+
+// scrubText replaces the emails, the JSON members holding a credential and the JWTs of the text.
+func scrubText(text string) string {
+
+	text = emailPattern.ReplaceAllString(text, ScrubbedEmail)
+	text = secretPattern.ReplaceAllString(text, `${1}"`+ScrubbedToken+`"`)
+
+	return jwtPattern.ReplaceAllString(text, ScrubbedToken)
+}
@@ -0,0 +1,207 @@
+// Package connect signs the requests of an Atlassian Connect app with a JWT.
+//
+// A Connect app calls the products as itself with a JWT signed with the shared secret received
+// on installation, and carrying the query string hash (qsh) of the request it authorizes.
+// The Signer is set on the Auth of the clients and signs every request they send, the retries are signed again
+// so they never go out with an expired token:
+//
+//	signer, err := connect.NewSigner(installation.Key, installation.SharedSecret, installation.BaseURL)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client.Auth.(common.RequestSignerAuthentication).SetRequestSigner(signer)
+//
+// The requests are made as the app, the products ignore the sub claim of these tokens.
+package connect
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DefaultExpiry is the lifetime of the tokens, Atlassian recommends keeping it short.
+const DefaultExpiry = 3 * time.Minute
+
+// NewSigner returns a Signer issuing the tokens of the app key, signed with the shared secret.
+//
+// The baseURL is the base URL of the installation, e.g. https://your-domain.atlassian.net/wiki,
+// the canonical path of a request is relative to it.
+func NewSigner(key, sharedSecret, baseURL string) (*Signer, error) {
+
+	if key == "" {
+		return nil, model.ErrNoConnectKey
+	}
+
+	if sharedSecret == "" {
+		return nil, model.ErrNoConnectSharedSecret
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		Key:          key,
+		SharedSecret: sharedSecret,
+		BaseURL:      base,
+		Expiry:       DefaultExpiry,
+	}, nil
+}
+
+// Signer signs the requests of a Connect app, it implements common.RequestSigner.
+type Signer struct {
+	Key          string        // The key of the app, used as the issuer of the tokens.
+	SharedSecret string        // The shared secret received on installation.
+	BaseURL      *url.URL      // The base URL of the installation.
+	Expiry       time.Duration // The lifetime of the tokens.
+
+	// Now returns the issue time of the tokens, time.Now when nil.
+	Now func() time.Time
+}
+
+// Claims are the claims of a Connect token.
+type Claims struct {
+	Issuer          string `json:"iss"` // The key of the app.
+	IssuedAt        int64  `json:"iat"` // The issue time, in seconds since the epoch.
+	ExpiresAt       int64  `json:"exp"` // The expiry time, in seconds since the epoch.
+	QueryStringHash string `json:"qsh"` // The hash of the canonical request.
+}
+
+// SignRequest sets the Authorization header of the request to a token authorizing it.
+func (s *Signer) SignRequest(_ context.Context, request *http.Request) error {
+
+	token, err := s.Token(request.Method, request.URL)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "JWT "+token)
+
+	return nil
+}
+
+// Token returns a token authorizing the request of method to u.
+func (s *Signer) Token(method string, u *url.URL) (string, error) {
+
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+
+	issuedAt := now()
+
+	basePath := ""
+	if s.BaseURL != nil {
+		basePath = s.BaseURL.Path
+	}
+
+	return Sign(&Claims{
+		Issuer:          s.Key,
+		IssuedAt:        issuedAt.Unix(),
+		ExpiresAt:       issuedAt.Add(s.Expiry).Unix(),
+		QueryStringHash: QueryStringHash(method, u, basePath),
+	}, s.SharedSecret)
+}
+
+// Sign encodes the claims in a JWT signed with HMAC SHA-256, the algorithm used by Connect.
+func Sign(claims *Claims, sharedSecret string) (string, error) {
+
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(sharedSecret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// QueryStringHash returns the qsh claim of a request: the hex encoded SHA-256 of its canonical form.
+func QueryStringHash(method string, u *url.URL, basePath string) string {
+	sum := sha256.Sum256([]byte(CanonicalRequest(method, u, basePath)))
+	return hex.EncodeToString(sum[:])
+}
+
+// CanonicalRequest returns the canonical form of a request, as defined by Connect:
+// the upper case method, the canonical path and the canonical query, separated by "&".
+//
+// The path is made relative to basePath, the path of the base URL of the installation.
+func CanonicalRequest(method string, u *url.URL, basePath string) string {
+	return strings.ToUpper(method) + "&" + canonicalPath(u, basePath) + "&" + canonicalQuery(u)
+}
+
+// canonicalPath returns the path relative to basePath, starting with a slash and without a trailing slash,
+// the "&" characters are encoded to not be confused with the separators.
+func canonicalPath(u *url.URL, basePath string) string {
+
+	path := u.EscapedPath()
+
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && (path == basePath || strings.HasPrefix(path, basePath+"/")) {
+		path = strings.TrimPrefix(path, basePath)
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	return strings.ReplaceAll(path, "&", "%26")
+}
+
+// canonicalQuery returns the query parameters sorted by name, except the jwt one, the values of
+// a repeated parameter are sorted and joined with ",", names and values are encoded following RFC 3986.
+func canonicalQuery(u *url.URL) string {
+
+	query := u.Query()
+	delete(query, "jwt")
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+
+		values := make([]string, 0, len(query[name]))
+		for _, value := range query[name] {
+			values = append(values, encode(value))
+		}
+		sort.Strings(values)
+
+		pairs = append(pairs, encode(name)+"="+strings.Join(values, ","))
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// encode percent-encodes s following RFC 3986, unlike url.QueryEscape spaces are encoded as %20.
+func encode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,162 @@
+package connect
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestCanonicalRequest(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		method   string
+		url      string
+		basePath string
+		want     string
+	}{
+		{
+			name:   "when the request has no query",
+			method: "get",
+			url:    "https://your-domain.atlassian.net/rest/api/3/myself",
+			want:   "GET&/rest/api/3/myself&",
+		},
+
+		{
+			name:   "when the query parameters are not sorted",
+			method: "GET",
+			url:    "https://your-domain.atlassian.net/rest/api/3/search?maxResults=50&jql=project%20%3D%20KP&fields=summary",
+			want:   "GET&/rest/api/3/search&fields=summary&jql=project%20%3D%20KP&maxResults=50",
+		},
+
+		{
+			name:   "when a query parameter is repeated",
+			method: "GET",
+			url:    "https://your-domain.atlassian.net/rest/api/3/issue/KP-1?expand=renderedFields&expand=changelog",
+			want:   "GET&/rest/api/3/issue/KP-1&expand=changelog,renderedFields",
+		},
+
+		{
+			name:   "when the query has the jwt parameter",
+			method: "GET",
+			url:    "https://your-domain.atlassian.net/rest/api/3/myself?jwt=token&expand=groups",
+			want:   "GET&/rest/api/3/myself&expand=groups",
+		},
+
+		{
+			name:   "when the query needs to be encoded",
+			method: "GET",
+			url:    "https://your-domain.atlassian.net/rest/api/3/user/search?query=John+Doe*",
+			want:   "GET&/rest/api/3/user/search&query=John%20Doe%2A",
+		},
+
+		{
+			name:   "when the path has a trailing slash and an ampersand",
+			method: "POST",
+			url:    "https://your-domain.atlassian.net/rest/api/3/project/R&D/",
+			want:   "POST&/rest/api/3/project/R%26D&",
+		},
+
+		{
+			name:     "when the installation has a base path",
+			method:   "GET",
+			url:      "https://your-domain.atlassian.net/wiki/rest/api/content?limit=25",
+			basePath: "/wiki",
+			want:     "GET&/rest/api/content&limit=25",
+		},
+
+		{
+			name:   "when the path is the root",
+			method: "GET",
+			url:    "https://your-domain.atlassian.net",
+			want:   "GET&/&",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			u, err := url.Parse(testCase.url)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.want, CanonicalRequest(testCase.method, u, testCase.basePath))
+		})
+	}
+}
+
+func TestQueryStringHash(t *testing.T) {
+
+	u, err := url.Parse("https://your-domain.atlassian.net/rest/api/3/myself")
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("GET&/rest/api/3/myself&"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), QueryStringHash(http.MethodGet, u, ""))
+}
+
+func TestNewSigner(t *testing.T) {
+
+	_, err := NewSigner("", "secret", "https://your-domain.atlassian.net")
+	assert.ErrorIs(t, err, model.ErrNoConnectKey)
+
+	_, err = NewSigner("app-key", "", "https://your-domain.atlassian.net")
+	assert.ErrorIs(t, err, model.ErrNoConnectSharedSecret)
+
+	signer, err := NewSigner("app-key", "secret", "https://your-domain.atlassian.net/wiki")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/wiki", signer.BaseURL.Path)
+	assert.Equal(t, DefaultExpiry, signer.Expiry)
+}
+
+func TestSigner_SignRequest(t *testing.T) {
+
+	signer, err := NewSigner("app-key", "secret", "https://your-domain.atlassian.net/wiki")
+	require.NoError(t, err)
+
+	issuedAt := time.Unix(1700000000, 0)
+	signer.Now = func() time.Time { return issuedAt }
+
+	request, err := http.NewRequest(http.MethodGet, "https://your-domain.atlassian.net/wiki/rest/api/space?limit=10", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, signer.SignRequest(context.Background(), request))
+
+	authorization := request.Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(authorization, "JWT "))
+
+	parts := strings.Split(strings.TrimPrefix(authorization, "JWT "), ".")
+	require.Len(t, parts, 3)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	claims := new(Claims)
+	require.NoError(t, json.Unmarshal(payload, claims))
+
+	assert.Equal(t, &Claims{
+		Issuer:          "app-key",
+		IssuedAt:        issuedAt.Unix(),
+		ExpiresAt:       issuedAt.Add(DefaultExpiry).Unix(),
+		QueryStringHash: QueryStringHash(http.MethodGet, request.URL, "/wiki"),
+	}, claims)
+
+	sum := sha256.Sum256([]byte("GET&/rest/api/space&limit=10"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), claims.QueryStringHash)
+}
@@ -0,0 +1,183 @@
+// Package customfield maps the issue fields to Go structs tagged with jira:"<field>", where the field is the ID of
+// a field, e.g. customfield_10010 or duedate, or its name, e.g. Story Points:
+//
+//	type Ticket struct {
+//		Key         string                                 `jira:"key"`
+//		Team        *models.CustomFieldContextOptionScheme `jira:"customfield_10010"`
+//		StoryPoints float64                                `jira:"Story Points,omitempty"`
+//		Due         time.Time                              `jira:"duedate,omitempty"`
+//	}
+//
+//	issue, response, err := client.Issue.Get(ctx, "KP-1", nil, nil)
+//	...
+//	var ticket Ticket
+//	err = customfield.NewDecoder(client.Issue.Field).Decode(ctx, response.Bytes, &ticket)
+//
+// The Encoder does the reverse for Issue.Create and Issue.Update, the wire shape of each value, e.g. {"value": "Platform"}
+// for a select list, is chosen from the schema of the field:
+//
+//	fields, err := customfield.NewEncoder(client.Issue.Field).Encode(ctx, &ticket)
+//	...
+//	issue, _, err := client.Issue.Create(ctx, payload, fields)
+//
+// The tags are read with reflection, the types implementing Marshaler, or a *FieldSet, are encoded without it.
+//
+// The names are resolved with Issue.Field.Gets, the fields are fetched once per decoder or encoder. The tags id and
+// key reference the ID and the key of the issue, they are only decoded.
+package customfield
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// customFieldPattern matches the IDs of the custom fields, they are used without fetching the fields.
+var customFieldPattern = regexp.MustCompile(`^customfield_[0-9]+$`)
+
+// FieldError is a value which does not match the type of its issue field, or of its struct field.
+type FieldError struct {
+	Op    string       // The operation, decode or encode.
+	Issue string       // The key of the issue, empty when unknown.
+	Field string       // The tag of the struct field, the ID or the name of the issue field.
+	ID    string       // The ID of the issue field.
+	Type  reflect.Type // The type of the Go value.
+	Err   error        // The cause of the mismatch.
+}
+
+func (e *FieldError) Error() string {
+
+	field := fmt.Sprintf("%q", e.Field)
+	if e.ID != e.Field {
+		field += " (" + e.ID + ")"
+	}
+
+	verb := "decoded into"
+	if e.Op == "encode" {
+		verb = "encoded from"
+	}
+
+	if e.Issue != "" {
+		return fmt.Sprintf("custom-field: %v: the field %v cannot be %v %v: %v", e.Issue, field, verb, e.Type, e.Err)
+	}
+
+	return fmt.Sprintf("custom-field: the field %v cannot be %v %v: %v", field, verb, e.Type, e.Err)
+}
+
+// Unwrap returns models.ErrCustomFieldType, so the mismatches match it with errors.Is.
+func (e *FieldError) Unwrap() error {
+	return model.ErrCustomFieldType
+}
+
+// registry resolves the tags to the issue fields, the fields are fetched on the first name and cached.
+type registry struct {
+	connector jira.FieldConnector
+
+	mu     sync.Mutex
+	fields []*model.IssueFieldScheme
+}
+
+// load returns the fields of the site, a failed fetch is retried on the next call. Without connector, no field is known.
+func (r *registry) load(ctx context.Context) ([]*model.IssueFieldScheme, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fields != nil || r.connector == nil {
+		return r.fields, nil
+	}
+
+	fields, _, err := r.connector.Gets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.fields = fields
+	return fields, nil
+}
+
+// id returns the ID of the field referenced by the tag. The IDs of the custom fields are returned as is, and so are
+// the tags when the registry has no connector.
+func (r *registry) id(ctx context.Context, tag string) (string, error) {
+
+	if customFieldPattern.MatchString(tag) || r.connector == nil {
+		return tag, nil
+	}
+
+	field, err := r.field(ctx, tag)
+	if err != nil {
+		return "", err
+	}
+
+	return field.ID, nil
+}
+
+// field returns the field with the ID or the key, or else the only field with the name, compared case-insensitively.
+func (r *registry) field(ctx context.Context, tag string) (*model.IssueFieldScheme, error) {
+
+	fields, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field.ID == tag || field.Key == tag {
+			return field, nil
+		}
+	}
+
+	var (
+		found []*model.IssueFieldScheme
+		ids   []string
+	)
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, tag) {
+			found = append(found, field)
+			ids = append(ids, field.ID)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%w: %q", model.ErrNoCustomFieldName, tag)
+	case 1:
+		return found[0], nil
+	}
+
+	return nil, fmt.Errorf("%w: %q is the name of %v", model.ErrAmbiguousCustomFieldName, tag, strings.Join(ids, ", "))
+}
+
+// taggedField is a struct field tagged with jira.
+type taggedField struct {
+	index     []int
+	tag       string
+	omitEmpty bool // The zero value is not encoded.
+}
+
+// taggedFields returns the exported fields of the struct type tagged with jira, the tag - skips a field.
+// The omitempty option, e.g. jira:"Story Points,omitempty", skips the zero value when encoding.
+func taggedFields(structType reflect.Type) []*taggedField {
+
+	var fields []*taggedField
+
+	for index := 0; index < structType.NumField(); index++ {
+
+		field := structType.Field(index)
+
+		tag, options, _ := strings.Cut(field.Tag.Get("jira"), ",")
+		if !field.IsExported() || tag == "" || tag == "-" {
+			continue
+		}
+
+		fields = append(fields, &taggedField{index: field.Index, tag: tag, omitEmpty: options == "omitempty"})
+	}
+
+	return fields
+}
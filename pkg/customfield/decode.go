@@ -0,0 +1,195 @@
+package customfield
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// timeLayouts are the formats of the date fields, e.g. duedate, and of the datetime fields, e.g. created.
+var timeLayouts = []string{"2006-01-02T15:04:05.000-0700", time.RFC3339Nano, "2006-01-02"}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// rawIssue is an issue of a payload, its fields are decoded on demand.
+type rawIssue struct {
+	ID     json.RawMessage            `json:"id"`
+	Key    json.RawMessage            `json:"key"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// Decoder fills the structs tagged with jira from the issues returned by Jira.
+type Decoder struct {
+	registry *registry
+}
+
+// NewDecoder returns a decoder resolving the field names with the connector, e.g. client.Issue.Field.
+// Without connector, the tags are used as the field IDs.
+func NewDecoder(fields jira.FieldConnector) *Decoder {
+	return &Decoder{registry: &registry{connector: fields}}
+}
+
+// Decode fills the target, a pointer to a struct, from the issue in the buffer, e.g. the ResponseScheme.Bytes
+// returned by Issue.Get. The fields missing or null in the issue keep their value.
+// A value not matching its struct field returns a *FieldError.
+func (d *Decoder) Decode(ctx context.Context, buffer bytes.Buffer, target interface{}) error {
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %T is not a pointer to a struct", model.ErrInvalidCustomFieldTarget, target)
+	}
+
+	var issue rawIssue
+	if err := json.Unmarshal(buffer.Bytes(), &issue); err != nil {
+		return err
+	}
+
+	if issue.Fields == nil {
+		return model.ErrNoFieldInformation
+	}
+
+	return d.decode(ctx, &issue, value.Elem())
+}
+
+// DecodeAll fills the target, a pointer to a slice of structs or of pointers to structs, from the issues in the
+// buffer, e.g. the ResponseScheme.Bytes returned by Issue.Search.GetByJQLSearch. The slice is replaced, its elements
+// follow the order of the issues.
+func (d *Decoder) DecodeAll(ctx context.Context, buffer bytes.Buffer, target interface{}) error {
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w: %T is not a pointer to a slice", model.ErrInvalidCustomFieldTarget, target)
+	}
+
+	sliceType := value.Elem().Type()
+	elementType, pointers := sliceType.Elem(), false
+	if elementType.Kind() == reflect.Pointer {
+		elementType, pointers = elementType.Elem(), true
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %T is not a pointer to a slice of structs", model.ErrInvalidCustomFieldTarget, target)
+	}
+
+	var page struct {
+		Issues []*rawIssue `json:"issues"`
+	}
+
+	if err := json.Unmarshal(buffer.Bytes(), &page); err != nil {
+		return err
+	}
+
+	if page.Issues == nil {
+		return model.ErrNoIssuesSlice
+	}
+
+	elements := reflect.MakeSlice(sliceType, len(page.Issues), len(page.Issues))
+
+	for index, issue := range page.Issues {
+
+		element := elements.Index(index)
+		if pointers {
+			element.Set(reflect.New(elementType))
+			element = element.Elem()
+		}
+
+		if err := d.decode(ctx, issue, element); err != nil {
+			return err
+		}
+	}
+
+	value.Elem().Set(elements)
+	return nil
+}
+
+// decode fills the struct value from the issue.
+func (d *Decoder) decode(ctx context.Context, issue *rawIssue, value reflect.Value) error {
+
+	var key string
+	_ = json.Unmarshal(issue.Key, &key)
+
+	for _, field := range taggedFields(value.Type()) {
+
+		var (
+			id  = field.tag
+			raw json.RawMessage
+		)
+
+		switch field.tag {
+		case "id":
+			raw = issue.ID
+		case "key":
+			raw = issue.Key
+		default:
+
+			var err error
+			if id, err = d.registry.id(ctx, field.tag); err != nil {
+				return err
+			}
+
+			raw = issue.Fields[id]
+		}
+
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		target := value.FieldByIndex(field.index)
+
+		if err := decodeValue(raw, target); err != nil {
+			return &FieldError{Op: "decode", Issue: key, Field: field.tag, ID: id, Type: target.Type(), Err: err}
+		}
+	}
+
+	return nil
+}
+
+// decodeValue decodes the JSON value in the target, the dates are parsed with the layouts of Jira.
+func decodeValue(raw json.RawMessage, target reflect.Value) error {
+
+	switch target.Type() {
+	case timeType:
+
+		parsed, err := parseTime(raw)
+		if err != nil {
+			return err
+		}
+
+		target.Set(reflect.ValueOf(parsed))
+		return nil
+
+	case reflect.PointerTo(timeType):
+
+		parsed, err := parseTime(raw)
+		if err != nil {
+			return err
+		}
+
+		target.Set(reflect.ValueOf(&parsed))
+		return nil
+	}
+
+	return json.Unmarshal(raw, target.Addr().Interface())
+}
+
+func parseTime(raw json.RawMessage) (time.Time, error) {
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		return time.Time{}, fmt.Errorf("%s is not a date", raw)
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a date", text)
+}
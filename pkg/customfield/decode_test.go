@@ -0,0 +1,244 @@
+package customfield
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+type fakeFieldConnector struct {
+	jira.FieldConnector
+	fields []*model.IssueFieldScheme
+	err    error
+	calls  int
+}
+
+func (f *fakeFieldConnector) Gets(_ context.Context) ([]*model.IssueFieldScheme, *model.ResponseScheme, error) {
+	f.calls++
+	return f.fields, nil, f.err
+}
+
+func newFakeFieldConnector() *fakeFieldConnector {
+	return &fakeFieldConnector{fields: []*model.IssueFieldScheme{
+		{ID: "summary", Key: "summary", Name: "Summary"},
+		{ID: "duedate", Key: "duedate", Name: "Due date"},
+		{ID: "labels", Key: "labels", Name: "Labels"},
+		{ID: "updated", Key: "updated", Name: "Updated"},
+		{ID: "customfield_10010", Key: "customfield_10010", Name: "Team", Custom: true},
+		{ID: "customfield_10016", Key: "customfield_10016", Name: "Story Points", Custom: true},
+		{ID: "customfield_10020", Key: "customfield_10020", Name: "Sprint", Custom: true},
+		{ID: "customfield_10030", Key: "customfield_10030", Name: "Reviewer", Custom: true},
+		{ID: "customfield_10031", Key: "customfield_10031", Name: "Reviewer", Custom: true},
+	}}
+}
+
+type ticket struct {
+	ID          string                                `jira:"id"`
+	Key         string                                `jira:"key"`
+	Summary     string                                `jira:"summary"`
+	Team        *model.CustomFieldContextOptionScheme `jira:"customfield_10010"`
+	StoryPoints float64                               `jira:"story points"`
+	Sprints     []*model.SprintDetailScheme           `jira:"Sprint,omitempty"`
+	Labels      []string                              `jira:"Labels"`
+	Due         *time.Time                            `jira:"duedate"`
+	Updated     time.Time                             `jira:"updated"`
+	Ignored     string                                `jira:"-"`
+	Untagged    string
+}
+
+const issue = `{
+	"id": "10001",
+	"key": "KP-1",
+	"fields": {
+		"summary": "Login fails",
+		"customfield_10010": {"id": "10100", "value": "Platform"},
+		"customfield_10016": 5.5,
+		"customfield_10020": [{"id": 3, "name": "Sprint 3", "state": "active"}],
+		"labels": ["backend", "auth"],
+		"duedate": "2024-03-01",
+		"updated": "2024-02-20T10:30:00.000+0100"
+	}
+}`
+
+func TestDecoder_Decode(t *testing.T) {
+
+	connector := newFakeFieldConnector()
+	decoder := NewDecoder(connector)
+
+	got := ticket{Untagged: "kept"}
+	require.NoError(t, decoder.Decode(context.Background(), *bytes.NewBufferString(issue), &got))
+
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, ticket{
+		ID:          "10001",
+		Key:         "KP-1",
+		Summary:     "Login fails",
+		Team:        &model.CustomFieldContextOptionScheme{ID: "10100", Value: "Platform"},
+		StoryPoints: 5.5,
+		Sprints:     []*model.SprintDetailScheme{{ID: 3, Name: "Sprint 3", State: "active"}},
+		Labels:      []string{"backend", "auth"},
+		Due:         &due,
+		Updated:     time.Date(2024, 2, 20, 10, 30, 0, 0, time.FixedZone("", 3600)),
+		Untagged:    "kept",
+	}, got)
+
+	// The fields are fetched once per decoder.
+	require.NoError(t, decoder.Decode(context.Background(), *bytes.NewBufferString(issue), &ticket{}))
+	assert.Equal(t, 1, connector.calls)
+}
+
+func TestDecoder_Decode_WithoutConnector(t *testing.T) {
+
+	var got struct {
+		Team   *model.CustomFieldContextOptionScheme `jira:"customfield_10010"`
+		Points float64                               `jira:"customfield_10016"`
+		Labels []string                              `jira:"labels"`
+	}
+
+	require.NoError(t, NewDecoder(nil).Decode(context.Background(), *bytes.NewBufferString(issue), &got))
+
+	assert.Equal(t, "Platform", got.Team.Value)
+	assert.Equal(t, 5.5, got.Points)
+	assert.Equal(t, []string{"backend", "auth"}, got.Labels)
+}
+
+func TestDecoder_DecodeAll(t *testing.T) {
+
+	search := `{"issues": [
+		{"key": "KP-1", "fields": {"customfield_10016": 3, "labels": ["a"]}},
+		{"key": "KP-2", "fields": {"customfield_10016": null}}
+	]}`
+
+	type row struct {
+		Key    string   `jira:"key"`
+		Points int      `jira:"Story Points"`
+		Labels []string `jira:"labels"`
+	}
+
+	decoder := NewDecoder(newFakeFieldConnector())
+
+	var rows []row
+	require.NoError(t, decoder.DecodeAll(context.Background(), *bytes.NewBufferString(search), &rows))
+	assert.Equal(t, []row{{Key: "KP-1", Points: 3, Labels: []string{"a"}}, {Key: "KP-2"}}, rows)
+
+	var pointers []*row
+	require.NoError(t, decoder.DecodeAll(context.Background(), *bytes.NewBufferString(search), &pointers))
+	assert.Equal(t, []*row{{Key: "KP-1", Points: 3, Labels: []string{"a"}}, {Key: "KP-2"}}, pointers)
+}
+
+func TestDecoder_Errors(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		payload string
+		target  interface{}
+		err     error
+		wantErr string
+	}{
+		{
+			name:    "when the value does not match the type",
+			payload: issue,
+			target: &struct {
+				Points string `jira:"Story Points"`
+			}{},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: KP-1: the field "Story Points" (customfield_10016) cannot be decoded into string: json: cannot unmarshal number into Go value of type string`,
+		},
+		{
+			name:    "when the date is invalid",
+			payload: `{"fields": {"duedate": "tomorrow"}}`,
+			target: &struct {
+				Due time.Time `jira:"duedate"`
+			}{},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "duedate" cannot be decoded into time.Time: "tomorrow" is not a date`,
+		},
+		{
+			name:    "when the field name is unknown",
+			payload: issue,
+			target: &struct {
+				Value string `jira:"Severity"`
+			}{},
+			err:     model.ErrNoCustomFieldName,
+			wantErr: `custom-field: no field found with the name: "Severity"`,
+		},
+		{
+			name:    "when the field name is ambiguous",
+			payload: issue,
+			target: &struct {
+				Reviewer *model.UserDetailScheme `jira:"Reviewer"`
+			}{},
+			err:     model.ErrAmbiguousCustomFieldName,
+			wantErr: `custom-field: several fields have the name: "Reviewer" is the name of customfield_10030, customfield_10031`,
+		},
+		{
+			name:    "when the target is not a pointer",
+			payload: issue,
+			target:  ticket{},
+			err:     model.ErrInvalidCustomFieldTarget,
+			wantErr: "custom-field: invalid target: customfield.ticket is not a pointer to a struct",
+		},
+		{
+			name:    "when the payload has no fields",
+			payload: `{"issues": []}`,
+			target:  &ticket{},
+			err:     model.ErrNoFieldInformation,
+			wantErr: model.ErrNoFieldInformation.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := NewDecoder(newFakeFieldConnector()).Decode(context.Background(), *bytes.NewBufferString(testCase.payload), testCase.target)
+
+			assert.EqualError(t, err, testCase.wantErr)
+			assert.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+func TestDecoder_FieldError(t *testing.T) {
+
+	var got struct {
+		Team []string `jira:"Team"`
+	}
+
+	err := NewDecoder(newFakeFieldConnector()).Decode(context.Background(), *bytes.NewBufferString(issue), &got)
+
+	var fieldError *FieldError
+	require.True(t, errors.As(err, &fieldError))
+
+	assert.Equal(t, "KP-1", fieldError.Issue)
+	assert.Equal(t, "Team", fieldError.Field)
+	assert.Equal(t, "customfield_10010", fieldError.ID)
+	assert.Equal(t, reflect.TypeOf([]string{}), fieldError.Type)
+}
+
+func TestDecoder_FetchError(t *testing.T) {
+
+	connector := &fakeFieldConnector{err: model.ErrUnauthorized}
+	decoder := NewDecoder(connector)
+
+	var got struct {
+		Points float64 `jira:"Story Points"`
+	}
+
+	assert.ErrorIs(t, decoder.Decode(context.Background(), *bytes.NewBufferString(issue), &got), model.ErrUnauthorized)
+
+	// A failed fetch is retried.
+	connector.err, connector.fields = nil, newFakeFieldConnector().fields
+	require.NoError(t, decoder.Decode(context.Background(), *bytes.NewBufferString(issue), &got))
+	assert.Equal(t, 5.5, got.Points)
+	assert.Equal(t, 2, connector.calls)
+}
@@ -0,0 +1,392 @@
+package customfield
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// sprintType is the custom type of the sprint field, its schema is an array but it takes a single sprint ID.
+const sprintType = "com.pyxis.greenhopper.jira:gh-sprint"
+
+// Encoder converts the tagged structs and the field sets to the custom fields of Issue.Create and Issue.Update.
+type Encoder struct {
+	registry *registry
+}
+
+// NewEncoder returns an encoder reading the schemas of the fields with the connector, e.g. client.Issue.Field.
+func NewEncoder(fields jira.FieldConnector) *Encoder {
+	return &Encoder{registry: &registry{connector: fields}}
+}
+
+// Encode returns the fields of the value, a *FieldSet, a Marshaler, a tagged struct or a pointer to one, in the wire
+// shape of their schema. The tags of the structs are read with reflection, it is the fallback for the values which are
+// neither a *FieldSet nor a Marshaler. The nil values are skipped, the custom fields can't clear a field, Operations does.
+// A value not matching its field returns a *FieldError, so the request is never sent.
+func (e *Encoder) Encode(ctx context.Context, value interface{}) (*model.CustomFields, error) {
+
+	entries, err := e.encode(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	customFields := new(model.CustomFields)
+
+	for _, entry := range entries {
+
+		if entry.value == nil {
+			continue
+		}
+
+		customFields.Fields = append(customFields.Fields, map[string]interface{}{
+			"fields": map[string]interface{}{entry.field: entry.value},
+		})
+	}
+
+	return customFields, nil
+}
+
+// Operations returns the fields of the value as the set operations of Issue.Update, the nil values clear their field.
+func (e *Encoder) Operations(ctx context.Context, value interface{}) (*model.UpdateOperations, error) {
+
+	entries, err := e.encode(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := new(model.UpdateOperations)
+
+	for _, entry := range entries {
+		if err := operations.AddMultiRawOperation(entry.field, []map[string]interface{}{{"set": entry.value}}); err != nil {
+			return nil, err
+		}
+	}
+
+	return operations, nil
+}
+
+// encode returns the entries of the value with the IDs of their fields and their wire values.
+func (e *Encoder) encode(ctx context.Context, value interface{}) ([]*entry, error) {
+
+	entries, err := entriesOf(value)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]*entry, 0, len(entries))
+
+	for _, current := range entries {
+
+		field, err := e.registry.field(ctx, current.field)
+		if err != nil {
+			return nil, err
+		}
+
+		if current.value == nil {
+			encoded = append(encoded, &entry{field: field.ID})
+			continue
+		}
+
+		wire, err := encodeValue(field, current.value)
+		if err != nil {
+			return nil, &FieldError{Op: "encode", Field: current.field, ID: field.ID, Type: reflect.TypeOf(current.value), Err: err}
+		}
+
+		encoded = append(encoded, &entry{field: field.ID, value: wire})
+	}
+
+	return encoded, nil
+}
+
+// entriesOf returns the entries of a field set, of the field set of a Marshaler, or of the tagged fields of a struct.
+// The tags id and key are skipped.
+func entriesOf(value interface{}) ([]*entry, error) {
+
+	switch value := value.(type) {
+	case *FieldSet:
+		return value.entries, nil
+	case Marshaler:
+
+		set, err := value.MarshalFieldSet()
+		if err != nil {
+			return nil, fmt.Errorf("customfield: %T: %w", value, err)
+		}
+
+		return set.entries, nil
+	}
+
+	structValue := reflect.ValueOf(value)
+	if structValue.Kind() == reflect.Pointer && !structValue.IsNil() {
+		structValue = structValue.Elem()
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T is not a *FieldSet or a struct", model.ErrInvalidCustomFieldTarget, value)
+	}
+
+	var entries []*entry
+
+	for _, field := range taggedFields(structValue.Type()) {
+
+		fieldValue := structValue.FieldByIndex(field.index)
+
+		if field.tag == "id" || field.tag == "key" || field.omitEmpty && fieldValue.IsZero() {
+			continue
+		}
+
+		switch fieldValue.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			if fieldValue.IsNil() {
+				entries = append(entries, &entry{field: field.tag})
+				continue
+			}
+		}
+
+		entries = append(entries, &entry{field: field.tag, value: fieldValue.Interface()})
+	}
+
+	return entries, nil
+}
+
+// encodeValue returns the value in the wire shape of the field, the arrays take a list of values.
+func encodeValue(field *model.IssueFieldScheme, value interface{}) (interface{}, error) {
+
+	if raw, ok := value.(Raw); ok {
+		return raw.Value, nil
+	}
+
+	schema := field.Schema
+	if schema == nil {
+		return nil, errors.New("the field has no schema, use customfield.Raw")
+	}
+
+	if isNil(value) {
+		return nil, errors.New("the value is a nil pointer")
+	}
+
+	value = indirect(value)
+
+	if schema.Custom == sprintType {
+		return encodeItem("number", value)
+	}
+
+	if schema.Type != "array" {
+		return encodeItem(schema.Type, value)
+	}
+
+	items, ok := listItems(value)
+	if !ok {
+		return nil, fmt.Errorf("the field type is array of %v", schema.Items)
+	}
+
+	wire := make([]interface{}, 0, len(items))
+
+	for index, item := range items {
+
+		if isNil(item) {
+			return nil, fmt.Errorf("the item %v of the list is nil", index)
+		}
+
+		encoded, err := encodeItem(schema.Items, indirect(item))
+		if err != nil {
+			return nil, err
+		}
+
+		wire = append(wire, encoded)
+	}
+
+	return wire, nil
+}
+
+// encodeItem returns the value in the wire shape of the schema type.
+func encodeItem(kind string, value interface{}) (interface{}, error) {
+
+	switch kind {
+	case "string":
+		switch value := value.(type) {
+		case string, *model.CommentNodeScheme:
+			return value, nil
+		}
+
+	case "number":
+		switch value.(type) {
+		case float64, float32, int, int32, int64:
+			return value, nil
+		}
+
+	case "date", "datetime":
+		if value, ok := value.(time.Time); ok {
+
+			if kind == "date" {
+				return value.Format("2006-01-02"), nil
+			}
+
+			return value.Format("2006-01-02T15:04:05.000-0700"), nil
+		}
+
+	case "option":
+		switch value := value.(type) {
+		case string:
+			return map[string]interface{}{"value": value}, nil
+		case *model.CustomFieldContextOptionScheme:
+			if value.ID != "" {
+				return map[string]interface{}{"id": value.ID}, nil
+			}
+			return map[string]interface{}{"value": value.Value}, nil
+		}
+
+	case "option-with-child":
+		switch value := value.(type) {
+		case Cascade:
+			return cascade(value.Parent, value.Child), nil
+		case *model.CascadingSelectScheme:
+			if value.Child == nil {
+				return cascade(value.Value, ""), nil
+			}
+			return cascade(value.Value, value.Child.Value), nil
+		}
+
+	case "user":
+		switch value := value.(type) {
+		case string:
+			return map[string]interface{}{"accountId": value}, nil
+		case *model.UserDetailScheme:
+			return map[string]interface{}{"accountId": value.AccountID}, nil
+		}
+
+	case "group":
+		switch value := value.(type) {
+		case string:
+			return map[string]interface{}{"name": value}, nil
+		case *model.GroupDetailScheme:
+			return map[string]interface{}{"name": value.Name}, nil
+		}
+
+	case "version", "component", "priority", "resolution", "securitylevel", "issuetype":
+		if value, ok := value.(string); ok {
+			return map[string]interface{}{"name": value}, nil
+		}
+
+	case "project":
+		if value, ok := value.(string); ok {
+			return map[string]interface{}{"key": value}, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("the %v fields are not supported, use customfield.Raw", kind)
+	}
+
+	return nil, fmt.Errorf("the field type is %v", kind)
+}
+
+func cascade(parent, child string) map[string]interface{} {
+
+	node := map[string]interface{}{"value": parent}
+	if child != "" {
+		node["child"] = map[string]interface{}{"value": child}
+	}
+
+	return node
+}
+
+// isNil reports whether the value is nil or a nil pointer, e.g. a nil *model.UserDetailScheme in a list of users.
+// The pointer types encodeItem supports are checked without reflection.
+func isNil(value interface{}) bool {
+
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string, float64, int, int64, time.Time, Cascade, []string, []interface{}:
+		return false
+	case *string:
+		return value == nil
+	case *float64:
+		return value == nil
+	case *int:
+		return value == nil
+	case *int64:
+		return value == nil
+	case *time.Time:
+		return value == nil
+	case *Cascade:
+		return value == nil
+	case *model.CommentNodeScheme:
+		return value == nil
+	case *model.CustomFieldContextOptionScheme:
+		return value == nil
+	case *model.CascadingSelectScheme:
+		return value == nil
+	case *model.UserDetailScheme:
+		return value == nil
+	case *model.GroupDetailScheme:
+		return value == nil
+	}
+
+	reflected := reflect.ValueOf(value)
+	return reflected.Kind() == reflect.Pointer && reflected.IsNil()
+}
+
+// indirect dereferences the pointers to the basic values, e.g. *float64 for an optional number.
+func indirect(value interface{}) interface{} {
+
+	switch value := value.(type) {
+	case *string:
+		return *value
+	case *float64:
+		return *value
+	case *int:
+		return *value
+	case *int64:
+		return *value
+	case *time.Time:
+		return *value
+	case *Cascade:
+		return *value
+	}
+
+	return value
+}
+
+// listItems returns the items of the supported slice types.
+func listItems(value interface{}) ([]interface{}, bool) {
+
+	var items []interface{}
+
+	switch value := value.(type) {
+	case []interface{}:
+		return value, true
+	case []string:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []int:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []float64:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []*model.CustomFieldContextOptionScheme:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []*model.UserDetailScheme:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	case []*model.GroupDetailScheme:
+		for _, item := range value {
+			items = append(items, item)
+		}
+	default:
+		return nil, false
+	}
+
+	return items, true
+}
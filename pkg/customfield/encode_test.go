@@ -0,0 +1,266 @@
+package customfield
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func newFakeSchemaConnector() *fakeFieldConnector {
+
+	custom := func(id, name, kind, items, customType string) *model.IssueFieldScheme {
+		return &model.IssueFieldScheme{ID: id, Key: id, Name: name, Custom: true,
+			Schema: &model.IssueFieldSchemaScheme{Type: kind, Items: items, Custom: customType}}
+	}
+
+	return &fakeFieldConnector{fields: []*model.IssueFieldScheme{
+		{ID: "labels", Key: "labels", Name: "Labels", Schema: &model.IssueFieldSchemaScheme{Type: "array", Items: "string", System: "labels"}},
+		{ID: "duedate", Key: "duedate", Name: "Due date", Schema: &model.IssueFieldSchemaScheme{Type: "date", System: "duedate"}},
+		{ID: "priority", Key: "priority", Name: "Priority", Schema: &model.IssueFieldSchemaScheme{Type: "priority", System: "priority"}},
+		custom("customfield_10010", "Team", "option", "", "com.atlassian.jira.plugin.system.customfieldtypes:select"),
+		custom("customfield_10016", "Story Points", "number", "", "com.atlassian.jira.plugin.system.customfieldtypes:float"),
+		custom("customfield_10020", "Sprint", "array", "json", sprintType),
+		custom("customfield_10030", "Reviewers", "array", "user", "com.atlassian.jira.plugin.system.customfieldtypes:multiuserpicker"),
+		custom("customfield_10031", "Owner", "user", "", "com.atlassian.jira.plugin.system.customfieldtypes:userpicker"),
+		custom("customfield_10032", "Components", "array", "option", "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"),
+		custom("customfield_10033", "Region", "option-with-child", "", "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"),
+		custom("customfield_10034", "Started", "datetime", "", "com.atlassian.jira.plugin.system.customfieldtypes:datetime"),
+		custom("customfield_10035", "Approvers", "array", "group", "com.atlassian.jira.plugin.system.customfieldtypes:multigrouppicker"),
+		custom("customfield_10036", "Health", "any", "", "com.example.app:health"),
+		custom("customfield_10040", "Notes", "string", "", "com.atlassian.jira.plugin.system.customfieldtypes:textarea"),
+	}}
+}
+
+// toJSON returns the JSON of the value, the wire values are compared as JSON.
+func toJSON(t *testing.T, value interface{}) string {
+
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	return string(encoded)
+}
+
+type ticketFields struct {
+	Key         string                                  `jira:"key"`
+	Team        string                                  `jira:"Team"`
+	StoryPoints *float64                                `jira:"Story Points"`
+	Sprint      int                                     `jira:"Sprint,omitempty"`
+	Reviewers   []string                                `jira:"Reviewers"`
+	Owner       *model.UserDetailScheme                 `jira:"customfield_10031"`
+	Components  []*model.CustomFieldContextOptionScheme `jira:"Components"`
+	Region      Cascade                                 `jira:"Region"`
+	Started     time.Time                               `jira:"Started"`
+	Due         time.Time                               `jira:"duedate"`
+	Labels      []string                                `jira:"labels"`
+	Priority    string                                  `jira:"priority"`
+	Health      Raw                                     `jira:"Health"`
+	Notes       *model.CommentNodeScheme                `jira:"Notes"`
+}
+
+func TestEncoder_Encode(t *testing.T) {
+
+	points := 5.0
+
+	ticket := &ticketFields{
+		Key:         "KP-1",
+		Team:        "Platform",
+		StoryPoints: &points,
+		Reviewers:   []string{"5b10ac8d82e05b22cc7d4ef5"},
+		Owner:       &model.UserDetailScheme{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Mia"},
+		Components:  []*model.CustomFieldContextOptionScheme{{ID: "10100"}, {Value: "API"}},
+		Region:      Cascade{Parent: "EMEA", Child: "Spain"},
+		Started:     time.Date(2024, 2, 20, 10, 30, 0, 0, time.UTC),
+		Due:         time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Labels:      []string{"backend"},
+		Priority:    "High",
+		Health:      Raw{Value: map[string]interface{}{"status": "green"}},
+	}
+
+	fields, err := NewEncoder(newFakeSchemaConnector()).Encode(context.Background(), ticket)
+	require.NoError(t, err)
+
+	var got []string
+	for _, field := range fields.Fields {
+		got = append(got, toJSON(t, field))
+	}
+
+	assert.Equal(t, []string{
+		`{"fields":{"customfield_10010":{"value":"Platform"}}}`,
+		`{"fields":{"customfield_10016":5}}`,
+		`{"fields":{"customfield_10030":[{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}}`,
+		`{"fields":{"customfield_10031":{"accountId":"5b10a2844c20165700ede21g"}}}`,
+		`{"fields":{"customfield_10032":[{"id":"10100"},{"value":"API"}]}}`,
+		`{"fields":{"customfield_10033":{"child":{"value":"Spain"},"value":"EMEA"}}}`,
+		`{"fields":{"customfield_10034":"2024-02-20T10:30:00.000+0000"}}`,
+		`{"fields":{"duedate":"2024-03-01"}}`,
+		`{"fields":{"labels":["backend"]}}`,
+		`{"fields":{"priority":{"name":"High"}}}`,
+		`{"fields":{"customfield_10036":{"status":"green"}}}`,
+	}, got)
+
+	// The custom fields are merged in the payload of Issue.Create.
+	payload, err := (&model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: "Login fails"}}).MergeCustomFields(fields)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"value": "Platform"}, payload["fields"].(map[string]interface{})["customfield_10010"])
+	assert.Equal(t, "Login fails", payload["fields"].(map[string]interface{})["summary"])
+}
+
+func TestEncoder_FieldSet(t *testing.T) {
+
+	set := new(FieldSet).
+		SetString("Team", "Platform").
+		SetNumber("Story Points", 3).
+		SetNumber("Sprint", 42).
+		SetStrings("labels", "backend", "auth").
+		SetStrings("Components", "API").
+		SetCascade("Region", "EMEA", "").
+		SetTime("duedate", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)).
+		SetRaw("Health", "green").
+		Clear("Owner")
+
+	encoder := NewEncoder(newFakeSchemaConnector())
+
+	fields, err := encoder.Encode(context.Background(), set)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`[{"fields":{"customfield_10010":{"value":"Platform"}}},{"fields":{"customfield_10016":3}},{"fields":{"customfield_10020":42}},`+
+			`{"fields":{"labels":["backend","auth"]}},{"fields":{"customfield_10032":[{"value":"API"}]}},{"fields":{"customfield_10033":{"value":"EMEA"}}},`+
+			`{"fields":{"duedate":"2024-03-01"}},{"fields":{"customfield_10036":"green"}}]`,
+		toJSON(t, fields.Fields))
+
+	operations, err := encoder.Operations(context.Background(), new(FieldSet).SetString("Team", "Platform").Clear("Owner"))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`[{"update":{"customfield_10010":[{"set":{"value":"Platform"}}]}},{"update":{"customfield_10031":[{"set":null}]}}]`,
+		toJSON(t, operations.Fields))
+}
+
+// sprintFields builds its field set, it is encoded without reading its tags.
+type sprintFields struct {
+	Sprint int
+	Team   string
+	err    error
+}
+
+func (s *sprintFields) MarshalFieldSet() (*FieldSet, error) {
+	return new(FieldSet).SetNumber("Sprint", float64(s.Sprint)).SetString("Team", s.Team), s.err
+}
+
+func TestEncoder_Marshaler(t *testing.T) {
+
+	encoder := NewEncoder(newFakeSchemaConnector())
+
+	fields, err := encoder.Encode(context.Background(), &sprintFields{Sprint: 42, Team: "Platform"})
+	require.NoError(t, err)
+
+	assert.Equal(t, `[{"fields":{"customfield_10020":42}},{"fields":{"customfield_10010":{"value":"Platform"}}}]`, toJSON(t, fields.Fields))
+
+	_, err = encoder.Encode(context.Background(), &sprintFields{err: errors.New("the sprint is closed")})
+	assert.EqualError(t, err, "customfield: *customfield.sprintFields: the sprint is closed")
+}
+
+func TestEncoder_Errors(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		value   interface{}
+		err     error
+		wantErr string
+	}{
+		{
+			name:    "when the value does not match the field",
+			value:   new(FieldSet).SetString("Story Points", "five"),
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Story Points" (customfield_10016) cannot be encoded from string: the field type is number`,
+		},
+		{
+			name:    "when a list is set to a single value field",
+			value:   new(FieldSet).SetStrings("Team", "Platform", "Mobile"),
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Team" (customfield_10010) cannot be encoded from []string: the field type is option`,
+		},
+		{
+			name:    "when a single value is set to a list field",
+			value:   new(FieldSet).SetString("labels", "backend"),
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "labels" cannot be encoded from string: the field type is array of string`,
+		},
+		{
+			name:    "when the field type is not supported",
+			value:   new(FieldSet).SetString("Health", "green"),
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Health" (customfield_10036) cannot be encoded from string: the any fields are not supported, use customfield.Raw`,
+		},
+		{
+			name: "when the struct field does not match",
+			value: struct {
+				Started string `jira:"Started"`
+			}{Started: "yesterday"},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Started" (customfield_10034) cannot be encoded from string: the field type is datetime`,
+		},
+		{
+			name: "when a list has a nil option",
+			value: struct {
+				Components []*model.CustomFieldContextOptionScheme `jira:"Components"`
+			}{Components: []*model.CustomFieldContextOptionScheme{{ID: "10100"}, nil}},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Components" (customfield_10032) cannot be encoded from []*models.CustomFieldContextOptionScheme: the item 1 of the list is nil`,
+		},
+		{
+			name: "when a list has a nil user",
+			value: struct {
+				Reviewers []*model.UserDetailScheme `jira:"Reviewers"`
+			}{Reviewers: []*model.UserDetailScheme{nil}},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Reviewers" (customfield_10030) cannot be encoded from []*models.UserDetailScheme: the item 0 of the list is nil`,
+		},
+		{
+			name: "when a list has a nil group",
+			value: struct {
+				Approvers []*model.GroupDetailScheme `jira:"Approvers"`
+			}{Approvers: []*model.GroupDetailScheme{{Name: "jira-admins"}, nil}},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Approvers" (customfield_10035) cannot be encoded from []*models.GroupDetailScheme: the item 1 of the list is nil`,
+		},
+		{
+			name: "when the value is a nil pointer",
+			value: struct {
+				Owner interface{} `jira:"Owner"`
+			}{Owner: (*model.UserDetailScheme)(nil)},
+			err:     model.ErrCustomFieldType,
+			wantErr: `custom-field: the field "Owner" (customfield_10031) cannot be encoded from *models.UserDetailScheme: the value is a nil pointer`,
+		},
+		{
+			name:    "when the field is unknown",
+			value:   new(FieldSet).SetString("Severity", "High"),
+			err:     model.ErrNoCustomFieldName,
+			wantErr: `custom-field: no field found with the name: "Severity"`,
+		},
+		{
+			name:    "when the value is not a struct",
+			value:   []string{"Team"},
+			err:     model.ErrInvalidCustomFieldTarget,
+			wantErr: "custom-field: invalid target: []string is not a *FieldSet or a struct",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			_, err := NewEncoder(newFakeSchemaConnector()).Encode(context.Background(), testCase.value)
+
+			assert.EqualError(t, err, testCase.wantErr)
+			assert.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
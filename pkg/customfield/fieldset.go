@@ -0,0 +1,81 @@
+package customfield
+
+import "time"
+
+// Cascade is the value of a cascading select list, the child is optional.
+type Cascade struct {
+	Parent string
+	Child  string
+}
+
+// Raw is a value encoded as is, for the field types without a known wire shape, e.g. the fields of the apps.
+type Raw struct {
+	Value interface{}
+}
+
+// Marshaler is implemented by the types building their own FieldSet, e.g. a struct encoded on a hot path.
+// The Encoder calls MarshalFieldSet instead of reading the jira tags of the struct with reflection.
+type Marshaler interface {
+	MarshalFieldSet() (*FieldSet, error)
+}
+
+// entry is a field and its Go value, a nil value clears the field.
+type entry struct {
+	field string
+	value interface{}
+}
+
+// FieldSet is a list of fields and their values, it is encoded like a tagged struct without reflection:
+//
+//	set := new(customfield.FieldSet).
+//		SetString("Team", "Platform").
+//		SetNumber("Story Points", 5).
+//		SetStrings("labels", "backend", "auth").
+//		Clear("customfield_10040")
+//
+// The fields are IDs or names, the values are converted to the wire shape of the field by the Encoder,
+// e.g. a string is {"value": "Platform"} for a select list and {"accountId": "..."} for a user picker.
+type FieldSet struct {
+	entries []*entry
+}
+
+func (s *FieldSet) set(field string, value interface{}) *FieldSet {
+	s.entries = append(s.entries, &entry{field: field, value: value})
+	return s
+}
+
+// SetString sets a text, an option, a user account ID, a group, a version or a project key.
+func (s *FieldSet) SetString(field, value string) *FieldSet {
+	return s.set(field, value)
+}
+
+// SetStrings sets the values of a field holding several, e.g. the labels, the options of a multi-select list or the
+// account IDs of a multi-user picker.
+func (s *FieldSet) SetStrings(field string, values ...string) *FieldSet {
+	return s.set(field, values)
+}
+
+// SetNumber sets a number field.
+func (s *FieldSet) SetNumber(field string, value float64) *FieldSet {
+	return s.set(field, value)
+}
+
+// SetTime sets a date or a datetime field.
+func (s *FieldSet) SetTime(field string, value time.Time) *FieldSet {
+	return s.set(field, value)
+}
+
+// SetCascade sets a cascading select list, the child is omitted when empty.
+func (s *FieldSet) SetCascade(field, parent, child string) *FieldSet {
+	return s.set(field, Cascade{Parent: parent, Child: child})
+}
+
+// SetRaw sets a value sent as is.
+func (s *FieldSet) SetRaw(field string, value interface{}) *FieldSet {
+	return s.set(field, Raw{Value: value})
+}
+
+// Clear clears the field, the cleared fields are only sent by Encoder.Operations.
+func (s *FieldSet) Clear(field string) *FieldSet {
+	return s.set(field, nil)
+}
@@ -0,0 +1,266 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError represents an unsuccessful response returned by an Atlassian API.
+//
+// It wraps the sentinel error matching the status code, so errors.Is(err, ErrNotFound) keeps working,
+// and exposes the error details decoded from the response body.
+//
+// The following body shapes are decoded:
+//
+//  1. Jira: {"errorMessages": [...], "errors": {"field": "message"}}
+//  2. Bitbucket: {"type": "error", "error": {"message": "...", "detail": "...", "fields": {...}}}
+//  3. Confluence: {"message": "..."} and {"errors": [{"title": "...", "detail": "..."}]}
+//  4. SCIM: {"detail": "...", "scimType": "..."}
+type APIError struct {
+	Err        error             // The sentinel error matching the status code, e.g. ErrNotFound.
+	StatusCode int               // The HTTP status code of the response.
+	Endpoint   string            // The endpoint that the request was made to.
+	Method     string            // The HTTP method used for the request.
+	RequestID  string            // The request ID assigned by Atlassian, from the X-Arequestid or X-Request-Id headers.
+	TraceID    string            // The trace ID assigned by Atlassian, from the Atl-Traceid header.
+	Messages   []string          // The general error messages.
+	Errors     map[string]string // The errors related to a specific field, keyed by the field name.
+	Detail     string            // The detailed description of the error, when provided.
+	SCIMType   string            // The SCIM error type, only set by the SCIM endpoints.
+}
+
+// NewAPIError creates the APIError describing an unsuccessful response.
+//
+// The response body is read from the ResponseScheme bytes, so the scheme must be fully populated.
+func NewAPIError(response *ResponseScheme) *APIError {
+
+	apiErr := &APIError{
+		Err:        statusCodeError(response.Code),
+		StatusCode: response.Code,
+		Endpoint:   response.Endpoint,
+		Method:     response.Method,
+	}
+
+	if response.Response != nil {
+		apiErr.RequestID = firstHeader(response.Header, "X-Arequestid", "X-Request-Id")
+		apiErr.TraceID = firstHeader(response.Header, "Atl-Traceid", "X-B3-Traceid")
+	}
+
+	apiErr.decode(response.Bytes.Bytes())
+
+	return apiErr
+}
+
+// Error returns the sentinel error message followed by the error messages decoded from the body.
+func (e *APIError) Error() string {
+
+	var details []string
+	details = append(details, e.Messages...)
+
+	if e.Detail != "" {
+		details = append(details, e.Detail)
+	}
+
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		details = append(details, fmt.Sprintf("%v: %v", field, e.Errors[field]))
+	}
+
+	if len(details) == 0 {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%v: %v", e.Err.Error(), strings.Join(details, "; "))
+}
+
+// Unwrap returns the sentinel errors matched by the APIError.
+//
+// The status codes mapped after ErrInvalidStatusCode was introduced also unwrap to it,
+// so the existing errors.Is(err, ErrInvalidStatusCode) checks are not broken.
+func (e *APIError) Unwrap() []error {
+
+	switch e.Err {
+	case ErrForbidden, ErrConflict, ErrPreconditionFailed, ErrUnprocessableEntity, ErrRateLimited, ErrServiceUnavailable:
+		return []error{e.Err, ErrInvalidStatusCode}
+	}
+
+	return []error{e.Err}
+}
+
+// apiErrorBodyScheme gathers the error body shapes returned by the Atlassian products.
+type apiErrorBodyScheme struct {
+	ErrorMessages []string        `json:"errorMessages"`
+	Errors        json.RawMessage `json:"errors"`
+	Message       string          `json:"message"`
+	Detail        string          `json:"detail"`
+	SCIMType      string          `json:"scimType"`
+	Error         *struct {
+		Message string              `json:"message"`
+		Detail  string              `json:"detail"`
+		Fields  map[string][]string `json:"fields"`
+	} `json:"error"`
+}
+
+// apiErrorItemScheme represents an item of the errors array returned by Confluence v2 and the Admin APIs.
+type apiErrorItemScheme struct {
+	Code    string `json:"code"`
+	Title   string `json:"title"`
+	Detail  string `json:"detail"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) decode(body []byte) {
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '{' {
+		return
+	}
+
+	payload := new(apiErrorBodyScheme)
+	if err := json.Unmarshal(body, payload); err != nil {
+		return
+	}
+
+	e.Messages = append(e.Messages, payload.ErrorMessages...)
+
+	if payload.Message != "" {
+		e.Messages = append(e.Messages, payload.Message)
+	}
+
+	e.Detail = payload.Detail
+	e.SCIMType = payload.SCIMType
+
+	if payload.Error != nil {
+
+		if payload.Error.Message != "" {
+			e.Messages = append(e.Messages, payload.Error.Message)
+		}
+
+		if payload.Error.Detail != "" {
+			e.Detail = payload.Error.Detail
+		}
+
+		for field, messages := range payload.Error.Fields {
+			e.addFieldError(field, strings.Join(messages, ", "))
+		}
+	}
+
+	errorsAsBytes := bytes.TrimSpace(payload.Errors)
+	if len(errorsAsBytes) == 0 {
+		return
+	}
+
+	switch errorsAsBytes[0] {
+
+	// Jira field errors
+	case '{':
+		fieldErrors := make(map[string]interface{})
+		if err := json.Unmarshal(errorsAsBytes, &fieldErrors); err != nil {
+			return
+		}
+
+		for field, message := range fieldErrors {
+			e.addFieldError(field, fmt.Sprintf("%v", message))
+		}
+
+	// Confluence v2 and Admin errors
+	case '[':
+		var items []*apiErrorItemScheme
+		if err := json.Unmarshal(errorsAsBytes, &items); err != nil {
+			return
+		}
+
+		for _, item := range items {
+
+			message := item.Title
+			if message == "" {
+				message = item.Message
+			}
+
+			if message == "" {
+				message = item.Code
+			}
+
+			if item.Detail != "" {
+				if message != "" {
+					message = fmt.Sprintf("%v (%v)", message, item.Detail)
+				} else {
+					message = item.Detail
+				}
+			}
+
+			if message != "" {
+				e.Messages = append(e.Messages, message)
+			}
+		}
+	}
+}
+
+func (e *APIError) addFieldError(field, message string) {
+
+	if e.Errors == nil {
+		e.Errors = make(map[string]string)
+	}
+
+	e.Errors[field] = message
+}
+
+// statusCodeError returns the sentinel error matching an unsuccessful status code.
+func statusCodeError(statusCode int) error {
+
+	switch statusCode {
+
+	case http.StatusBadRequest:
+		return ErrBadRequest
+
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+
+	case http.StatusForbidden:
+		return ErrForbidden
+
+	case http.StatusNotFound:
+		return ErrNotFound
+
+	case http.StatusConflict:
+		return ErrConflict
+
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+
+	case http.StatusUnprocessableEntity:
+		return ErrUnprocessableEntity
+
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+
+	case http.StatusInternalServerError:
+		return ErrInternal
+
+	case http.StatusServiceUnavailable:
+		return ErrServiceUnavailable
+
+	default:
+		return ErrInvalidStatusCode
+	}
+}
+
+func firstHeader(header http.Header, keys ...string) string {
+
+	for _, key := range keys {
+		if value := header.Get(key); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
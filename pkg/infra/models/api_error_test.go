@@ -0,0 +1,157 @@
+package models
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAPIErrorResponse(status int, body string, header http.Header) *ResponseScheme {
+
+	if header == nil {
+		header = http.Header{}
+	}
+
+	response := &ResponseScheme{
+		Response: &http.Response{StatusCode: status, Header: header},
+		Code:     status,
+		Endpoint: "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+		Method:   http.MethodPut,
+	}
+
+	response.Bytes.WriteString(body)
+
+	return response
+}
+
+func TestNewAPIError(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		response *ResponseScheme
+		want     *APIError
+		wantErr  string
+		wantIs   []error
+	}{
+		{
+			name: "when the body follows the jira error collection",
+			response: newAPIErrorResponse(http.StatusBadRequest,
+				`{"errorMessages":["Issue does not exist"],"errors":{"summary":"Summary is required","priority":"Priority is invalid"}}`,
+				http.Header{"X-Arequestid": {"1a2b3c"}, "Atl-Traceid": {"f00d"}}),
+			want: &APIError{
+				Err:        ErrBadRequest,
+				StatusCode: http.StatusBadRequest,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+				RequestID:  "1a2b3c",
+				TraceID:    "f00d",
+				Messages:   []string{"Issue does not exist"},
+				Errors:     map[string]string{"summary": "Summary is required", "priority": "Priority is invalid"},
+			},
+			wantErr: "client: atlassian invalid payload: Issue does not exist; priority: Priority is invalid; summary: Summary is required",
+			wantIs:  []error{ErrBadRequest},
+		},
+		{
+			name: "when the body follows the bitbucket error shape",
+			response: newAPIErrorResponse(http.StatusConflict,
+				`{"type":"error","error":{"message":"Branch already exists","detail":"feature/KP-1","fields":{"name":["duplicated"]}}}`, nil),
+			want: &APIError{
+				Err:        ErrConflict,
+				StatusCode: http.StatusConflict,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+				Messages:   []string{"Branch already exists"},
+				Errors:     map[string]string{"name": "duplicated"},
+				Detail:     "feature/KP-1",
+			},
+			wantErr: "client: atlassian conflict with the current state of the resource: Branch already exists; feature/KP-1; name: duplicated",
+			wantIs:  []error{ErrConflict, ErrInvalidStatusCode},
+		},
+		{
+			name: "when the body follows the confluence v2 errors array",
+			response: newAPIErrorResponse(http.StatusForbidden,
+				`{"errors":[{"status":403,"code":"FORBIDDEN","title":"Forbidden","detail":"Not permitted to use confluence"}]}`, nil),
+			want: &APIError{
+				Err:        ErrForbidden,
+				StatusCode: http.StatusForbidden,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+				Messages:   []string{"Forbidden (Not permitted to use confluence)"},
+			},
+			wantErr: "client: atlassian forbidden, the user does not have the required permissions: Forbidden (Not permitted to use confluence)",
+			wantIs:  []error{ErrForbidden, ErrInvalidStatusCode},
+		},
+		{
+			name: "when the body follows the scim error shape",
+			response: newAPIErrorResponse(http.StatusConflict,
+				`{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409","scimType":"uniqueness","detail":"User already exists"}`,
+				http.Header{"X-Request-Id": {"scim-1"}}),
+			want: &APIError{
+				Err:        ErrConflict,
+				StatusCode: http.StatusConflict,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+				RequestID:  "scim-1",
+				Detail:     "User already exists",
+				SCIMType:   "uniqueness",
+			},
+			wantErr: "client: atlassian conflict with the current state of the resource: User already exists",
+			wantIs:  []error{ErrConflict},
+		},
+		{
+			name:     "when the body is not a json document",
+			response: newAPIErrorResponse(http.StatusServiceUnavailable, "<html>Service Unavailable</html>", nil),
+			want: &APIError{
+				Err:        ErrServiceUnavailable,
+				StatusCode: http.StatusServiceUnavailable,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+			},
+			wantErr: "client: atlassian service unavailable",
+			wantIs:  []error{ErrServiceUnavailable, ErrInvalidStatusCode},
+		},
+		{
+			name:     "when the request is rate limited",
+			response: newAPIErrorResponse(http.StatusTooManyRequests, "", nil),
+			want: &APIError{
+				Err:        ErrRateLimited,
+				StatusCode: http.StatusTooManyRequests,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+			},
+			wantErr: "client: atlassian rate limited",
+			wantIs:  []error{ErrRateLimited, ErrInvalidStatusCode},
+		},
+		{
+			name:     "when the status code is not mapped",
+			response: newAPIErrorResponse(http.StatusTeapot, "", nil),
+			want: &APIError{
+				Err:        ErrInvalidStatusCode,
+				StatusCode: http.StatusTeapot,
+				Endpoint:   "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1",
+				Method:     http.MethodPut,
+			},
+			wantErr: "client: invalid http response status, please refer the response.body for more details",
+			wantIs:  []error{ErrInvalidStatusCode},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := NewAPIError(testCase.response)
+
+			assert.Equal(t, testCase.want, got)
+			assert.EqualError(t, got, testCase.wantErr)
+
+			for _, target := range testCase.wantIs {
+				assert.ErrorIs(t, got, target)
+			}
+
+			var apiErr *APIError
+			assert.True(t, errors.As(error(got), &apiErr))
+		})
+	}
+}
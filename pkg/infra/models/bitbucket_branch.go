@@ -21,3 +21,45 @@ type PullRequestBranchScheme struct {
 	Name  string                `json:"name"`
 	Links *WorkspaceLinksScheme `json:"links"`
 }
+
+// RefPageScheme represents a paginated list of branches or tags.
+type RefPageScheme struct {
+	Size     int          `json:"size,omitempty"`     // The number of references in the current page.
+	Page     int          `json:"page,omitempty"`     // The current page number.
+	Pagelen  int          `json:"pagelen,omitempty"`  // The maximum number of references per page.
+	Next     string       `json:"next,omitempty"`     // The URL to the next page.
+	Previous string       `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*RefScheme `json:"values,omitempty"`   // The references in the current page.
+}
+
+// RefScheme represents a branch or a tag.
+type RefScheme struct {
+	Type                 string              `json:"type,omitempty"`                   // The type of the reference, branch or tag.
+	Name                 string              `json:"name,omitempty"`                   // The name of the reference.
+	Target               *CommitScheme       `json:"target,omitempty"`                 // The commit the reference points to.
+	Links                *RefLinksScheme     `json:"links,omitempty"`                  // A collection of links related to the reference.
+	MergeStrategies      []string            `json:"merge_strategies,omitempty"`       // The merge strategies available for the branch, branches only.
+	DefaultMergeStrategy string              `json:"default_merge_strategy,omitempty"` // The default merge strategy of the branch, branches only.
+	Message              string              `json:"message,omitempty"`                // The message of the annotated tag, tags only.
+	Date                 string              `json:"date,omitempty"`                   // The creation time of the annotated tag, tags only.
+	Tagger               *CommitAuthorScheme `json:"tagger,omitempty"`                 // The author of the annotated tag, tags only.
+}
+
+// RefLinksScheme represents a collection of links related to a branch or a tag.
+type RefLinksScheme struct {
+	Self    *BitbucketLinkScheme `json:"self,omitempty"`    // The link to the reference itself.
+	Commits *BitbucketLinkScheme `json:"commits,omitempty"` // The link to the commits of the reference.
+	HTML    *BitbucketLinkScheme `json:"html,omitempty"`    // The link to the reference's HTML page.
+}
+
+// RefPayloadScheme represents the payload used to create a branch or a tag.
+type RefPayloadScheme struct {
+	Name    string                  `json:"name,omitempty"`    // The name of the reference.
+	Target  *RefTargetPayloadScheme `json:"target,omitempty"`  // The commit the reference points to.
+	Message string                  `json:"message,omitempty"` // The message of the tag, an annotated tag is created when set.
+}
+
+// RefTargetPayloadScheme represents the commit targeted by a new branch or tag.
+type RefTargetPayloadScheme struct {
+	Hash string `json:"hash,omitempty"` // The hash of the commit, or the name of a branch or tag pointing to it.
+}
@@ -0,0 +1,55 @@
+package models
+
+import "io"
+
+// CommitListOptionsScheme represents the filters used to list the commits of a repository.
+type CommitListOptionsScheme struct {
+	Include []string // The commits reachable from these branches, tags or hashes are returned, the main branch is used when empty.
+	Exclude []string // The commits reachable from these branches, tags or hashes are left out, e.g. to list the commits of a branch only.
+	Path    string   // Only the commits modifying this path are returned.
+}
+
+// DiffOptionsScheme represents the options used to compute a diff between two revisions.
+type DiffOptionsScheme struct {
+	Context          int      // The number of context lines around the changes, the Bitbucket default is used when zero.
+	Paths            []string // Limits the diff to these paths.
+	IgnoreWhitespace bool     // Whether the whitespace only changes are ignored.
+	Binary           bool     // Whether the binary files are included.
+}
+
+// SourcePageScheme represents a paginated directory listing.
+type SourcePageScheme struct {
+	Size     int             `json:"size,omitempty"`     // The number of entries in the current page.
+	Page     int             `json:"page,omitempty"`     // The current page number.
+	Pagelen  int             `json:"pagelen,omitempty"`  // The maximum number of entries per page.
+	Next     string          `json:"next,omitempty"`     // The URL to the next page.
+	Previous string          `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*SourceScheme `json:"values,omitempty"`   // The entries of the directory in the current page.
+}
+
+// SourceScheme represents a file or a directory at a given commit.
+type SourceScheme struct {
+	Type        string        `json:"type,omitempty"`         // The type of the entry, commit_file or commit_directory.
+	Path        string        `json:"path,omitempty"`         // The path of the entry.
+	EscapedPath string        `json:"escaped_path,omitempty"` // The path of the entry, escaped.
+	Size        int           `json:"size,omitempty"`         // The size of the file in bytes, files only.
+	MimeType    string        `json:"mimetype,omitempty"`     // The mime type of the file, files only.
+	Attributes  []string      `json:"attributes,omitempty"`   // The attributes of the file, e.g. binary, executable, link or lfs.
+	Commit      *CommitScheme `json:"commit,omitempty"`       // The commit the entry was read at.
+}
+
+// SourceCommitPayloadScheme represents the files committed through the source endpoint.
+type SourceCommitPayloadScheme struct {
+	Message string                    // The commit message.
+	Author  string                    // The author of the commit, in the "Name <email>" format, the authenticated user is used when empty.
+	Branch  string                    // The branch to commit to, it is created when it does not exist, the main branch is used when empty.
+	Parents []string                  // The parent commits, the branch head is used when empty.
+	Files   []*SourceCommitFileScheme // The files to add or update.
+	Deletes []string                  // The paths of the files to delete.
+}
+
+// SourceCommitFileScheme represents a file added or updated by a commit.
+type SourceCommitFileScheme struct {
+	Path    string    // The path of the file in the repository.
+	Content io.Reader // The content of the file.
+}
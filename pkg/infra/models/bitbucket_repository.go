@@ -69,3 +69,31 @@ type RepositoryLinksScheme struct {
 	Clone        []*BitbucketLinkScheme `json:"clone,omitempty"`        // The links to clone the repository.
 	Hooks        *BitbucketLinkScheme   `json:"hooks,omitempty"`        // The link to the repository's hooks.
 }
+
+// RepositoryForkPayloadScheme represents the payload used to fork a repository.
+type RepositoryForkPayloadScheme struct {
+	Name        string                       `json:"name,omitempty"`        // The name of the fork, the name of the parent repository is used when empty.
+	Workspace   *RepositoryForkTargetScheme  `json:"workspace,omitempty"`   // The workspace of the fork, the workspace of the authenticated user is used when nil.
+	Project     *RepositoryForkProjectScheme `json:"project,omitempty"`     // The project of the fork.
+	Description string                       `json:"description,omitempty"` // The description of the fork.
+	IsPrivate   *bool                        `json:"is_private,omitempty"`  // Indicates if the fork is private, it must be private when the parent repository is.
+	ForkPolicy  string                       `json:"fork_policy,omitempty"` // The fork policy of the fork.
+	Language    string                       `json:"language,omitempty"`    // The programming language used in the fork.
+	MainBranch  *MainBranchScheme            `json:"mainbranch,omitempty"`  // The main branch of the fork.
+}
+
+// RepositoryForkTargetScheme represents the workspace a repository is forked into.
+type RepositoryForkTargetScheme struct {
+	Slug string `json:"slug,omitempty"` // The slug of the workspace.
+}
+
+// RepositoryForkProjectScheme represents the project a repository is forked into.
+type RepositoryForkProjectScheme struct {
+	Key string `json:"key,omitempty"` // The key of the project.
+}
+
+// RepositoryInheritanceStateScheme represents the settings a repository overrides instead of inheriting them from its project.
+type RepositoryInheritanceStateScheme struct {
+	Type             string                  `json:"type,omitempty"`              // The type of the resource, repository_inheritance_state.
+	OverrideSettings *OverrideSettingsScheme `json:"override_settings,omitempty"` // The overridden settings.
+}
@@ -6,6 +6,7 @@ type WebhookSubscriptionPayloadScheme struct {
 	URL         string   `json:"url,omitempty"`         // The URL of the webhook subscription.
 	Active      bool     `json:"active,omitempty"`      // Indicates if the webhook subscription is active.
 	Events      []string `json:"events,omitempty"`      // The events for the webhook subscription.
+	Secret      string   `json:"secret,omitempty"`      // The secret used to sign the webhook deliveries in the X-Hub-Signature header.
 }
 
 // WebhookSubscriptionPageScheme represents a paginated list of webhook subscriptions.
@@ -28,9 +29,61 @@ type WebhookSubscriptionScheme struct {
 	Active      bool                              `json:"active,omitempty"`       // Indicates if the webhook subscription is active.
 	CreatedAt   string                            `json:"created_at,omitempty"`   // The creation time of the webhook subscription.
 	Events      []string                          `json:"events,omitempty"`       // The events for the webhook subscription.
+	SecretSet   bool                              `json:"secret_set,omitempty"`   // Indicates if the webhook deliveries are signed with a secret.
 }
 
 // WebhookSubscriptionSubjectScheme represents the subject of a webhook subscription.
 type WebhookSubscriptionSubjectScheme struct {
 	Type string `json:"type,omitempty"` // The type of the subject.
 }
+
+// RepositoryPushScheme represents the changes pushed to a repository, as sent by the repo:push webhook.
+type RepositoryPushScheme struct {
+	Changes []*RepositoryPushChangeScheme `json:"changes,omitempty"` // The reference changes of the push.
+}
+
+// RepositoryPushChangeScheme represents the change of a single branch or tag in a push.
+type RepositoryPushChangeScheme struct {
+	New       *RepositoryPushRefScheme `json:"new,omitempty"`       // The reference after the push, nil when it was deleted.
+	Old       *RepositoryPushRefScheme `json:"old,omitempty"`       // The reference before the push, nil when it was created.
+	Created   bool                     `json:"created,omitempty"`   // Indicates if the reference was created.
+	Closed    bool                     `json:"closed,omitempty"`    // Indicates if the reference was deleted.
+	Forced    bool                     `json:"forced,omitempty"`    // Indicates if the push was forced.
+	Truncated bool                     `json:"truncated,omitempty"` // Indicates if the list of commits was truncated.
+	Commits   []*CommitScheme          `json:"commits,omitempty"`   // The commits added to the reference, up to five.
+}
+
+// RepositoryPushRefScheme represents a branch or tag in a push.
+type RepositoryPushRefScheme struct {
+	Type   string        `json:"type,omitempty"`   // The type of the reference, branch or tag.
+	Name   string        `json:"name,omitempty"`   // The name of the reference.
+	Target *CommitScheme `json:"target,omitempty"` // The commit the reference points to.
+}
+
+// PullRequestApprovalScheme represents the approval of a pull request.
+type PullRequestApprovalScheme struct {
+	Date string                  `json:"date,omitempty"` // The date of the approval.
+	User *BitbucketAccountScheme `json:"user,omitempty"` // The user who approved the pull request.
+}
+
+// CommitStatusScheme represents the build status of a commit.
+type CommitStatusScheme struct {
+	UUID        string                   `json:"uuid,omitempty"`        // The unique identifier of the status.
+	Key         string                   `json:"key,omitempty"`         // The key identifying the build system reporting the status.
+	RefName     string                   `json:"refname,omitempty"`     // The name of the reference the status applies to.
+	URL         string                   `json:"url,omitempty"`         // The URL of the build.
+	State       string                   `json:"state,omitempty"`       // The state of the status, INPROGRESS, SUCCESSFUL, FAILED or STOPPED.
+	Name        string                   `json:"name,omitempty"`        // The name of the build.
+	Description string                   `json:"description,omitempty"` // The description of the build.
+	Type        string                   `json:"type,omitempty"`        // The type of the object.
+	CreatedOn   string                   `json:"created_on,omitempty"`  // The creation time of the status.
+	UpdatedOn   string                   `json:"updated_on,omitempty"`  // The update time of the status.
+	Commit      *CommitScheme            `json:"commit,omitempty"`      // The commit of the status.
+	Links       *CommitStatusLinksScheme `json:"links,omitempty"`       // A collection of links related to the status.
+}
+
+// CommitStatusLinksScheme represents a collection of links related to a commit status.
+type CommitStatusLinksScheme struct {
+	Self   *BitbucketLinkScheme `json:"self,omitempty"`   // The link to the status itself.
+	Commit *BitbucketLinkScheme `json:"commit,omitempty"` // The link to the commit of the status.
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"log/slog"
+	"time"
+)
 
 // ClientConfig holds configuration options for the Client
 type ClientConfig struct {
@@ -10,4 +13,15 @@ type ClientConfig struct {
 	InitialRetryDelay time.Duration
 	// MaxRetryDelay is the maximum delay between retries in milliseconds
 	MaxRetryDelay time.Duration
+	// RateLimit is the number of requests per second allowed by the client-side rate limiter,
+	// zero only paces the requests with the budget reported by the rate limit headers.
+	RateLimit float64
+	// RateLimitBurst is the number of requests the rate limiter lets through at once, 1 when zero.
+	RateLimitBurst int
+	// ShareRateLimit shares the rate limiter among the clients of the same site created with it set.
+	ShareRateLimit bool
+	// Logger receives the internal diagnostics of the client, such as the retries, they are discarded when nil.
+	Logger *slog.Logger
+	// Debug logs a summary of every request and response at the debug level, with the credentials redacted.
+	Debug bool
 }
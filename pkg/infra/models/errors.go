@@ -134,6 +134,11 @@ var (
 	ErrInternal                       = errors.New("client: atlassian internal error")
 	ErrBadRequest                     = errors.New("client: atlassian invalid payload")
 	ErrRateLimited                    = errors.New("client: atlassian rate limited")
+	ErrForbidden                      = errors.New("client: atlassian forbidden, the user does not have the required permissions")
+	ErrConflict                       = errors.New("client: atlassian conflict with the current state of the resource")
+	ErrPreconditionFailed             = errors.New("client: atlassian precondition failed")
+	ErrUnprocessableEntity            = errors.New("client: atlassian unprocessable entity")
+	ErrServiceUnavailable             = errors.New("client: atlassian service unavailable")
 	ErrNoSite                         = errors.New("client: no atlassian site set")
 	ErrNoFloatType                    = errors.New("custom-field: no float type set")
 	ErrNoSprintType                   = errors.New("custom-field: no sprint type found")
@@ -175,4 +180,44 @@ var (
 	ErrNoWebhookID                    = errors.New("bitbucket: no webhook id set")
 	ErrNoRepository                   = errors.New("bitbucket: no repository set")
 	ErrNoPipelineUUID                 = errors.New("bitbucket: no pipeline uuid set")
+	ErrNoChangelogIDs                 = errors.New("jira: no changelog id's set")
+	ErrNoIssueKeysOrIDs               = errors.New("jira: no issue key/id's set")
+	ErrNoWebhookIDs                   = errors.New("jira: no webhook id's set")
+	ErrNoWebhookPayload               = errors.New("jira: no webhook registration payload set")
+	ErrNoWebhookEvent                 = errors.New("jira: no webhook event set")
+	ErrNoWebhookEventKey              = errors.New("bitbucket: no webhook event key set")
+	ErrInvalidWebhookSignature        = errors.New("bitbucket: invalid webhook signature")
+	ErrNoGroupSlug                    = errors.New("bitbucket: no group slug set")
+	ErrNoUserID                       = errors.New("bitbucket: no user id set")
+	ErrNoPermissionLevel              = errors.New("bitbucket: no permission level set")
+	ErrNoPullRequestID                = errors.New("bitbucket: no pull request id set")
+	ErrNoPullRequestCommentID         = errors.New("bitbucket: no pull request comment id set")
+	ErrNoRefName                      = errors.New("bitbucket: no branch or tag name set")
+	ErrNoCommit                       = errors.New("bitbucket: no commit set")
+	ErrNoDiffSpec                     = errors.New("bitbucket: no diff spec set")
+	ErrNoSourcePath                   = errors.New("bitbucket: no source path set")
+	ErrNoSourcePayload                = errors.New("bitbucket: no source commit payload set")
+	ErrNoSourceFiles                  = errors.New("bitbucket: no files to commit set")
+	ErrNoPipelineStepUUID             = errors.New("bitbucket: no pipeline step uuid set")
+	ErrNoPipelineTarget               = errors.New("bitbucket: no pipeline target set")
+	ErrNoPipelineVariableUUID         = errors.New("bitbucket: no pipeline variable uuid set")
+	ErrNoPipelineScheduleUUID         = errors.New("bitbucket: no pipeline schedule uuid set")
+	ErrInvalidPollInterval            = errors.New("bitbucket: the poll interval must be positive")
+	ErrNoEnvironmentUUID              = errors.New("bitbucket: no deployment environment uuid set")
+	ErrNoAuthorizationCode            = errors.New("oauth: no authorization code set")
+	ErrNoRefreshToken                 = errors.New("oauth: no refresh token set")
+	ErrNoAccessToken                  = errors.New("oauth: no access token set")
+	ErrNoOAuthToken                   = errors.New("oauth: no token set on the token source")
+	ErrNoCloudResource                = errors.New("oauth: no accessible resource matches the site")
+	ErrNoConnectKey                   = errors.New("connect: no app key set")
+	ErrNoConnectSharedSecret          = errors.New("connect: no shared secret set")
+	ErrNoCassettePath                 = errors.New("cassette: no cassette path set")
+	ErrNoCassetteInteraction          = errors.New("cassette: no recorded interaction matches the request")
+	ErrInvalidADF                     = errors.New("adf: invalid document")
+	ErrInvalidJQL                     = errors.New("jql: invalid query")
+	ErrCustomFieldType                = errors.New("custom-field: the value does not match the type")
+	ErrNoCustomFieldName              = errors.New("custom-field: no field found with the name")
+	ErrAmbiguousCustomFieldName       = errors.New("custom-field: several fields have the name")
+	ErrInvalidCustomFieldTarget       = errors.New("custom-field: invalid target")
+	ErrInvalidIssuePayload            = errors.New("issuemeta: invalid issue payload")
 )
@@ -38,3 +38,34 @@ type IssueChangelogHistoryItemScheme struct {
 	To         string `json:"to,omitempty"`         // The new value of the field.
 	ToString   string `json:"toString,omitempty"`   // The new value of the field as a string.
 }
+
+// IssueChangelogPageScheme represents a page of the changelog of an issue in Jira.
+type IssueChangelogPageScheme struct {
+	Self       string                         `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                         `json:"nextPage,omitempty"`   // The URL of the next page.
+	MaxResults int                            `json:"maxResults,omitempty"` // The maximum number of results in the page.
+	StartAt    int                            `json:"startAt,omitempty"`    // The starting index of the page.
+	Total      int                            `json:"total,omitempty"`      // The total number of changes in the changelog.
+	IsLast     bool                           `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`     // The histories in the page.
+}
+
+// IssueChangelogBulkFetchPayloadScheme represents the payload to fetch the changelogs of multiple issues in Jira.
+type IssueChangelogBulkFetchPayloadScheme struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"` // The IDs or keys of the issues, up to 1000.
+	FieldIDs       []string `json:"fieldIds,omitempty"`       // The IDs of the fields to filter the changelogs by, up to 10.
+	MaxResults     int      `json:"maxResults,omitempty"`     // The maximum number of histories to return per page.
+	NextPageToken  string   `json:"nextPageToken,omitempty"`  // The token of the page to fetch, empty for the first page.
+}
+
+// IssueChangelogBulkFetchPageScheme represents a page of the changelogs of multiple issues in Jira.
+type IssueChangelogBulkFetchPageScheme struct {
+	IssueChangeLogs []*IssueChangelogBulkFetchIssueScheme `json:"issueChangeLogs,omitempty"` // The changelogs, grouped by issue.
+	NextPageToken   string                                `json:"nextPageToken,omitempty"`   // The token of the next page, empty on the last page.
+}
+
+// IssueChangelogBulkFetchIssueScheme represents the changelog of a single issue returned by the bulk fetch in Jira.
+type IssueChangelogBulkFetchIssueScheme struct {
+	IssueID         string                         `json:"issueId,omitempty"`         // The ID of the issue.
+	ChangeHistories []*IssueChangelogHistoryScheme `json:"changeHistories,omitempty"` // The histories of the issue.
+}
@@ -0,0 +1,65 @@
+package models
+
+// WebhookRegistrationPayloadScheme represents the payload to register dynamic webhooks in Jira.
+type WebhookRegistrationPayloadScheme struct {
+	Webhooks []*WebhookDetailsScheme `json:"webhooks,omitempty"` // The webhooks to register.
+	URL      string                  `json:"url,omitempty"`      // The URL that specifies where to send the webhooks, it must use the app base URL.
+}
+
+// WebhookDetailsScheme represents the details of a dynamic webhook to register in Jira.
+type WebhookDetailsScheme struct {
+	JqlFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter that specifies which issues the webhook is sent for.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The field IDs that trigger the jira:issue_updated webhook when changed.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The issue property keys that trigger the issue_property events when changed.
+	Events                  []string `json:"events,omitempty"`                  // The Jira events that trigger the webhook.
+}
+
+// WebhookRegistrationResultScheme represents the result of the registration of dynamic webhooks in Jira.
+type WebhookRegistrationResultScheme struct {
+	WebhookRegistrationResult []*WebhookRegistrationItemScheme `json:"webhookRegistrationResult,omitempty"` // The results, in the order of the registered webhooks.
+}
+
+// WebhookRegistrationItemScheme represents the result of the registration of a single dynamic webhook in Jira.
+type WebhookRegistrationItemScheme struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"` // The ID of the webhook, only set when it was registered.
+	Errors           []string `json:"errors,omitempty"`           // The errors preventing the registration of the webhook.
+}
+
+// WebhookPageScheme represents a page of the dynamic webhooks registered by the calling app in Jira.
+type WebhookPageScheme struct {
+	MaxResults int              `json:"maxResults,omitempty"` // The maximum number of results in the page.
+	StartAt    int              `json:"startAt,omitempty"`    // The starting index of the page.
+	Total      int              `json:"total,omitempty"`      // The total number of webhooks.
+	IsLast     bool             `json:"isLast,omitempty"`     // Indicates if the page is the last one.
+	Values     []*WebhookScheme `json:"values,omitempty"`     // The webhooks in the page.
+}
+
+// WebhookScheme represents a dynamic webhook registered in Jira.
+type WebhookScheme struct {
+	ID                      int      `json:"id,omitempty"`                      // The ID of the webhook.
+	JqlFilter               string   `json:"jqlFilter,omitempty"`               // The JQL filter that specifies which issues the webhook is sent for.
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`          // The field IDs that trigger the jira:issue_updated webhook when changed.
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"` // The issue property keys that trigger the issue_property events when changed.
+	Events                  []string `json:"events,omitempty"`                  // The Jira events that trigger the webhook.
+	ExpirationDate          int64    `json:"expirationDate,omitempty"`          // The date after which the webhook is no longer sent, in epoch milliseconds.
+}
+
+// WebhookExpirationScheme represents the new expiration date of refreshed dynamic webhooks in Jira.
+type WebhookExpirationScheme struct {
+	ExpirationDate int64 `json:"expirationDate,omitempty"` // The expiration date of the webhooks, in epoch milliseconds.
+}
+
+// FailedWebhookPageScheme represents a page of the webhooks that failed to be delivered in Jira.
+type FailedWebhookPageScheme struct {
+	Values     []*FailedWebhookScheme `json:"values,omitempty"`     // The failed webhooks in the page.
+	MaxResults int                    `json:"maxResults,omitempty"` // The maximum number of results in the page.
+	Next       string                 `json:"next,omitempty"`       // The URL of the next page, empty on the last page.
+}
+
+// FailedWebhookScheme represents a webhook that failed to be delivered in Jira.
+type FailedWebhookScheme struct {
+	ID          string `json:"id,omitempty"`          // The ID of the webhook.
+	Body        string `json:"body,omitempty"`        // The webhook body.
+	URL         string `json:"url,omitempty"`         // The original webhook destination.
+	FailureTime int64  `json:"failureTime,omitempty"` // The time the webhook was added to the list of failed webhooks, in epoch milliseconds.
+}
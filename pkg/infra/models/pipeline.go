@@ -16,6 +16,7 @@ type RepositoryPipelineVariable struct {
 	Secured bool   `json:"secured"`
 	System  bool   `json:"system"`
 	Scope   string `json:"scope"`
+	Value   string `json:"value,omitempty"`
 }
 
 type RepositoryPipelineRunsPageScheme struct {
@@ -156,3 +157,63 @@ type PipelineResult struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
 }
+
+// Pipeline target types, used in PipelineTriggerTargetScheme.Type.
+const (
+	PipelineRefTarget    = "pipeline_ref_target"
+	PipelineCommitTarget = "pipeline_commit_target"
+)
+
+// PipelineTriggerPayloadScheme represents the payload used to trigger a pipeline.
+type PipelineTriggerPayloadScheme struct {
+	Target    *PipelineTriggerTargetScheme     `json:"target,omitempty"`    // The target of the pipeline.
+	Variables []*PipelineVariablePayloadScheme `json:"variables,omitempty"` // The variables of the run, custom pipelines only.
+}
+
+// PipelineTriggerTargetScheme represents what a pipeline runs on.
+//
+// A branch or a tag is targeted with the PipelineRefTarget type and the RefType and RefName fields,
+// a commit with the PipelineCommitTarget type and the Commit field. The Selector picks a custom pipeline,
+// e.g. &PipelineSelector{Type: "custom", Pattern: "deploy"}, the pipeline of the reference is run when nil.
+type PipelineTriggerTargetScheme struct {
+	Type     string            `json:"type"`               // The type of the target, PipelineRefTarget or PipelineCommitTarget.
+	RefType  string            `json:"ref_type,omitempty"` // The type of the reference, branch or tag.
+	RefName  string            `json:"ref_name,omitempty"` // The name of the branch or tag.
+	Commit   *PipelineCommit   `json:"commit,omitempty"`   // The commit, required for commit targets and optional for reference targets.
+	Selector *PipelineSelector `json:"selector,omitempty"` // The custom pipeline to run.
+}
+
+// PipelineVariablePayloadScheme represents the payload used to create or update a pipeline variable.
+type PipelineVariablePayloadScheme struct {
+	Key     string `json:"key"`               // The name of the variable.
+	Value   string `json:"value"`             // The value of the variable.
+	Secured bool   `json:"secured,omitempty"` // Whether the variable is secured, the value of a secured variable is masked in the logs and cannot be read back.
+}
+
+// PipelineSchedulePageScheme represents a paginated list of pipeline schedules.
+type PipelineSchedulePageScheme struct {
+	Size     int                       `json:"size"`
+	Page     int                       `json:"page"`
+	Pagelen  int                       `json:"pagelen"`
+	Next     string                    `json:"next"`
+	Previous string                    `json:"previous"`
+	Values   []*PipelineScheduleScheme `json:"values"`
+}
+
+// PipelineScheduleScheme represents a pipeline schedule.
+type PipelineScheduleScheme struct {
+	Type        string                       `json:"type,omitempty"`         // The type of the object.
+	UUID        string                       `json:"uuid,omitempty"`         // The unique identifier of the schedule.
+	Enabled     bool                         `json:"enabled"`                // Whether the schedule is enabled.
+	CronPattern string                       `json:"cron_pattern,omitempty"` // The cron expression of the schedule, in UTC.
+	Target      *PipelineTriggerTargetScheme `json:"target,omitempty"`       // The target of the scheduled pipelines.
+	CreatedOn   string                       `json:"created_on,omitempty"`   // The creation time of the schedule.
+	UpdatedOn   string                       `json:"updated_on,omitempty"`   // The update time of the schedule.
+}
+
+// PipelineSchedulePayloadScheme represents the payload used to create a pipeline schedule.
+type PipelineSchedulePayloadScheme struct {
+	Enabled     bool                         `json:"enabled"`      // Whether the schedule is enabled.
+	CronPattern string                       `json:"cron_pattern"` // The cron expression of the schedule, in UTC, e.g. "0 0 2 * * ? *".
+	Target      *PipelineTriggerTargetScheme `json:"target"`       // The branch and selector to run, schedules only support branch targets.
+}
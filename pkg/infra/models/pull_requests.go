@@ -12,24 +12,27 @@ type PullRequestsResponse struct {
 
 // PullRequestScheme represents a pull request
 type PullRequestScheme struct {
-	CommentCount      int                     `json:"comment_count"`
-	TaskCount         int                     `json:"task_count"`
-	Type              string                  `json:"type"`
-	ID                int                     `json:"id"`
-	Title             string                  `json:"title"`
-	Description       string                  `json:"description"`
-	State             string                  `json:"state"`
-	MergeCommit       *CommitScheme           `json:"merge_commit"`
-	CloseSourceBranch bool                    `json:"close_source_branch"`
-	ClosedBy          *BitbucketAccountScheme `json:"closed_by"`
-	Author            *BitbucketAccountScheme `json:"author"`
-	Reason            string                  `json:"reason"`
-	CreatedOn         string                  `json:"created_on"`
-	UpdatedOn         string                  `json:"updated_on"`
-	Source            *PullRequestRefScheme   `json:"source"`
-	Destination       *PullRequestRefScheme   `json:"destination"`
-	Links             *PullRequestLinksScheme `json:"links"`
-	Summary           *SummaryScheme          `json:"summary"`
+	CommentCount      int                             `json:"comment_count"`
+	TaskCount         int                             `json:"task_count"`
+	Type              string                          `json:"type"`
+	ID                int                             `json:"id"`
+	Title             string                          `json:"title"`
+	Description       string                          `json:"description"`
+	State             string                          `json:"state"`
+	MergeCommit       *CommitScheme                   `json:"merge_commit"`
+	CloseSourceBranch bool                            `json:"close_source_branch"`
+	ClosedBy          *BitbucketAccountScheme         `json:"closed_by"`
+	Author            *BitbucketAccountScheme         `json:"author"`
+	Reason            string                          `json:"reason"`
+	CreatedOn         string                          `json:"created_on"`
+	UpdatedOn         string                          `json:"updated_on"`
+	Source            *PullRequestRefScheme           `json:"source"`
+	Destination       *PullRequestRefScheme           `json:"destination"`
+	Links             *PullRequestLinksScheme         `json:"links"`
+	Summary           *SummaryScheme                  `json:"summary"`
+	Draft             bool                            `json:"draft"`
+	Reviewers         []*BitbucketAccountScheme       `json:"reviewers"`
+	Participants      []*PullRequestParticipantScheme `json:"participants"`
 }
 
 // PullRequestRefScheme represents a reference (source/destination) in a pull request
@@ -46,6 +49,25 @@ type CommitScheme struct {
 	Message string                `json:"message"`
 	Date    string                `json:"date"`
 	Links   *WorkspaceLinksScheme `json:"links"`
+	Author  *CommitAuthorScheme   `json:"author,omitempty"`
+	Parents []*CommitScheme       `json:"parents,omitempty"`
+	Summary *SummaryScheme        `json:"summary,omitempty"`
+}
+
+// CommitAuthorScheme represents the author of a commit
+type CommitAuthorScheme struct {
+	Raw  string                  `json:"raw,omitempty"`  // The raw author value from the commit, e.g. "Name <email>".
+	User *BitbucketAccountScheme `json:"user,omitempty"` // The Bitbucket account matching the author, when there is one.
+}
+
+// CommitPageScheme represents a paginated list of commits
+type CommitPageScheme struct {
+	Size     int             `json:"size"`
+	Page     int             `json:"page"`
+	Pagelen  int             `json:"pagelen"`
+	Next     string          `json:"next"`
+	Previous string          `json:"previous"`
+	Values   []*CommitScheme `json:"values"`
 }
 
 // PullRequestLinksScheme represents the links available in a pull request
@@ -71,3 +93,194 @@ type SummaryScheme struct {
 	Markup string `json:"markup"`
 	HTML   string `json:"html"`
 }
+
+// PullRequestPayloadScheme represents the payload used to create or update a pull request
+type PullRequestPayloadScheme struct {
+	Title             string                              `json:"title,omitempty"`               // The title of the pull request.
+	Description       string                              `json:"description,omitempty"`         // The description of the pull request.
+	Source            *PullRequestRefPayloadScheme        `json:"source,omitempty"`              // The branch to merge, required on creation.
+	Destination       *PullRequestRefPayloadScheme        `json:"destination,omitempty"`         // The branch to merge into, the main branch is used when nil.
+	Reviewers         []*PullRequestReviewerPayloadScheme `json:"reviewers,omitempty"`           // The reviewers of the pull request.
+	CloseSourceBranch *bool                               `json:"close_source_branch,omitempty"` // Whether the source branch is deleted once the pull request is merged, unchanged when nil.
+	Draft             *bool                               `json:"draft,omitempty"`               // Whether the pull request is a draft, unchanged when nil.
+}
+
+// PullRequestRefPayloadScheme represents the source or destination of a pull request payload
+type PullRequestRefPayloadScheme struct {
+	Branch     *PullRequestBranchPayloadScheme     `json:"branch,omitempty"`     // The branch.
+	Commit     *PullRequestCommitPayloadScheme     `json:"commit,omitempty"`     // The commit, to pin the reference to a specific commit.
+	Repository *PullRequestRepositoryPayloadScheme `json:"repository,omitempty"` // The repository, only required when the source is a fork.
+}
+
+// PullRequestBranchPayloadScheme represents a branch in a pull request payload
+type PullRequestBranchPayloadScheme struct {
+	Name string `json:"name,omitempty"` // The name of the branch.
+}
+
+// PullRequestCommitPayloadScheme represents a commit in a pull request payload
+type PullRequestCommitPayloadScheme struct {
+	Hash string `json:"hash,omitempty"` // The hash of the commit.
+}
+
+// PullRequestRepositoryPayloadScheme represents a repository in a pull request payload
+type PullRequestRepositoryPayloadScheme struct {
+	FullName string `json:"full_name,omitempty"` // The full name of the repository, e.g. workspace/repository.
+}
+
+// PullRequestReviewerPayloadScheme represents a reviewer in a pull request payload
+type PullRequestReviewerPayloadScheme struct {
+	UUID      string `json:"uuid,omitempty"`       // The unique identifier of the reviewer.
+	AccountID string `json:"account_id,omitempty"` // The Atlassian account ID of the reviewer.
+}
+
+// PullRequestMergePayloadScheme represents the payload used to merge a pull request
+type PullRequestMergePayloadScheme struct {
+	Message           string `json:"message,omitempty"`             // The commit message of the merge, a default message is used when empty.
+	CloseSourceBranch *bool  `json:"close_source_branch,omitempty"` // Whether the source branch is deleted once merged, the setting of the pull request is used when nil.
+	// MergeStrategy is one of merge_commit, squash, fast_forward, squash_fast_forward, rebase_fast_forward or rebase_merge,
+	// the default merge strategy of the destination branch is used when empty.
+	MergeStrategy string `json:"merge_strategy,omitempty"`
+}
+
+// PullRequestParticipantScheme represents a participant of a pull request
+type PullRequestParticipantScheme struct {
+	Type           string                  `json:"type,omitempty"`            // The type of the object.
+	User           *BitbucketAccountScheme `json:"user,omitempty"`            // The participant.
+	Role           string                  `json:"role,omitempty"`            // The role of the participant, PARTICIPANT or REVIEWER.
+	Approved       bool                    `json:"approved,omitempty"`        // Whether the participant approved the pull request.
+	State          string                  `json:"state,omitempty"`           // The review state, approved, changes_requested or empty.
+	ParticipatedOn string                  `json:"participated_on,omitempty"` // The last time the participant interacted with the pull request.
+}
+
+// PullRequestCommentPageScheme represents a paginated list of pull request comments
+type PullRequestCommentPageScheme struct {
+	Size     int                         `json:"size"`
+	Page     int                         `json:"page"`
+	Pagelen  int                         `json:"pagelen"`
+	Next     string                      `json:"next"`
+	Previous string                      `json:"previous"`
+	Values   []*PullRequestCommentScheme `json:"values"`
+}
+
+// PullRequestCommentScheme represents a pull request comment
+type PullRequestCommentScheme struct {
+	ID         int                                 `json:"id,omitempty"`         // The ID of the comment.
+	Type       string                              `json:"type,omitempty"`       // The type of the object.
+	Content    *SummaryScheme                      `json:"content,omitempty"`    // The content of the comment.
+	User       *BitbucketAccountScheme             `json:"user,omitempty"`       // The author of the comment.
+	CreatedOn  string                              `json:"created_on,omitempty"` // The creation time of the comment.
+	UpdatedOn  string                              `json:"updated_on,omitempty"` // The update time of the comment.
+	Deleted    bool                                `json:"deleted,omitempty"`    // Whether the comment was deleted.
+	Pending    bool                                `json:"pending,omitempty"`    // Whether the comment is pending, i.e. part of an unpublished review.
+	Inline     *PullRequestCommentInlineScheme     `json:"inline,omitempty"`     // The file and lines the comment is anchored to, nil for general comments.
+	Parent     *PullRequestCommentParentScheme     `json:"parent,omitempty"`     // The comment replied to.
+	Resolution *PullRequestCommentResolutionScheme `json:"resolution,omitempty"` // The resolution of the comment, nil when unresolved.
+	Links      *PullRequestCommentLinksScheme      `json:"links,omitempty"`      // A collection of links related to the comment.
+}
+
+// PullRequestCommentPayloadScheme represents the payload used to add a pull request comment
+type PullRequestCommentPayloadScheme struct {
+	Content *PullRequestCommentContentScheme `json:"content,omitempty"` // The content of the comment.
+	Inline  *PullRequestCommentInlineScheme  `json:"inline,omitempty"`  // The file and line to anchor the comment to.
+	Parent  *PullRequestCommentParentScheme  `json:"parent,omitempty"`  // The comment to reply to.
+	Pending bool                             `json:"pending,omitempty"` // Whether the comment is added to the pending review instead of being published.
+}
+
+// PullRequestCommentContentScheme represents the content of a comment payload
+type PullRequestCommentContentScheme struct {
+	Raw string `json:"raw,omitempty"` // The comment text, in markdown.
+}
+
+// PullRequestCommentInlineScheme represents the anchor of an inline comment.
+//
+// From targets a line of the old version of the file and To a line of the new version,
+// a comment on an added line only sets To while a comment on a removed line only sets From.
+type PullRequestCommentInlineScheme struct {
+	Path string `json:"path"`           // The path of the file.
+	From *int   `json:"from,omitempty"` // The line in the old version of the file.
+	To   *int   `json:"to,omitempty"`   // The line in the new version of the file.
+}
+
+// PullRequestCommentParentScheme represents the comment replied to
+type PullRequestCommentParentScheme struct {
+	ID int `json:"id"` // The ID of the parent comment.
+}
+
+// PullRequestCommentResolutionScheme represents the resolution of a pull request comment
+type PullRequestCommentResolutionScheme struct {
+	Type      string                  `json:"type,omitempty"`       // The type of the object.
+	User      *BitbucketAccountScheme `json:"user,omitempty"`       // The user who resolved the comment.
+	CreatedOn string                  `json:"created_on,omitempty"` // The resolution time.
+}
+
+// PullRequestCommentLinksScheme represents the links available in a pull request comment
+type PullRequestCommentLinksScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"`
+	HTML *BitbucketLinkScheme `json:"html,omitempty"`
+	Code *BitbucketLinkScheme `json:"code,omitempty"`
+}
+
+// PullRequestActivityPageScheme represents a paginated list of pull request activities
+type PullRequestActivityPageScheme struct {
+	Pagelen int                          `json:"pagelen"`
+	Next    string                       `json:"next"`
+	Values  []*PullRequestActivityScheme `json:"values"`
+}
+
+// PullRequestActivityScheme represents an activity of a pull request, only one of the activity fields is set
+type PullRequestActivityScheme struct {
+	PullRequest      *PullRequestScheme               `json:"pull_request,omitempty"`      // The pull request the activity belongs to.
+	Update           *PullRequestActivityUpdateScheme `json:"update,omitempty"`            // Set when the pull request was updated.
+	Approval         *PullRequestApprovalScheme       `json:"approval,omitempty"`          // Set when the pull request was approved.
+	ChangesRequested *PullRequestApprovalScheme       `json:"changes_requested,omitempty"` // Set when changes were requested.
+	Comment          *PullRequestCommentScheme        `json:"comment,omitempty"`           // Set when a comment was added.
+}
+
+// PullRequestActivityUpdateScheme represents the update of a pull request in its activity
+type PullRequestActivityUpdateScheme struct {
+	State       string                  `json:"state,omitempty"`       // The state of the pull request after the update.
+	Title       string                  `json:"title,omitempty"`       // The title of the pull request after the update.
+	Description string                  `json:"description,omitempty"` // The description of the pull request after the update.
+	Reason      string                  `json:"reason,omitempty"`      // The reason of the update, e.g. the decline reason.
+	Date        string                  `json:"date,omitempty"`        // The time of the update.
+	Author      *BitbucketAccountScheme `json:"author,omitempty"`      // The user who updated the pull request.
+	Source      *PullRequestRefScheme   `json:"source,omitempty"`      // The source of the pull request after the update.
+	Destination *PullRequestRefScheme   `json:"destination,omitempty"` // The destination of the pull request after the update.
+}
+
+// CommitStatusPageScheme represents a paginated list of commit statuses
+type CommitStatusPageScheme struct {
+	Size     int                   `json:"size"`
+	Page     int                   `json:"page"`
+	Pagelen  int                   `json:"pagelen"`
+	Next     string                `json:"next"`
+	Previous string                `json:"previous"`
+	Values   []*CommitStatusScheme `json:"values"`
+}
+
+// DiffStatPageScheme represents a paginated list of file changes
+type DiffStatPageScheme struct {
+	Size     int               `json:"size"`
+	Page     int               `json:"page"`
+	Pagelen  int               `json:"pagelen"`
+	Next     string            `json:"next"`
+	Previous string            `json:"previous"`
+	Values   []*DiffStatScheme `json:"values"`
+}
+
+// DiffStatScheme represents the changes of a file
+type DiffStatScheme struct {
+	Type         string            `json:"type,omitempty"`          // The type of the object.
+	Status       string            `json:"status,omitempty"`        // The status of the file, added, removed, modified or renamed.
+	LinesAdded   int               `json:"lines_added,omitempty"`   // The number of lines added.
+	LinesRemoved int               `json:"lines_removed,omitempty"` // The number of lines removed.
+	Old          *CommitFileScheme `json:"old,omitempty"`           // The file before the change, nil when added.
+	New          *CommitFileScheme `json:"new,omitempty"`           // The file after the change, nil when removed.
+}
+
+// CommitFileScheme represents a file at a given commit
+type CommitFileScheme struct {
+	Type        string `json:"type,omitempty"`         // The type of the object.
+	Path        string `json:"path,omitempty"`         // The path of the file.
+	EscapedPath string `json:"escaped_path,omitempty"` // The path of the file, escaped.
+}
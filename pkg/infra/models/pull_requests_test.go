@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestPayloadScheme_MarshalJSON(t *testing.T) {
+
+	enabled, disabled := true, false
+
+	testCases := []struct {
+		name    string
+		payload *PullRequestPayloadScheme
+		want    string
+	}{
+		{
+			name:    "when the flags are not set",
+			payload: &PullRequestPayloadScheme{Title: "Release 1.2.0"},
+			want:    `{"title":"Release 1.2.0"}`,
+		},
+		{
+			name:    "when the flags are turned off",
+			payload: &PullRequestPayloadScheme{CloseSourceBranch: &disabled, Draft: &disabled},
+			want:    `{"close_source_branch":false,"draft":false}`,
+		},
+		{
+			name:    "when the flags are turned on",
+			payload: &PullRequestPayloadScheme{CloseSourceBranch: &enabled, Draft: &enabled},
+			want:    `{"close_source_branch":true,"draft":true}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			encoded, err := json.Marshal(testCase.payload)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, testCase.want, string(encoded))
+		})
+	}
+}
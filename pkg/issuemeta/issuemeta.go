@@ -0,0 +1,242 @@
+// Package issuemeta caches the create and edit metadata of the issues and validates the issue payloads against it,
+// so a payload Jira would reject with a 400 is reported locally, field by field:
+//
+//	registry := issuemeta.NewRegistry(client.Issue.Metadata)
+//
+//	if err := registry.Validate(ctx, payload, customFields); err != nil {
+//		// err joins a *ValidationError per field, e.g.
+//		// issuemeta: customfield_10010 (Team): "Mobile" is not an allowed value, the allowed values are Platform, API
+//	}
+//
+// The create metadata is fetched with FetchFieldMappings once per project and issue type, the edit metadata is
+// fetched with Metadata.Get on each update since it depends on the state of the issue. NewADFIssues and
+// NewRichTextIssues wrap the issue services to validate the payloads of Create, Creates and Update automatically.
+package issuemeta
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// pageSize is the number of issue types or fields fetched per page of the create metadata.
+const pageSize = 50
+
+// Field is the metadata of a field on a create or an edit screen.
+type Field struct {
+	ID              string                        `json:"fieldId,omitempty"`
+	Key             string                        `json:"key,omitempty"`
+	Name            string                        `json:"name,omitempty"`
+	Required        bool                          `json:"required,omitempty"`
+	HasDefaultValue bool                          `json:"hasDefaultValue,omitempty"`
+	Operations      []string                      `json:"operations,omitempty"`
+	Schema          *model.IssueFieldSchemaScheme `json:"schema,omitempty"`
+	AllowedValues   []*AllowedValue               `json:"allowedValues,omitempty"`
+}
+
+// AllowedValue is a value accepted by a field, e.g. an option, a version or a priority.
+type AllowedValue struct {
+	ID       string          `json:"id,omitempty"`
+	Key      string          `json:"key,omitempty"`
+	Name     string          `json:"name,omitempty"`
+	Value    string          `json:"value,omitempty"`
+	Children []*AllowedValue `json:"children,omitempty"` // The child options of a cascading select list.
+}
+
+// label returns the value shown to the users.
+func (v *AllowedValue) label() string {
+
+	switch {
+	case v.Value != "":
+		return v.Value
+	case v.Name != "":
+		return v.Name
+	case v.Key != "":
+		return v.Key
+	}
+
+	return v.ID
+}
+
+// Schema is the metadata of the fields of a create or an edit screen.
+type Schema struct {
+	Fields map[string]*Field // The fields by ID.
+	edit   bool
+}
+
+// issueType is an issue type of the create metadata of a project.
+type issueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Registry fetches and caches the create metadata of the issues, it is safe for concurrent use.
+//
+// The lock only guards the cache, the metadata is fetched without holding it, so a slow project does not hold up the
+// validation of the others. Concurrent calls missing the same schema fetch it each, the first one stored is kept.
+type Registry struct {
+	metadata jira.MetadataConnector
+
+	mu         sync.Mutex
+	schemas    map[string]*Schema     // The create schemas by project and issue type ID.
+	issueTypes map[string][]issueType // The issue types by project.
+}
+
+// NewRegistry returns a registry fetching the metadata with the connector, e.g. client.Issue.Metadata.
+func NewRegistry(metadata jira.MetadataConnector) *Registry {
+	return &Registry{metadata: metadata, schemas: make(map[string]*Schema), issueTypes: make(map[string][]issueType)}
+}
+
+// Reset drops the cached metadata, e.g. after a change of the screens or the field configurations.
+func (r *Registry) Reset() {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schemas = make(map[string]*Schema)
+	r.issueTypes = make(map[string][]issueType)
+}
+
+// CreateSchema returns the fields of the create screen of the project and the issue type, the issue type is an ID
+// or a name.
+func (r *Registry) CreateSchema(ctx context.Context, projectKeyOrID, issueTypeIDOrName string) (*Schema, error) {
+
+	issueTypeID, err := r.issueTypeID(ctx, projectKeyOrID, issueTypeIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	key := projectKeyOrID + "/" + issueTypeID
+
+	r.mu.Lock()
+	schema, ok := r.schemas[key]
+	r.mu.Unlock()
+
+	if ok {
+		return schema, nil
+	}
+
+	schema = &Schema{Fields: make(map[string]*Field)}
+
+	for startAt := 0; ; {
+
+		page, _, err := r.metadata.FetchFieldMappings(ctx, projectKeyOrID, issueTypeID, startAt, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		values := pageValues(page, "fields")
+		for _, value := range values {
+
+			field := new(Field)
+			if err := json.Unmarshal([]byte(value.Raw), field); err != nil {
+				return nil, err
+			}
+
+			schema.Fields[field.ID] = field
+		}
+
+		startAt += len(values)
+		if len(values) == 0 || startAt >= int(page.Get("total").Int()) {
+			break
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cached, ok := r.schemas[key]; ok {
+		return cached, nil
+	}
+
+	r.schemas[key] = schema
+	return schema, nil
+}
+
+// issueTypeID returns the ID of the issue type, the names are resolved with the issue types of the project.
+func (r *Registry) issueTypeID(ctx context.Context, projectKeyOrID, issueTypeIDOrName string) (string, error) {
+
+	r.mu.Lock()
+	issueTypes, ok := r.issueTypes[projectKeyOrID]
+	r.mu.Unlock()
+
+	if !ok {
+
+		for startAt := 0; ; {
+
+			page, _, err := r.metadata.FetchIssueMappings(ctx, projectKeyOrID, startAt, pageSize)
+			if err != nil {
+				return "", err
+			}
+
+			values := pageValues(page, "issueTypes")
+			for _, value := range values {
+				issueTypes = append(issueTypes, issueType{ID: value.Get("id").String(), Name: value.Get("name").String()})
+			}
+
+			startAt += len(values)
+			if len(values) == 0 || startAt >= int(page.Get("total").Int()) {
+				break
+			}
+		}
+
+		r.mu.Lock()
+		r.issueTypes[projectKeyOrID] = issueTypes
+		r.mu.Unlock()
+	}
+
+	for _, issueType := range issueTypes {
+		if issueType.ID == issueTypeIDOrName || strings.EqualFold(issueType.Name, issueTypeIDOrName) {
+			return issueType.ID, nil
+		}
+	}
+
+	return "", &ValidationError{Field: "issuetype", Message: "the issue type " + issueTypeIDOrName + " is not in the create metadata of " + projectKeyOrID}
+}
+
+// EditSchema returns the fields of the edit screen of the issue, the edit metadata is not cached.
+func (r *Registry) EditSchema(ctx context.Context, issueKeyOrID string) (*Schema, error) {
+
+	page, _, err := r.metadata.Get(ctx, issueKeyOrID, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{Fields: make(map[string]*Field), edit: true}
+
+	var parseErr error
+	page.Get("fields").ForEach(func(id, value gjson.Result) bool {
+
+		field := new(Field)
+		if parseErr = json.Unmarshal([]byte(value.Raw), field); parseErr != nil {
+			return false
+		}
+
+		field.ID = id.String()
+		schema.Fields[field.ID] = field
+		return true
+	})
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return schema, nil
+}
+
+// pageValues returns the values of a page of the create metadata, under the key or under values for the former
+// format of the endpoints.
+func pageValues(page gjson.Result, key string) []gjson.Result {
+
+	if values := page.Get(key); values.Exists() {
+		return values.Array()
+	}
+
+	return page.Get("values").Array()
+}
@@ -0,0 +1,158 @@
+package issuemeta
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+type fakeMetadataConnector struct {
+	jira.MetadataConnector
+	issueTypes string
+	fields     []string // The pages of fields, by startAt / 4.
+	editmeta   string
+	fetching   chan struct{} // Receives when the fields of the project SLOW are being fetched, when set.
+	blocked    chan struct{} // The fields of the project SLOW are fetched once it is closed, when set.
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (f *fakeMetadataConnector) call(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[name]++
+}
+
+func (f *fakeMetadataConnector) FetchIssueMappings(_ context.Context, _ string, _, _ int) (gjson.Result, *model.ResponseScheme, error) {
+	f.call("issuetypes")
+	return gjson.Parse(f.issueTypes), nil, nil
+}
+
+func (f *fakeMetadataConnector) FetchFieldMappings(_ context.Context, projectKeyOrID, issueTypeID string, startAt, _ int) (gjson.Result, *model.ResponseScheme, error) {
+
+	if projectKeyOrID == "SLOW" && f.blocked != nil && startAt == 0 {
+		f.fetching <- struct{}{}
+		<-f.blocked
+	}
+
+	f.call("fields/" + issueTypeID)
+	return gjson.Parse(f.fields[startAt/4]), nil, nil
+}
+
+func (f *fakeMetadataConnector) Get(_ context.Context, _ string, _, _ bool) (gjson.Result, *model.ResponseScheme, error) {
+	f.call("editmeta")
+	return gjson.Parse(f.editmeta), nil, nil
+}
+
+func newFakeMetadataConnector() *fakeMetadataConnector {
+	return &fakeMetadataConnector{
+		calls:      make(map[string]int),
+		issueTypes: `{"startAt": 0, "total": 2, "issueTypes": [{"id": "10001", "name": "Bug"}, {"id": "10002", "name": "Story"}]}`,
+		fields: []string{
+			`{"startAt": 0, "total": 7, "fields": [
+				{"fieldId": "project", "name": "Project", "required": true, "schema": {"type": "project", "system": "project"},
+					"allowedValues": [{"id": "10000", "key": "KP", "name": "Kanban Project"}]},
+				{"fieldId": "issuetype", "name": "Issue Type", "required": true, "schema": {"type": "issuetype", "system": "issuetype"},
+					"allowedValues": [{"id": "10001", "name": "Bug"}]},
+				{"fieldId": "summary", "name": "Summary", "required": true, "schema": {"type": "string", "system": "summary"}},
+				{"fieldId": "reporter", "name": "Reporter", "required": true, "hasDefaultValue": true, "schema": {"type": "user", "system": "reporter"}}
+			]}`,
+			`{"startAt": 4, "total": 7, "fields": [
+				{"fieldId": "customfield_10010", "name": "Team", "required": true, "schema": {"type": "option", "customId": 10010},
+					"allowedValues": [{"id": "10100", "value": "Platform"}, {"id": "10101", "value": "API"}]},
+				{"fieldId": "customfield_10016", "name": "Story Points", "schema": {"type": "number", "customId": 10016}},
+				{"fieldId": "customfield_10033", "name": "Region", "schema": {"type": "option-with-child", "customId": 10033},
+					"allowedValues": [{"id": "10200", "value": "EMEA", "children": [{"id": "10201", "value": "Spain"}]}]}
+			]}`,
+		},
+		editmeta: `{"fields": {
+			"summary": {"name": "Summary", "required": true, "operations": ["set"], "schema": {"type": "string", "system": "summary"}},
+			"labels": {"name": "Labels", "operations": ["add", "set", "remove"], "schema": {"type": "array", "items": "string", "system": "labels"}},
+			"customfield_10010": {"name": "Team", "operations": ["set"], "schema": {"type": "option", "customId": 10010},
+				"allowedValues": [{"id": "10100", "value": "Platform"}, {"id": "10101", "value": "API"}]},
+			"customfield_10020": {"name": "Sprint", "operations": ["set"], "schema": {"type": "array", "items": "json", "custom": "com.pyxis.greenhopper.jira:gh-sprint"}}
+		}}`,
+	}
+}
+
+func TestRegistry_CreateSchema(t *testing.T) {
+
+	connector := newFakeMetadataConnector()
+	registry := NewRegistry(connector)
+
+	schema, err := registry.CreateSchema(context.Background(), "KP", "bug")
+	require.NoError(t, err)
+
+	assert.Len(t, schema.Fields, 7)
+	assert.Equal(t, "Platform", schema.Fields["customfield_10010"].AllowedValues[0].Value)
+	assert.Equal(t, "Spain", schema.Fields["customfield_10033"].AllowedValues[0].Children[0].Value)
+
+	// The schemas and the issue types are cached.
+	_, err = registry.CreateSchema(context.Background(), "KP", "10001")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"issuetypes": 1, "fields/10001": 2}, connector.calls)
+
+	registry.Reset()
+	_, err = registry.CreateSchema(context.Background(), "KP", "Bug")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"issuetypes": 2, "fields/10001": 4}, connector.calls)
+
+	_, err = registry.CreateSchema(context.Background(), "KP", "Epic")
+	assert.EqualError(t, err, "issuemeta: issuetype: the issue type Epic is not in the create metadata of KP")
+	assert.ErrorIs(t, err, model.ErrInvalidIssuePayload)
+}
+
+func TestRegistry_CreateSchema_Concurrent(t *testing.T) {
+
+	connector := newFakeMetadataConnector()
+	connector.fetching, connector.blocked = make(chan struct{}), make(chan struct{})
+
+	registry := NewRegistry(connector)
+
+	_, err := registry.CreateSchema(context.Background(), "KP", "Bug")
+	require.NoError(t, err)
+
+	slow := make(chan error)
+	go func() {
+		_, err := registry.CreateSchema(context.Background(), "SLOW", "Bug")
+		slow <- err
+	}()
+
+	<-connector.fetching
+
+	// The schema of KP is read from the cache while the fields of SLOW are still being fetched.
+	done := make(chan error)
+	go func() {
+		_, err := registry.CreateSchema(context.Background(), "KP", "Bug")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("the cached schema is blocked by the fetch of another project")
+	}
+
+	close(connector.blocked)
+	assert.NoError(t, <-slow)
+}
+
+func TestRegistry_EditSchema(t *testing.T) {
+
+	schema, err := NewRegistry(newFakeMetadataConnector()).EditSchema(context.Background(), "KP-1")
+	require.NoError(t, err)
+
+	assert.Len(t, schema.Fields, 4)
+	assert.Equal(t, "labels", schema.Fields["labels"].ID)
+	assert.Equal(t, []string{"add", "set", "remove"}, schema.Fields["labels"].Operations)
+}
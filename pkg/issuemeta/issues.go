@@ -0,0 +1,122 @@
+package issuemeta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// ADFIssues is the issue service of the v3 client validating the payloads of Create, Creates and Update with the
+// registry before sending them, the other methods are the ones of the wrapped service.
+type ADFIssues struct {
+	jira.IssueADFConnector
+	registry *Registry
+}
+
+// NewADFIssues returns the issue service validating its payloads, e.g. NewADFIssues(client.Issue, registry).
+func NewADFIssues(issues jira.IssueADFConnector, registry *Registry) *ADFIssues {
+	return &ADFIssues{IssueADFConnector: issues, registry: registry}
+}
+
+// Create validates the payload with Registry.Validate, then creates the issue.
+func (i *ADFIssues) Create(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	if err := i.registry.Validate(ctx, payload, customFields); err != nil {
+		return nil, nil, err
+	}
+
+	return i.IssueADFConnector.Create(ctx, payload, customFields)
+}
+
+// Creates validates every payload with Registry.Validate, then creates the issues. None is created when a payload
+// is invalid, the errors are prefixed with the index of their payload.
+func (i *ADFIssues) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+	var errs []error
+	for index, issue := range payload {
+		if issue != nil {
+			errs = append(errs, indexed(index, i.registry.Validate(ctx, issue.Payload, issue.CustomFields)))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+
+	return i.IssueADFConnector.Creates(ctx, payload)
+}
+
+// Update validates the payload with Registry.ValidateUpdate, then edits the issue.
+func (i *ADFIssues) Update(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueScheme, customFields *model.CustomFields,
+	operations *model.UpdateOperations) (*model.ResponseScheme, error) {
+
+	if err := i.registry.ValidateUpdate(ctx, issueKeyOrID, payload, customFields, operations); err != nil {
+		return nil, err
+	}
+
+	return i.IssueADFConnector.Update(ctx, issueKeyOrID, notify, payload, customFields, operations)
+}
+
+// RichTextIssues is the issue service of the v2 client validating the payloads of Create, Creates and Update with
+// the registry before sending them, the other methods are the ones of the wrapped service.
+type RichTextIssues struct {
+	jira.IssueRichTextConnector
+	registry *Registry
+}
+
+// NewRichTextIssues returns the issue service validating its payloads, e.g. NewRichTextIssues(client.Issue, registry).
+func NewRichTextIssues(issues jira.IssueRichTextConnector, registry *Registry) *RichTextIssues {
+	return &RichTextIssues{IssueRichTextConnector: issues, registry: registry}
+}
+
+// Create validates the payload with Registry.Validate, then creates the issue.
+func (i *RichTextIssues) Create(ctx context.Context, payload *model.IssueSchemeV2, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	if err := i.registry.Validate(ctx, payload, customFields); err != nil {
+		return nil, nil, err
+	}
+
+	return i.IssueRichTextConnector.Create(ctx, payload, customFields)
+}
+
+// Creates validates every payload with Registry.Validate, then creates the issues. None is created when a payload
+// is invalid, the errors are prefixed with the index of their payload.
+func (i *RichTextIssues) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV2) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+
+	var errs []error
+	for index, issue := range payload {
+		if issue != nil {
+			errs = append(errs, indexed(index, i.registry.Validate(ctx, issue.Payload, issue.CustomFields)))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+
+	return i.IssueRichTextConnector.Creates(ctx, payload)
+}
+
+// Update validates the payload with Registry.ValidateUpdate, then edits the issue.
+func (i *RichTextIssues) Update(ctx context.Context, issueKeyOrID string, notify bool, payload *model.IssueSchemeV2, customFields *model.CustomFields,
+	operations *model.UpdateOperations) (*model.ResponseScheme, error) {
+
+	if err := i.registry.ValidateUpdate(ctx, issueKeyOrID, payload, customFields, operations); err != nil {
+		return nil, err
+	}
+
+	return i.IssueRichTextConnector.Update(ctx, issueKeyOrID, notify, payload, customFields, operations)
+}
+
+// indexed prefixes the error of a bulk payload with its index.
+func indexed(index int, err error) error {
+
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("issues[%v]: %w", index, err)
+}
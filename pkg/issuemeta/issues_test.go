@@ -0,0 +1,66 @@
+package issuemeta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+type fakeIssueADFConnector struct {
+	jira.IssueADFConnector
+	created int
+	updated int
+}
+
+func (f *fakeIssueADFConnector) Create(_ context.Context, _ *model.IssueScheme, _ *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	f.created++
+	return &model.IssueResponseScheme{Key: "KP-1"}, nil, nil
+}
+
+func (f *fakeIssueADFConnector) Creates(_ context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
+	f.created += len(payload)
+	return &model.IssueBulkResponseScheme{}, nil, nil
+}
+
+func (f *fakeIssueADFConnector) Update(_ context.Context, _ string, _ bool, _ *model.IssueScheme, _ *model.CustomFields, _ *model.UpdateOperations) (*model.ResponseScheme, error) {
+	f.updated++
+	return nil, nil
+}
+
+func TestADFIssues(t *testing.T) {
+
+	connector := &fakeIssueADFConnector{}
+	issues := NewADFIssues(connector, NewRegistry(newFakeMetadataConnector()))
+
+	payload, customFields := newPayload()
+
+	issue, _, err := issues.Create(context.Background(), payload, customFields)
+	require.NoError(t, err)
+	assert.Equal(t, "KP-1", issue.Key)
+
+	// An invalid payload is not sent.
+	invalid, _ := newPayload()
+	_, _, err = issues.Create(context.Background(), invalid, nil)
+	assert.EqualError(t, err, "issuemeta: customfield_10010 (Team): the field is required")
+	assert.Equal(t, 1, connector.created)
+
+	_, _, err = issues.Creates(context.Background(), []*model.IssueBulkSchemeV3{
+		{Payload: payload, CustomFields: customFields},
+		{Payload: invalid},
+	})
+	assert.EqualError(t, err, "issues[1]: issuemeta: customfield_10010 (Team): the field is required")
+	assert.ErrorIs(t, err, model.ErrInvalidIssuePayload)
+	assert.Equal(t, 1, connector.created)
+
+	_, err = issues.Update(context.Background(), "KP-1", false, &model.IssueScheme{Fields: &model.IssueFieldsScheme{Summary: "Login fails on Safari"}}, nil, nil)
+	require.NoError(t, err)
+
+	_, err = issues.Update(context.Background(), "KP-1", false, &model.IssueScheme{Fields: &model.IssueFieldsScheme{Priority: &model.PriorityScheme{Name: "High"}}}, nil, nil)
+	assert.EqualError(t, err, "issuemeta: priority: the field is not on the edit screen of the issue")
+	assert.Equal(t, 1, connector.updated)
+}
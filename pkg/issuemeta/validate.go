@@ -0,0 +1,487 @@
+package issuemeta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// sprintType is the custom type of the sprint field, its schema is an array but it takes a single sprint ID.
+const sprintType = "com.pyxis.greenhopper.jira:gh-sprint"
+
+// maxAllowedValues is the number of allowed values listed in the messages.
+const maxAllowedValues = 10
+
+// objectTypes are the schema types whose values are objects, e.g. {"value": "Platform"} or {"accountId": "..."}.
+var objectTypes = []string{"option", "option-with-child", "user", "group", "version", "component", "priority",
+	"resolution", "securitylevel", "issuetype", "project", "issuelink"}
+
+// dateTimeLayouts are the formats accepted by the datetime fields.
+var dateTimeLayouts = []string{"2006-01-02T15:04:05.000-0700", time.RFC3339Nano, "2006-01-02T15:04:05-0700"}
+
+// ValidationError is a field of an issue payload rejected by the metadata.
+type ValidationError struct {
+	Field   string // The ID of the field, e.g. customfield_10010.
+	Name    string // The name of the field, empty when it is not in the metadata.
+	Message string // The violated rule.
+}
+
+func (e *ValidationError) Error() string {
+
+	if e.Name == "" {
+		return fmt.Sprintf("issuemeta: %v: %v", e.Field, e.Message)
+	}
+
+	return fmt.Sprintf("issuemeta: %v (%v): %v", e.Field, e.Name, e.Message)
+}
+
+// Unwrap returns models.ErrInvalidIssuePayload, so every violation matches it with errors.Is.
+func (e *ValidationError) Unwrap() error {
+	return model.ErrInvalidIssuePayload
+}
+
+// Payload is an issue payload of the v3 or the v2 client, *models.IssueScheme or *models.IssueSchemeV2.
+type Payload interface {
+	ToMap() (map[string]interface{}, error)
+	MergeCustomFields(fields *model.CustomFields) (map[string]interface{}, error)
+}
+
+// Validate checks the payload of Issue.Create and its custom fields against the create metadata of its project and
+// issue type: the required fields without default value are set, the fields are on the create screen, and the
+// values have the type of their field and are among its allowed values.
+// All the violations are returned, joined, each one is a *ValidationError matching models.ErrInvalidIssuePayload.
+func (r *Registry) Validate(ctx context.Context, payload Payload, customFields *model.CustomFields) error {
+
+	fields, err := fieldsOf(payload, customFields)
+	if err != nil {
+		return err
+	}
+
+	project := identifier(fields["project"], "key", "id")
+	if project == "" {
+		return &ValidationError{Field: "project", Message: "the field is required"}
+	}
+
+	issueType := identifier(fields["issuetype"], "id", "name")
+	if issueType == "" {
+		return &ValidationError{Field: "issuetype", Message: "the field is required"}
+	}
+
+	schema, err := r.CreateSchema(ctx, project, issueType)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(fields, nil)
+}
+
+// ValidateUpdate checks the payload of Issue.Update, its custom fields and its operations against the edit metadata
+// of the issue, like Validate. The required fields are only checked when they are cleared, and the operations
+// must be allowed by their field.
+func (r *Registry) ValidateUpdate(ctx context.Context, issueKeyOrID string, payload Payload, customFields *model.CustomFields,
+	operations *model.UpdateOperations) error {
+
+	fields, err := fieldsOf(payload, customFields)
+	if err != nil {
+		return err
+	}
+
+	schema, err := r.EditSchema(ctx, issueKeyOrID)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(fields, operations)
+}
+
+// fieldsOf returns the fields of the payload merged with the custom fields, as sent to Jira.
+func fieldsOf(payload Payload, customFields *model.CustomFields) (map[string]interface{}, error) {
+
+	if payload == nil {
+		payload = &model.IssueScheme{}
+	}
+
+	merged, err := payload.ToMap()
+	if customFields != nil && len(customFields.Fields) != 0 {
+		merged, err = payload.MergeCustomFields(customFields)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The custom fields are merged as they were set, e.g. []string or int, they are converted to JSON values.
+	encoded, err := json.Marshal(merged["fields"])
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// identifier returns the first of the keys set in the object, e.g. the key or the ID of the project.
+func identifier(value interface{}, keys ...string) string {
+
+	object, _ := value.(map[string]interface{})
+
+	for _, key := range keys {
+		if text, ok := object[key].(string); ok && text != "" {
+			return text
+		}
+	}
+
+	return ""
+}
+
+// Validate checks the fields, as sent in the fields of a payload, and the update operations against the schema.
+func (s *Schema) Validate(fields map[string]interface{}, operations *model.UpdateOperations) error {
+
+	var errs []error
+
+	for _, id := range slices.Sorted(maps.Keys(fields)) {
+
+		field, ok := s.Fields[id]
+		if !ok {
+			errs = append(errs, &ValidationError{Field: id, Message: s.missingMessage()})
+			continue
+		}
+
+		errs = append(errs, field.validate(fields[id])...)
+	}
+
+	updates, err := updatesOf(operations)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(updates)) {
+
+		field, ok := s.Fields[id]
+		if !ok {
+			errs = append(errs, &ValidationError{Field: id, Message: s.missingMessage()})
+			continue
+		}
+
+		if _, ok := fields[id]; ok {
+			errs = append(errs, &ValidationError{Field: id, Name: field.Name, Message: "the field is set in both fields and update"})
+			continue
+		}
+
+		errs = append(errs, field.validateOperations(updates[id])...)
+	}
+
+	if !s.edit {
+		for _, id := range slices.Sorted(maps.Keys(s.Fields)) {
+
+			field := s.Fields[id]
+
+			if _, ok := fields[id]; !ok && field.Required && !field.HasDefaultValue {
+				errs = append(errs, &ValidationError{Field: id, Name: field.Name, Message: "the field is required"})
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Schema) missingMessage() string {
+
+	if s.edit {
+		return "the field is not on the edit screen of the issue"
+	}
+
+	return "the field is not on the create screen of the project and issue type"
+}
+
+// updatesOf returns the operations by field, they are converted to JSON values like the fields.
+func updatesOf(operations *model.UpdateOperations) (map[string][]map[string]interface{}, error) {
+
+	updates := make(map[string][]map[string]interface{})
+
+	if operations == nil {
+		return updates, nil
+	}
+
+	encoded, err := json.Marshal(operations.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []struct {
+		Update map[string][]map[string]interface{} `json:"update"`
+	}
+
+	if err := json.Unmarshal(encoded, &nodes); err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		for id, fieldOperations := range node.Update {
+			updates[id] = append(updates[id], fieldOperations...)
+		}
+	}
+
+	return updates, nil
+}
+
+// validate checks the value set to the field.
+func (f *Field) validate(value interface{}) []error {
+
+	if isEmpty(value) {
+
+		if f.Required {
+			return []error{f.errorf("the field is required")}
+		}
+
+		return nil
+	}
+
+	if f.Schema == nil {
+		return nil
+	}
+
+	if f.Schema.Type != "array" || f.Schema.Custom == sprintType {
+		return f.validateItem(value)
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return []error{f.errorf("the field takes an array, got %v", kindOf(value))}
+	}
+
+	var errs []error
+	for _, item := range items {
+		errs = append(errs, f.validateItem(item)...)
+	}
+
+	return errs
+}
+
+// validateOperations checks the update operations of the field, e.g. [{"add": "backend"}].
+func (f *Field) validateOperations(operations []map[string]interface{}) []error {
+
+	var errs []error
+
+	for _, operation := range operations {
+		for _, name := range slices.Sorted(maps.Keys(operation)) {
+
+			if len(f.Operations) != 0 && !slices.Contains(f.Operations, name) {
+				errs = append(errs, f.errorf("the operation %v is not allowed, the field allows %v", name, strings.Join(f.Operations, ", ")))
+				continue
+			}
+
+			switch {
+			case name == "set":
+				errs = append(errs, f.validate(operation[name])...)
+			case (name == "add" || name == "remove") && f.Schema != nil && f.Schema.Type == "array":
+				errs = append(errs, f.validateItem(operation[name])...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateItem checks a value of the field, or an item of an array field, against its schema type.
+func (f *Field) validateItem(value interface{}) []error {
+
+	kind := f.Schema.Type
+	switch {
+	case f.Schema.Custom == sprintType:
+		kind = "number"
+	case kind == "array":
+		kind = f.Schema.Items
+	}
+
+	switch kind {
+	case "string":
+		// The rich text fields of the v3 client take an ADF document.
+		if object, ok := value.(map[string]interface{}); ok && object["type"] == "doc" {
+			return nil
+		}
+
+		if _, ok := value.(string); !ok {
+			return []error{f.errorf("the field takes a string, got %v", kindOf(value))}
+		}
+
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return []error{f.errorf("the field takes a number, got %v", kindOf(value))}
+		}
+
+	case "date", "datetime":
+
+		text, ok := value.(string)
+		if !ok {
+			return []error{f.errorf("the field takes a %v string, got %v", kind, kindOf(value))}
+		}
+
+		if !isDate(kind, text) {
+			return []error{f.errorf("%q is not a %v, e.g. %v", text, kind, map[string]string{"date": "2024-03-01", "datetime": "2024-03-01T10:30:00.000+0000"}[kind])}
+		}
+
+	default:
+
+		if !slices.Contains(objectTypes, kind) {
+			return nil
+		}
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{f.errorf("the field takes an object, got %v", kindOf(value))}
+		}
+
+		return f.validateAllowed(object)
+	}
+
+	return nil
+}
+
+// validateAllowed checks the object against the allowed values of the field, and its child for the cascading lists.
+func (f *Field) validateAllowed(object map[string]interface{}) []error {
+
+	allowed, checked := match(f.AllowedValues, object)
+	if !checked {
+		return nil
+	}
+
+	if allowed == nil {
+		return []error{f.errorf("%v is not an allowed value, the allowed values are %v", describe(object), labels(f.AllowedValues))}
+	}
+
+	child, ok := object["child"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if found, checked := match(allowed.Children, child); checked && found == nil {
+		return []error{f.errorf("%v is not an allowed child of %v, the allowed values are %v", describe(child), describe(object), labels(allowed.Children))}
+	}
+
+	return nil
+}
+
+func (f *Field) errorf(format string, arguments ...interface{}) error {
+	return &ValidationError{Field: f.ID, Name: f.Name, Message: fmt.Sprintf(format, arguments...)}
+}
+
+// match returns the allowed value identified by the object, it is not checked when the field has no allowed values
+// or the object has no identifier, e.g. a user.
+func match(values []*AllowedValue, object map[string]interface{}) (*AllowedValue, bool) {
+
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	checked := false
+
+	for _, key := range []string{"id", "value", "name", "key"} {
+
+		text, ok := object[key].(string)
+		if !ok {
+			continue
+		}
+
+		checked = true
+
+		for _, value := range values {
+
+			candidate := map[string]string{"id": value.ID, "value": value.Value, "name": value.Name, "key": value.Key}[key]
+			if candidate == text {
+				return value, true
+			}
+		}
+	}
+
+	return nil, checked
+}
+
+// describe returns the identifier of the object shown in the messages.
+func describe(object map[string]interface{}) string {
+
+	for _, key := range []string{"value", "name", "key"} {
+		if text, ok := object[key].(string); ok {
+			return fmt.Sprintf("%q", text)
+		}
+	}
+
+	return fmt.Sprintf("the ID %v", object["id"])
+}
+
+// labels returns the first allowed values, joined.
+func labels(values []*AllowedValue) string {
+
+	var texts []string
+	for _, value := range values {
+
+		if len(texts) == maxAllowedValues {
+			texts = append(texts, "…")
+			break
+		}
+
+		texts = append(texts, value.label())
+	}
+
+	return strings.Join(texts, ", ")
+}
+
+func isEmpty(value interface{}) bool {
+
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+func isDate(kind, text string) bool {
+
+	if kind == "date" {
+		_, err := time.Parse("2006-01-02", text)
+		return err == nil
+	}
+
+	for _, layout := range dateTimeLayouts {
+		if _, err := time.Parse(layout, text); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kindOf returns the JSON kind of the value shown in the messages.
+func kindOf(value interface{}) string {
+
+	switch value.(type) {
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	}
+
+	return "null"
+}
@@ -0,0 +1,187 @@
+package issuemeta
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// newPayload returns a valid payload of the create metadata of the fake connector.
+func newPayload() (*model.IssueScheme, *model.CustomFields) {
+
+	payload := &model.IssueScheme{Fields: &model.IssueFieldsScheme{
+		Project:   &model.ProjectScheme{Key: "KP"},
+		IssueType: &model.IssueTypeScheme{Name: "Bug"},
+		Summary:   "Login fails",
+	}}
+
+	customFields := new(model.CustomFields)
+	_ = customFields.Select("customfield_10010", "Platform")
+
+	return payload, customFields
+}
+
+func TestRegistry_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		prepare func(payload *model.IssueScheme, customFields *model.CustomFields)
+		wantErr string
+	}{
+		{
+			name:    "when the payload is valid",
+			prepare: func(*model.IssueScheme, *model.CustomFields) {},
+		},
+		{
+			name: "when the values are set",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields) {
+				_ = customFields.Number("customfield_10016", 3)
+				_ = customFields.Cascading("customfield_10033", "EMEA", "Spain")
+				payload.Fields.Project = &model.ProjectScheme{ID: "10000"}
+			},
+		},
+		{
+			name: "when the required fields are missing",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields) {
+				payload.Fields.Summary = ""
+				customFields.Fields = nil
+			},
+			wantErr: "issuemeta: customfield_10010 (Team): the field is required\n" +
+				"issuemeta: summary (Summary): the field is required",
+		},
+		{
+			name: "when the fields are not on the create screen",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields) {
+				payload.Fields.Labels = []string{"backend"}
+				_ = customFields.Text("customfield_10099", "unknown")
+			},
+			wantErr: "issuemeta: customfield_10099: the field is not on the create screen of the project and issue type\n" +
+				"issuemeta: labels: the field is not on the create screen of the project and issue type",
+		},
+		{
+			name: "when the options are not allowed",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields) {
+				customFields.Fields = nil
+				_ = customFields.Select("customfield_10010", "Mobile")
+				_ = customFields.Cascading("customfield_10033", "EMEA", "France")
+			},
+			wantErr: `issuemeta: customfield_10010 (Team): "Mobile" is not an allowed value, the allowed values are Platform, API` + "\n" +
+				`issuemeta: customfield_10033 (Region): "France" is not an allowed child of "EMEA", the allowed values are Spain`,
+		},
+		{
+			name: "when the values have the wrong type",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields) {
+				_ = customFields.Text("customfield_10016", "three")
+				_ = customFields.Raw("customfield_10033", []string{"EMEA"})
+			},
+			wantErr: "issuemeta: customfield_10016 (Story Points): the field takes a number, got a string\n" +
+				"issuemeta: customfield_10033 (Region): the field takes an object, got an array",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			payload, customFields := newPayload()
+			testCase.prepare(payload, customFields)
+
+			err := NewRegistry(newFakeMetadataConnector()).Validate(context.Background(), payload, customFields)
+
+			if testCase.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, testCase.wantErr)
+			assert.ErrorIs(t, err, model.ErrInvalidIssuePayload)
+		})
+	}
+}
+
+func TestRegistry_Validate_WithoutProject(t *testing.T) {
+
+	err := NewRegistry(newFakeMetadataConnector()).Validate(context.Background(), &model.IssueSchemeV2{Fields: &model.IssueFieldsSchemeV2{Summary: "Login fails"}}, nil)
+
+	var validationError *ValidationError
+	require.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "project", validationError.Field)
+}
+
+func TestRegistry_ValidateUpdate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		prepare func(payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations)
+		wantErr string
+	}{
+		{
+			name: "when the update is valid",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) {
+				payload.Fields.Summary = "Login fails on Safari"
+				_ = customFields.Raw("customfield_10020", 42)
+				_ = operations.AddArrayOperation("labels", map[string]string{"backend": "add"})
+				_ = operations.AddMultiRawOperation("customfield_10010", []map[string]interface{}{{"set": map[string]interface{}{"id": "10101"}}})
+			},
+		},
+		{
+			name: "when a required field is cleared and a field is not editable",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) {
+				_ = operations.AddMultiRawOperation("summary", []map[string]interface{}{{"set": ""}})
+				_ = operations.AddStringOperation("priority", "set", "High")
+			},
+			wantErr: "issuemeta: priority: the field is not on the edit screen of the issue\n" +
+				"issuemeta: summary (Summary): the field is required",
+		},
+		{
+			name: "when the operations are not allowed",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) {
+				_ = operations.AddStringOperation("customfield_10010", "add", "API")
+				_ = operations.AddMultiRawOperation("labels", []map[string]interface{}{{"add": 3}})
+			},
+			wantErr: "issuemeta: customfield_10010 (Team): the operation add is not allowed, the field allows set\n" +
+				"issuemeta: labels (Labels): the field takes a string, got a number",
+		},
+		{
+			name: "when a field is set in both fields and update",
+			prepare: func(payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) {
+				_ = customFields.Select("customfield_10010", "API")
+				_ = operations.AddMultiRawOperation("customfield_10010", []map[string]interface{}{{"set": map[string]interface{}{"value": "API"}}})
+			},
+			wantErr: "issuemeta: customfield_10010 (Team): the field is set in both fields and update",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			payload, customFields, operations := &model.IssueScheme{Fields: &model.IssueFieldsScheme{}}, new(model.CustomFields), new(model.UpdateOperations)
+			testCase.prepare(payload, customFields, operations)
+
+			err := NewRegistry(newFakeMetadataConnector()).ValidateUpdate(context.Background(), "KP-1", payload, customFields, operations)
+
+			if testCase.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, testCase.wantErr)
+			assert.ErrorIs(t, err, model.ErrInvalidIssuePayload)
+		})
+	}
+}
+
+func TestLabels(t *testing.T) {
+
+	var values []*AllowedValue
+	for _, name := range strings.Split("1.0 1.1 1.2 1.3 1.4 1.5 1.6 1.7 1.8 1.9 2.0", " ") {
+		values = append(values, &AllowedValue{Name: name})
+	}
+
+	assert.Equal(t, "1.0, 1.1, 1.2, 1.3, 1.4, 1.5, 1.6, 1.7, 1.8, 1.9, …", labels(values))
+}
@@ -0,0 +1,362 @@
+package jql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Operator is the operator of a condition, e.g. = or WAS.
+type Operator string
+
+const (
+	Equals           = Operator("=")
+	NotEquals        = Operator("!=")
+	GreaterThan      = Operator(">")
+	GreaterThanEqual = Operator(">=")
+	LessThan         = Operator("<")
+	LessThanEqual    = Operator("<=")
+	In               = Operator("IN")
+	NotIn            = Operator("NOT IN")
+	Contains         = Operator("~")
+	NotContains      = Operator("!~")
+	Is               = Operator("IS")
+	IsNot            = Operator("IS NOT")
+	Was              = Operator("WAS")
+	WasNot           = Operator("WAS NOT")
+	WasIn            = Operator("WAS IN")
+	WasNotIn         = Operator("WAS NOT IN")
+	Changed          = Operator("CHANGED")
+)
+
+// PredicateOperator is the operator of a predicate of the history operators, e.g. BY or DURING.
+type PredicateOperator string
+
+const (
+	PredicateAfter  = PredicateOperator("AFTER")
+	PredicateBefore = PredicateOperator("BEFORE")
+	PredicateBy     = PredicateOperator("BY")
+	PredicateDuring = PredicateOperator("DURING")
+	PredicateOn     = PredicateOperator("ON")
+	PredicateFrom   = PredicateOperator("FROM")
+	PredicateTo     = PredicateOperator("TO")
+)
+
+// Clause is a condition of a query, or a composition of conditions with AND, OR and NOT.
+type Clause interface {
+	String() string
+	clause()
+}
+
+// Field is a field referenced by a condition or an ORDER BY, e.g. Field("assignee") or Field("Story Points").
+// The custom fields are better referenced by their ID with CustomField, their names are not unique.
+type Field string
+
+// CustomField returns the reference of a custom field by its ID, e.g. cf[10010].
+func CustomField(id int) Field {
+	return Field("cf[" + strconv.Itoa(id) + "]")
+}
+
+func (f Field) String() string {
+
+	// The bracketed references are not quoted, e.g. cf[10010] or issue.property[support].level.
+	if referencePattern.MatchString(string(f)) {
+		return string(f)
+	}
+
+	return quote(string(f))
+}
+
+// Eq returns the condition field = value, see Field.In for the accepted values.
+func (f Field) Eq(value interface{}) *Condition {
+	return f.condition(Equals, operand(value))
+}
+
+// NotEq returns the condition field != value.
+func (f Field) NotEq(value interface{}) *Condition {
+	return f.condition(NotEquals, operand(value))
+}
+
+// Gt returns the condition field > value.
+func (f Field) Gt(value interface{}) *Condition {
+	return f.condition(GreaterThan, operand(value))
+}
+
+// Gte returns the condition field >= value.
+func (f Field) Gte(value interface{}) *Condition {
+	return f.condition(GreaterThanEqual, operand(value))
+}
+
+// Lt returns the condition field < value.
+func (f Field) Lt(value interface{}) *Condition {
+	return f.condition(LessThan, operand(value))
+}
+
+// Lte returns the condition field <= value.
+func (f Field) Lte(value interface{}) *Condition {
+	return f.condition(LessThanEqual, operand(value))
+}
+
+// In returns the condition field IN (values). The values are strings, numbers, times, formatted as dates,
+// or operands, e.g. a Function. A single function is not wrapped in a list, e.g. sprint IN openSprints().
+// At least one value is required, Query.Validate rejects the empty lists.
+func (f Field) In(values ...interface{}) *Condition {
+	return f.condition(In, list(values))
+}
+
+// NotIn returns the condition field NOT IN (values), at least one value is required.
+func (f Field) NotIn(values ...interface{}) *Condition {
+	return f.condition(NotIn, list(values))
+}
+
+// Contains returns the text search field ~ value, e.g. summary ~ "login".
+func (f Field) Contains(value interface{}) *Condition {
+	return f.condition(Contains, operand(value))
+}
+
+// NotContains returns the text search field !~ value.
+func (f Field) NotContains(value interface{}) *Condition {
+	return f.condition(NotContains, operand(value))
+}
+
+// IsEmpty returns the condition field IS EMPTY.
+func (f Field) IsEmpty() *Condition {
+	return f.condition(Is, Empty)
+}
+
+// IsNotEmpty returns the condition field IS NOT EMPTY.
+func (f Field) IsNotEmpty() *Condition {
+	return f.condition(IsNot, Empty)
+}
+
+// Was returns the history condition field WAS value, it accepts the predicates, e.g. By or During.
+func (f Field) Was(value interface{}) *Condition {
+	return f.condition(Was, operand(value))
+}
+
+// WasNot returns the history condition field WAS NOT value.
+func (f Field) WasNot(value interface{}) *Condition {
+	return f.condition(WasNot, operand(value))
+}
+
+// WasIn returns the history condition field WAS IN (values), at least one value is required.
+func (f Field) WasIn(values ...interface{}) *Condition {
+	return f.condition(WasIn, list(values))
+}
+
+// WasNotIn returns the history condition field WAS NOT IN (values), at least one value is required.
+func (f Field) WasNotIn(values ...interface{}) *Condition {
+	return f.condition(WasNotIn, list(values))
+}
+
+// Changed returns the history condition field CHANGED, it accepts the predicates, e.g. From or After.
+func (f Field) Changed() *Condition {
+	return f.condition(Changed, nil)
+}
+
+func (f Field) condition(operator Operator, operand Operand) *Condition {
+	return &Condition{Field: f, Operator: operator, Operand: operand}
+}
+
+func list(values []interface{}) Operand {
+
+	if len(values) == 1 {
+		if function, ok := values[0].(*Function); ok {
+			return function
+		}
+	}
+
+	var operands List
+	for _, value := range values {
+
+		// The slices are flattened, e.g. In([]string{"KP", "OPS"}).
+		if nested, ok := operand(value).(List); ok {
+			operands = append(operands, nested...)
+			continue
+		}
+
+		operands = append(operands, operand(value))
+	}
+
+	return operands
+}
+
+// Predicate narrows a history condition, e.g. BY currentUser() or DURING ("2024-01-01", "2024-02-01").
+type Predicate struct {
+	Operator PredicateOperator // The operator of the predicate.
+	Operand  Operand           // The value of the predicate, a list of two dates for DURING.
+}
+
+func (p *Predicate) String() string {
+	return string(p.Operator) + " " + p.Operand.String()
+}
+
+// Condition compares a field with an operand, e.g. project = KP, the history conditions have predicates.
+type Condition struct {
+	Field      Field        // The field of the condition.
+	Operator   Operator     // The operator of the condition.
+	Operand    Operand      // The value of the condition, nil for CHANGED.
+	Predicates []*Predicate // The predicates of the WAS and CHANGED operators.
+}
+
+func (c *Condition) clause() {}
+
+func (c *Condition) String() string {
+
+	parts := []string{c.Field.String(), string(c.Operator)}
+
+	if c.Operand != nil {
+		parts = append(parts, c.Operand.String())
+	}
+
+	for _, predicate := range c.Predicates {
+		parts = append(parts, predicate.String())
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// After adds the predicate AFTER date.
+func (c *Condition) After(date interface{}) *Condition {
+	return c.predicate(PredicateAfter, operand(date))
+}
+
+// Before adds the predicate BEFORE date.
+func (c *Condition) Before(date interface{}) *Condition {
+	return c.predicate(PredicateBefore, operand(date))
+}
+
+// On adds the predicate ON date.
+func (c *Condition) On(date interface{}) *Condition {
+	return c.predicate(PredicateOn, operand(date))
+}
+
+// During adds the predicate DURING (from, to).
+func (c *Condition) During(from, to interface{}) *Condition {
+	return c.predicate(PredicateDuring, List{operand(from), operand(to)})
+}
+
+// By adds the predicate BY user, the user is an account ID or a function, e.g. CurrentUser().
+func (c *Condition) By(user interface{}) *Condition {
+	return c.predicate(PredicateBy, operand(user))
+}
+
+// From adds the predicate FROM value of the CHANGED operator.
+func (c *Condition) From(value interface{}) *Condition {
+	return c.predicate(PredicateFrom, operand(value))
+}
+
+// To adds the predicate TO value of the CHANGED operator.
+func (c *Condition) To(value interface{}) *Condition {
+	return c.predicate(PredicateTo, operand(value))
+}
+
+func (c *Condition) predicate(operator PredicateOperator, operand Operand) *Condition {
+	c.Predicates = append(c.Predicates, &Predicate{Operator: operator, Operand: operand})
+	return c
+}
+
+// Compound joins clauses with AND or OR.
+type Compound struct {
+	Operator string   // AND or OR.
+	Clauses  []Clause // The joined clauses.
+}
+
+func (c *Compound) clause() {}
+
+func (c *Compound) String() string {
+
+	parts := make([]string, 0, len(c.Clauses))
+
+	for _, clause := range c.Clauses {
+
+		text := clause.String()
+
+		// The nested compounds with the other operator are wrapped in parentheses, AND takes precedence over OR.
+		if nested, ok := clause.(*Compound); ok && nested.Operator != c.Operator && len(nested.Clauses) > 1 {
+			text = "(" + text + ")"
+		}
+
+		parts = append(parts, text)
+	}
+
+	return strings.Join(parts, " "+c.Operator+" ")
+}
+
+// And joins the clauses with AND, the nil clauses are skipped and a single clause is returned as is.
+func And(clauses ...Clause) Clause {
+	return compound("AND", clauses)
+}
+
+// Or joins the clauses with OR, the nil clauses are skipped and a single clause is returned as is.
+func Or(clauses ...Clause) Clause {
+	return compound("OR", clauses)
+}
+
+func compound(operator string, clauses []Clause) Clause {
+
+	var kept []Clause
+	for _, clause := range clauses {
+
+		// The nested compounds with the same operator are flattened, e.g. a AND (b AND c) is a AND b AND c.
+		if nested, ok := clause.(*Compound); ok && nested != nil && nested.Operator == operator {
+			kept = append(kept, nested.Clauses...)
+			continue
+		}
+
+		if !isNil(clause) {
+			kept = append(kept, clause)
+		}
+	}
+
+	switch len(kept) {
+	case 0:
+		return nil
+	case 1:
+		return kept[0]
+	}
+
+	return &Compound{Operator: operator, Clauses: kept}
+}
+
+// isNil reports if the clause is nil, including a nil pointer stored in the interface.
+func isNil(clause Clause) bool {
+
+	switch clause := clause.(type) {
+	case nil:
+		return true
+	case *Condition:
+		return clause == nil
+	case *Compound:
+		return clause == nil
+	case *Negation:
+		return clause == nil
+	}
+
+	return false
+}
+
+// Negation negates a clause with NOT.
+type Negation struct {
+	Clause Clause // The negated clause.
+}
+
+func (n *Negation) clause() {}
+
+func (n *Negation) String() string {
+
+	if compound, ok := n.Clause.(*Compound); ok && len(compound.Clauses) > 1 {
+		return "NOT (" + compound.String() + ")"
+	}
+
+	return "NOT " + n.Clause.String()
+}
+
+// Not negates the clause, e.g. NOT (status = Done OR resolution IS NOT EMPTY).
+func Not(clause Clause) Clause {
+
+	if isNil(clause) {
+		return nil
+	}
+
+	return &Negation{Clause: clause}
+}
@@ -0,0 +1,129 @@
+// Package jql builds Jira Query Language queries, quoting the values and the field names which need it.
+//
+// The conditions are built from the fields, composed with And, Or and Not, and wrapped in a Query:
+//
+//	query := jql.Where(jql.And(
+//		jql.Field("project").In("KP", "OPS"),
+//		jql.Field("summary").Contains(`can't "login"`),
+//		jql.CustomField(10010).Eq("Platform"),
+//		jql.Field("assignee").Eq(jql.CurrentUser()),
+//		jql.Field("status").Was("Done").By(jql.CurrentUser()).During("2024-01-01", "2024-02-01"),
+//	)).OrderBy("created", jql.Desc)
+//
+//	issues, _, err := client.Issue.Search.GetByJQLSearch(ctx, query.String(), "summary", false, "")
+//
+// The queries can be checked by Jira before they are used with Query.Validate.
+package jql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// Direction is the direction of an ORDER BY field.
+type Direction string
+
+const (
+	Asc  = Direction("ASC")
+	Desc = Direction("DESC")
+)
+
+// OrderField is a field of the ORDER BY clause, the direction is optional.
+type OrderField struct {
+	Field     Field     // The sorted field.
+	Direction Direction // The direction, the default direction of the field when empty.
+}
+
+func (o *OrderField) String() string {
+
+	if o.Direction == "" {
+		return o.Field.String()
+	}
+
+	return o.Field.String() + " " + string(o.Direction)
+}
+
+// Query is a JQL query, its clause and its ORDER BY fields.
+type Query struct {
+	Where Clause        // The clause of the query, nil for all the issues.
+	Order []*OrderField // The ORDER BY fields.
+}
+
+// Where returns the query of the clause.
+func Where(clause Clause) *Query {
+	return &Query{Where: clause}
+}
+
+// OrderBy adds a field to the ORDER BY clause.
+func (q *Query) OrderBy(field Field, direction Direction) *Query {
+	q.Order = append(q.Order, &OrderField{Field: field, Direction: direction})
+	return q
+}
+
+// String returns the JQL of the query.
+func (q *Query) String() string {
+
+	var parts []string
+
+	if !isNil(q.Where) {
+		parts = append(parts, q.Where.String())
+	}
+
+	if len(q.Order) != 0 {
+
+		fields := make([]string, 0, len(q.Order))
+		for _, field := range q.Order {
+			fields = append(fields, field.String())
+		}
+
+		parts = append(parts, "ORDER BY "+strings.Join(fields, ", "))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Validate parses the query with the Jira JQL parser, e.g. client.JQL, in strict mode.
+// The errors reported by Jira, e.g. an unknown field or value, are returned wrapping models.ErrInvalidJQL.
+// The conditions with an empty list of values, e.g. Field("project").In(), are rejected before Jira is called.
+//
+// POST /rest/api/{2-3}/jql/parse
+func (q *Query) Validate(ctx context.Context, parser jira.JQLConnector) error {
+
+	if condition := emptyList(q.Where); condition != nil {
+		return fmt.Errorf("%w: %v: the list of values is empty", model.ErrInvalidJQL, condition)
+	}
+
+	page, _, err := parser.Parse(ctx, "strict", []string{q.String()})
+	if err != nil {
+		return err
+	}
+
+	for _, query := range page.Queries {
+		if len(query.Errors) != 0 {
+			return fmt.Errorf("%w: %v", model.ErrInvalidJQL, strings.Join(query.Errors, "; "))
+		}
+	}
+
+	return nil
+}
+
+// emptyList returns the first condition of the clause whose operand is an empty list, nil when there is none.
+func emptyList(clause Clause) (found *Condition) {
+
+	Walk(clause, func(clause Clause) bool {
+
+		if condition, ok := clause.(*Condition); ok && found == nil {
+			if list, ok := condition.Operand.(List); ok && len(list) == 0 {
+				found = condition
+			}
+		}
+
+		return found == nil
+	})
+
+	return found
+}
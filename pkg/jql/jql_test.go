@@ -0,0 +1,115 @@
+package jql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+func TestQuery_String(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		query *Query
+		want  string
+	}{
+		{
+			name:  "when the values need quoting",
+			query: Where(And(Field("project").Eq("KP"), Field("summary").Contains(`can't "login" \ now`), Field("labels").In("and", "ops team", 42))),
+			want:  `project = KP AND summary ~ "can't \"login\" \\ now" AND labels IN ("and", "ops team", 42)`,
+		},
+		{
+			name:  "when the fields are custom or quoted",
+			query: Where(And(CustomField(10010).Eq("Platform"), Field("Story Points").Gte(3.5), Field("order").IsNotEmpty())),
+			want:  `cf[10010] = Platform AND "Story Points" >= "3.5" AND "order" IS NOT EMPTY`,
+		},
+		{
+			name:  "when the operands are functions",
+			query: Where(And(Field("assignee").Eq(CurrentUser()), Field("sprint").In(OpenSprints()), Field("reporter").In(MembersOf("jira-software users")), Field("created").Gt(StartOfWeek("-1w")))),
+			want:  `assignee = currentUser() AND sprint IN openSprints() AND reporter IN membersOf("jira-software users") AND created > startOfWeek(-1w)`,
+		},
+		{
+			name: "when the clauses are nested and negated",
+			query: Where(Or(
+				And(Field("project").Eq("KP"), Or(Field("priority").Eq("High"), Field("priority").Eq("Highest"))),
+				Not(Or(Field("status").Eq("Done"), nil, Field("resolution").IsNotEmpty())),
+				Not(Field("labels").NotIn([]string{"a", "b"})),
+			)),
+			want: `(project = KP AND (priority = High OR priority = Highest)) OR NOT (status = Done OR resolution IS NOT EMPTY) OR NOT labels NOT IN ("a", b)`,
+		},
+		{
+			name: "when the conditions have history predicates",
+			query: Where(And(
+				Field("status").Was("In Progress").By(CurrentUser()).During(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), "2024-02-01"),
+				Field("assignee").Changed().From("5b10ac8d82e05b22cc7d4ef5").After(time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)),
+				Field("status").WasNotIn("Done", "Closed"),
+			)),
+			want: `status WAS "In Progress" BY currentUser() DURING (2024-01-01, 2024-02-01) AND ` +
+				`assignee CHANGED FROM 5b10ac8d82e05b22cc7d4ef5 AFTER "2024-03-01 09:30" AND status WAS NOT IN (Done, Closed)`,
+		},
+		{
+			name:  "when the query is ordered",
+			query: Where(Field("project").Eq("KP")).OrderBy("created", Desc).OrderBy("Story Points", ""),
+			want:  `project = KP ORDER BY created DESC, "Story Points"`,
+		},
+		{
+			name:  "when the query has no clause",
+			query: Where(And()).OrderBy("key", Asc),
+			want:  `ORDER BY key ASC`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.query.String())
+		})
+	}
+}
+
+type fakeJQLConnector struct {
+	jira.JQLConnector
+	errors []string
+	err    error
+	query  string
+}
+
+func (f *fakeJQLConnector) Parse(_ context.Context, validationType string, queries []string) (*model.ParsedQueryPageScheme, *model.ResponseScheme, error) {
+
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+
+	f.query = validationType + ":" + queries[0]
+
+	return &model.ParsedQueryPageScheme{Queries: []*model.ParseQueryScheme{{Query: queries[0], Errors: f.errors}}}, nil, nil
+}
+
+func TestQuery_Validate(t *testing.T) {
+
+	query := Where(Field("project").Eq("KP"))
+
+	connector := &fakeJQLConnector{}
+	assert.NoError(t, query.Validate(context.Background(), connector))
+	assert.Equal(t, "strict:project = KP", connector.query)
+
+	connector = &fakeJQLConnector{errors: []string{"The value 'KP' does not exist for the field 'project'."}}
+	err := query.Validate(context.Background(), connector)
+	assert.ErrorIs(t, err, model.ErrInvalidJQL)
+	assert.ErrorContains(t, err, "does not exist")
+
+	connector = &fakeJQLConnector{err: errors.New("unauthorized")}
+	assert.EqualError(t, query.Validate(context.Background(), connector), "unauthorized")
+
+	// The empty lists are rejected without calling Jira.
+	connector = &fakeJQLConnector{}
+	err = Where(And(Field("project").Eq("KP"), Not(Field("status").WasIn()))).Validate(context.Background(), connector)
+	assert.ErrorIs(t, err, model.ErrInvalidJQL)
+	assert.EqualError(t, err, "jql: invalid query: status WAS IN (): the list of values is empty")
+	assert.Empty(t, connector.query)
+}
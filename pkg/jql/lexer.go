@@ -0,0 +1,189 @@
+package jql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SyntaxError is an error of a query parsed by Parse.
+type SyntaxError struct {
+	Position int    // The byte offset of the error in the query.
+	Message  string // The expected token, e.g. expected an operator.
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("jql: %v at position %v", e.Message, e.Position)
+}
+
+// Unwrap returns models.ErrInvalidJQL, so the syntax errors match it with errors.Is.
+func (e *SyntaxError) Unwrap() error {
+	return model.ErrInvalidJQL
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+type token struct {
+	kind     tokenKind
+	text     string // The word, the unquoted string or the operator.
+	position int
+}
+
+// is reports if the token is the unquoted keyword, the keywords are case-insensitive.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) describe() string {
+
+	switch t.kind {
+	case tokenEOF:
+		return "the end of the query"
+	case tokenString:
+		return strconv.Quote(t.text)
+	}
+
+	return "'" + t.text + "'"
+}
+
+// operators are the symbols of the operators, from the longest, && and || are AND and OR, ! is NOT.
+var operators = []string{"!=", "!~", ">=", "<=", "&&", "||", "=", "~", ">", "<", "!", "&", "|"}
+
+// tokenize splits the query in words, quoted strings, operators, parentheses and commas.
+func tokenize(source string) ([]token, error) {
+
+	var tokens []token
+
+	for index := 0; index < len(source); {
+
+		char, size := utf8.DecodeRuneInString(source[index:])
+
+		switch {
+		case unicode.IsSpace(char):
+			index += size
+
+		case char == '(':
+			tokens = append(tokens, token{kind: tokenOpen, text: "(", position: index})
+			index++
+
+		case char == ')':
+			tokens = append(tokens, token{kind: tokenClose, text: ")", position: index})
+			index++
+
+		case char == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", position: index})
+			index++
+
+		case char == '"' || char == '\'':
+
+			text, end, err := unquote(source, index)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: tokenString, text: text, position: index})
+			index = end
+
+		case strings.ContainsRune("!=~<>&|", char):
+
+			for _, operator := range operators {
+				if strings.HasPrefix(source[index:], operator) {
+					tokens = append(tokens, token{kind: tokenOperator, text: operator, position: index})
+					index += len(operator)
+					break
+				}
+			}
+
+		default:
+			end := wordEnd(source, index)
+			tokens = append(tokens, token{kind: tokenWord, text: source[index:end], position: index})
+			index = end
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(source)}), nil
+}
+
+// wordEnd returns the end of the unquoted word, the brackets are part of the word, e.g. cf[10010].
+func wordEnd(source string, index int) int {
+
+	for index < len(source) {
+
+		char, size := utf8.DecodeRuneInString(source[index:])
+
+		if char == '[' {
+			if end := strings.IndexByte(source[index:], ']'); end != -1 {
+				index += end + 1
+				continue
+			}
+		}
+
+		if unicode.IsSpace(char) || strings.ContainsRune(`()",'!=~<>&|`, char) {
+			break
+		}
+
+		index += size
+	}
+
+	return index
+}
+
+// unquote returns the text of the string quoted at the index and the index following it.
+// The backslash escapes the quotes, the backslash, the line endings and the unicode characters, e.g. \u00e9.
+func unquote(source string, index int) (string, int, error) {
+
+	delimiter := source[index]
+
+	var builder strings.Builder
+
+	for end := index + 1; end < len(source); end++ {
+
+		char := source[end]
+
+		switch {
+		case char == delimiter:
+			return builder.String(), end + 1, nil
+
+		case char == '\\' && end+1 < len(source):
+
+			end++
+
+			switch escaped := source[end]; escaped {
+			case 'n':
+				builder.WriteByte('\n')
+			case 'r':
+				builder.WriteByte('\r')
+			case 't':
+				builder.WriteByte('\t')
+			case 'u':
+				code, err := strconv.ParseUint(source[end+1:min(end+5, len(source))], 16, 32)
+				if err != nil || end+5 > len(source) {
+					return "", 0, &SyntaxError{Position: end - 1, Message: "invalid unicode escape"}
+				}
+				builder.WriteRune(rune(code))
+				end += 4
+			default:
+				builder.WriteByte(escaped)
+			}
+
+		default:
+			builder.WriteByte(char)
+		}
+	}
+
+	return "", 0, &SyntaxError{Position: index, Message: "unterminated string"}
+}
@@ -0,0 +1,264 @@
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// accountIDPattern matches the Atlassian account IDs, e.g. 5b10ac8d82e05b22cc7d4ef5 or 557058:f58131cb-b67d-43c7-b30d-6b58d40bd077.
+var accountIDPattern = regexp.MustCompile(`^(?:[0-9a-fA-F]{24}|[0-9]+:[0-9a-fA-F\-]{36}|qm:[0-9a-fA-F\-]+:[0-9a-fA-F\-]+)$`)
+
+// boundingFields are the fields restricting a query to some projects, issues, sprints or a filter.
+var boundingFields = wordSet("project key issuekey issue id parent sprint filter request savedfilter searchrequest")
+
+// userFields are the system fields holding users, their values are account IDs since the usernames were removed.
+var userFields = wordSet("assignee reporter creator watcher watchers voter voters worklogauthor")
+
+// userFunctions are the functions taking a user as first argument.
+var userFunctions = wordSet("updatedby issuehistory")
+
+// systemFields are the names of the system fields and their aliases, the other names reference custom fields.
+var systemFields = wordSet("affectedversion approvals assignee attachments category comment component created createddate creator " +
+	"description due duedate environment epiclink filter fixversion id issue issuekey issuekeys issuelink issuelinktype issuetype " +
+	"key labels lastviewed level originalestimate parent priority project projecttype remainingestimate reporter request resolution " +
+	"rank resolutiondate resolved savedfilter searchrequest sprint status statuscategory statuscategorychangeddate summary text " +
+	"timeestimate timeoriginalestimate timespent type updated updateddate voter voters votes watcher watchers worklogauthor " +
+	"worklogcomment worklogdate workratio")
+
+// Finding is an issue reported by a lint rule.
+type Finding struct {
+	Rule    string // The name of the rule, e.g. unbounded-query.
+	Message string // The description of the issue.
+	Clause  Clause // The clause at fault, nil when the finding is about the whole query.
+}
+
+func (f *Finding) String() string {
+
+	if f.Clause == nil {
+		return f.Rule + ": " + f.Message
+	}
+
+	return fmt.Sprintf("%v: %v: %v", f.Rule, f.Clause, f.Message)
+}
+
+// Rule is a lint rule, it returns the findings of the query.
+type Rule func(query *Query) []*Finding
+
+// DefaultRules are the rules checked by Lint when none is given.
+var DefaultRules = []Rule{UnboundedQuery, DeprecatedIssueFunction, FieldNames, Usernames}
+
+// Lint checks the query with the rules, or with DefaultRules, and returns the findings in the order of the rules.
+func Lint(query *Query, rules ...Rule) []*Finding {
+
+	if len(rules) == 0 {
+		rules = DefaultRules
+	}
+
+	var findings []*Finding
+	for _, rule := range rules {
+		findings = append(findings, rule(query)...)
+	}
+
+	return findings
+}
+
+// Walk calls visit for the clause and its nested clauses, depth first, the nested clauses are skipped when visit returns false.
+func Walk(clause Clause, visit func(Clause) bool) {
+
+	if isNil(clause) || !visit(clause) {
+		return
+	}
+
+	switch clause := clause.(type) {
+	case *Compound:
+		for _, nested := range clause.Clauses {
+			Walk(nested, visit)
+		}
+	case *Negation:
+		Walk(clause.Clause, visit)
+	}
+}
+
+// conditions returns the conditions of the query.
+func conditions(query *Query) []*Condition {
+
+	var found []*Condition
+
+	Walk(query.Where, func(clause Clause) bool {
+
+		if condition, ok := clause.(*Condition); ok {
+			found = append(found, condition)
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func normalize(field Field) string {
+	return strings.ToLower(strings.ReplaceAll(string(field), " ", ""))
+}
+
+// UnboundedQuery reports the queries which are not restricted to some projects, issues, sprints or a filter,
+// they scan every issue of the site.
+func UnboundedQuery(query *Query) []*Finding {
+
+	if bounded(query.Where) {
+		return nil
+	}
+
+	return []*Finding{{Rule: "unbounded-query", Message: "the query is not restricted by project, issue, sprint or filter, it scans the whole site"}}
+}
+
+// bounded reports if the clause restricts the issues, a negation never does, an OR only does when all its clauses do.
+func bounded(clause Clause) bool {
+
+	switch clause := clause.(type) {
+	case *Condition:
+		return clause != nil && boundingFields[normalize(clause.Field)] && (clause.Operator == Equals || clause.Operator == In)
+
+	case *Compound:
+
+		if clause == nil {
+			return false
+		}
+
+		for _, nested := range clause.Clauses {
+
+			switch isBounded := bounded(nested); {
+			case isBounded && clause.Operator == "AND":
+				return true
+			case !isBounded && clause.Operator == "OR":
+				return false
+			}
+		}
+
+		return clause.Operator == "OR"
+	}
+
+	return false
+}
+
+// DeprecatedIssueFunction reports the issueFunction conditions of ScriptRunner for Jira Server,
+// which are not supported by Jira Cloud.
+func DeprecatedIssueFunction(query *Query) []*Finding {
+
+	var findings []*Finding
+
+	for _, condition := range conditions(query) {
+
+		if normalize(condition.Field) != "issuefunction" {
+			continue
+		}
+
+		findings = append(findings, &Finding{
+			Rule:    "deprecated-issue-function",
+			Message: "issueFunction is not supported by Jira Cloud, use the native JQL functions or the ScriptRunner Enhanced Search",
+			Clause:  condition,
+		})
+	}
+
+	return findings
+}
+
+// FieldNames reports the custom fields referenced by their name, e.g. "Story Points", instead of their ID,
+// e.g. cf[10016]. The names are not unique and the queries break when a field is renamed.
+func FieldNames(query *Query) []*Finding {
+
+	var (
+		findings []*Finding
+		reported = make(map[Field]bool)
+	)
+
+	report := func(field Field, clause Clause) {
+
+		// The issueFunction conditions are reported by DeprecatedIssueFunction.
+		name := normalize(field)
+		if reported[field] || systemFields[name] || name == "issuefunction" || referencePattern.MatchString(string(field)) {
+			return
+		}
+
+		reported[field] = true
+
+		findings = append(findings, &Finding{
+			Rule:    "field-name",
+			Message: fmt.Sprintf("the custom field %v is referenced by its name, reference it by its ID, e.g. cf[10010]", field),
+			Clause:  clause,
+		})
+	}
+
+	for _, condition := range conditions(query) {
+		report(condition.Field, condition)
+	}
+
+	for _, order := range query.Order {
+		report(order.Field, nil)
+	}
+
+	return findings
+}
+
+// Usernames reports the users referenced by their username or their email instead of their account ID,
+// Jira Cloud no longer resolves them.
+func Usernames(query *Query) []*Finding {
+
+	var findings []*Finding
+
+	for _, condition := range conditions(query) {
+
+		var users []Operand
+
+		if userFields[normalize(condition.Field)] {
+
+			users = append(users, condition.Operand)
+
+			for _, predicate := range condition.Predicates {
+				if predicate.Operator == PredicateFrom || predicate.Operator == PredicateTo {
+					users = append(users, predicate.Operand)
+				}
+			}
+		}
+
+		for _, predicate := range condition.Predicates {
+			if predicate.Operator == PredicateBy {
+				users = append(users, predicate.Operand)
+			}
+		}
+
+		if function, ok := condition.Operand.(*Function); ok && userFunctions[strings.ToLower(function.Name)] && len(function.Args) != 0 {
+			users = append(users, Literal(function.Args[0]))
+		}
+
+		for _, username := range usernames(users) {
+			findings = append(findings, &Finding{
+				Rule:    "username",
+				Message: fmt.Sprintf("the user %q is not an account ID, Jira Cloud no longer resolves the usernames", username),
+				Clause:  condition,
+			})
+		}
+	}
+
+	return findings
+}
+
+// usernames returns the literals of the operands which are not account IDs.
+func usernames(operands []Operand) []string {
+
+	var found []string
+
+	for _, operand := range operands {
+
+		switch operand := operand.(type) {
+		case Literal:
+			if !accountIDPattern.MatchString(string(operand)) {
+				found = append(found, string(operand))
+			}
+		case List:
+			found = append(found, usernames(operand)...)
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,90 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "when the query is bounded and uses IDs",
+			query: `project = KP AND assignee = 5b10ac8d82e05b22cc7d4ef5 AND status CHANGED BY "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077" AND cf[10016] > 3 ORDER BY Rank`,
+		},
+		{
+			name:  "when the query is unbounded",
+			query: `status = Done OR project IN (KP, OPS) ORDER BY created`,
+			want:  []string{"unbounded-query: the query is not restricted by project, issue, sprint or filter, it scans the whole site"},
+		},
+		{
+			name:  "when the query is negated",
+			query: `NOT project = KP AND sprint IN openSprints()`,
+		},
+		{
+			name:  "when the query uses issueFunction",
+			query: `project = KP AND issueFunction in subtasksOf("project = OPS")`,
+			want: []string{
+				`deprecated-issue-function: issueFunction IN subtasksOf("project = OPS"): issueFunction is not supported by Jira Cloud, use the native JQL functions or the ScriptRunner Enhanced Search`,
+			},
+		},
+		{
+			name:  "when the custom fields are referenced by name",
+			query: `project = KP AND "Story Points" > 3 AND "Story Points" < 8 ORDER BY "Epic Name", "Due Date"`,
+			want: []string{
+				`field-name: "Story Points" > 3: the custom field "Story Points" is referenced by its name, reference it by its ID, e.g. cf[10010]`,
+				`field-name: the custom field "Epic Name" is referenced by its name, reference it by its ID, e.g. cf[10010]`,
+			},
+		},
+		{
+			name:  "when the users are referenced by username",
+			query: `project = KP AND reporter in (jsmith, 5b10ac8d82e05b22cc7d4ef5) AND status WAS Done BY "jane@example.com" AND issuekey IN updatedBy(admin)`,
+			want: []string{
+				`username: reporter IN (jsmith, 5b10ac8d82e05b22cc7d4ef5): the user "jsmith" is not an account ID, Jira Cloud no longer resolves the usernames`,
+				`username: status WAS Done BY "jane@example.com": the user "jane@example.com" is not an account ID, Jira Cloud no longer resolves the usernames`,
+				`username: issuekey IN updatedBy(admin): the user "admin" is not an account ID, Jira Cloud no longer resolves the usernames`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			query, err := Parse(testCase.query)
+			require.NoError(t, err)
+
+			var findings []string
+			for _, finding := range Lint(query) {
+				findings = append(findings, finding.String())
+			}
+
+			assert.Equal(t, testCase.want, findings)
+		})
+	}
+}
+
+func TestWalk(t *testing.T) {
+
+	query, err := Parse("project = KP AND NOT (status = Done OR labels IS EMPTY)")
+	require.NoError(t, err)
+
+	var fields []Field
+	Walk(query.Where, func(clause Clause) bool {
+
+		if condition, ok := clause.(*Condition); ok {
+			fields = append(fields, condition.Field)
+		}
+
+		// The negated clauses are skipped.
+		_, negated := clause.(*Negation)
+		return !negated
+	})
+
+	assert.Equal(t, []Field{"project"}, fields)
+}
@@ -0,0 +1,244 @@
+package jql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	wordPattern      = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+	referencePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.]*\[[^\]"]*\][A-Za-z0-9_.]*$`)
+)
+
+// reservedWords are the words JQL reserves, they are quoted when used as a value or a field name.
+var reservedWords = wordSet("a an abort access add after alias all alter and any are as asc audit avg before begin between boolean " +
+	"break by byte catch cf char character check checkpoint collate collation column commit connect continue count create current " +
+	"date decimal declare decrement default defaults define delete delimiter desc difference distinct divide do double drop else " +
+	"empty encoding end equals escape exclusive exec execute exists explain false fetch file field first float for from function go " +
+	"goto grant greater group having identified if immediate in increment index initial inner inout input insert int integer " +
+	"intersect intersection into is isempty isnull join last left less like limit lock long max min minus mode modify modulo more " +
+	"multiply next noaudit not notin nowait null number object of on option or order outer output power previous prior privileges " +
+	"public raise raw remainder rename resume return returns revoke right row rowid rownum rows select session set share size sqrt " +
+	"start strict string subtract sum synonym table then to trans transaction trigger true uid union unique update user validate " +
+	"values view when whenever where while with")
+
+func wordSet(words string) map[string]bool {
+
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+
+	return set
+}
+
+// Operand is the value compared by a condition, e.g. a literal, a list or a function.
+type Operand interface {
+	String() string
+	operand()
+}
+
+// Literal is a string or a number, it is quoted when it holds a reserved word or a special character.
+type Literal string
+
+func (l Literal) operand() {}
+
+func (l Literal) String() string {
+	return quote(string(l))
+}
+
+// Keyword is a JQL keyword used as an operand, e.g. EMPTY.
+type Keyword string
+
+const (
+	Empty = Keyword("EMPTY")
+	Null  = Keyword("NULL")
+)
+
+func (k Keyword) operand() {}
+
+func (k Keyword) String() string {
+	return string(k)
+}
+
+// List is a list of operands, e.g. the values of the IN operator.
+type List []Operand
+
+func (l List) operand() {}
+
+func (l List) String() string {
+
+	values := make([]string, 0, len(l))
+	for _, operand := range l {
+		values = append(values, operand.String())
+	}
+
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+// Function is a JQL function, e.g. currentUser() or membersOf("jira-administrators").
+type Function struct {
+	Name string   // The name of the function, e.g. openSprints.
+	Args []string // The arguments of the function, they are quoted when needed.
+}
+
+func (f *Function) operand() {}
+
+func (f *Function) String() string {
+
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, quote(arg))
+	}
+
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Func returns a call of the JQL function, e.g. Func("updatedBy", "5b10ac8d82e05b22cc7d4ef5").
+func Func(name string, args ...string) *Function {
+	return &Function{Name: name, Args: args}
+}
+
+// CurrentUser returns the currentUser() function, the user running the query.
+func CurrentUser() *Function {
+	return Func("currentUser")
+}
+
+// MembersOf returns the membersOf() function, the users of the group.
+func MembersOf(group string) *Function {
+	return Func("membersOf", group)
+}
+
+// OpenSprints returns the openSprints() function, the sprints which are started and not completed.
+func OpenSprints() *Function {
+	return Func("openSprints")
+}
+
+// ClosedSprints returns the closedSprints() function, the completed sprints.
+func ClosedSprints() *Function {
+	return Func("closedSprints")
+}
+
+// FutureSprints returns the futureSprints() function, the sprints which are not started.
+func FutureSprints() *Function {
+	return Func("futureSprints")
+}
+
+// ReleasedVersions returns the releasedVersions() function, the released versions of the projects, or of all the projects.
+func ReleasedVersions(projects ...string) *Function {
+	return Func("releasedVersions", projects...)
+}
+
+// UnreleasedVersions returns the unreleasedVersions() function, the versions of the projects which are not released.
+func UnreleasedVersions(projects ...string) *Function {
+	return Func("unreleasedVersions", projects...)
+}
+
+// Now returns the now() function, the current time.
+func Now() *Function {
+	return Func("now")
+}
+
+// StartOfDay returns the startOfDay() function, the increment is optional, e.g. "-1d".
+func StartOfDay(increment ...string) *Function {
+	return Func("startOfDay", increment...)
+}
+
+// EndOfDay returns the endOfDay() function, the increment is optional, e.g. "+1d".
+func EndOfDay(increment ...string) *Function {
+	return Func("endOfDay", increment...)
+}
+
+// StartOfWeek returns the startOfWeek() function, the increment is optional, e.g. "-1w".
+func StartOfWeek(increment ...string) *Function {
+	return Func("startOfWeek", increment...)
+}
+
+// EndOfWeek returns the endOfWeek() function, the increment is optional, e.g. "+1w".
+func EndOfWeek(increment ...string) *Function {
+	return Func("endOfWeek", increment...)
+}
+
+// StartOfMonth returns the startOfMonth() function, the increment is optional, e.g. "-1M".
+func StartOfMonth(increment ...string) *Function {
+	return Func("startOfMonth", increment...)
+}
+
+// EndOfMonth returns the endOfMonth() function, the increment is optional, e.g. "+1M".
+func EndOfMonth(increment ...string) *Function {
+	return Func("endOfMonth", increment...)
+}
+
+// operand converts a Go value to an operand: the strings and the numbers are literals,
+// the times are literal dates in their location, the slices of strings are lists,
+// the other values are formatted as literals.
+func operand(value interface{}) Operand {
+
+	switch value := value.(type) {
+	case Operand:
+		return value
+	case string:
+		return Literal(value)
+	case int:
+		return Literal(strconv.Itoa(value))
+	case int64:
+		return Literal(strconv.FormatInt(value, 10))
+	case float64:
+		return Literal(strconv.FormatFloat(value, 'f', -1, 64))
+	case time.Time:
+		return Literal(formatDate(value))
+	case []string:
+		list := make(List, 0, len(value))
+		for _, item := range value {
+			list = append(list, Literal(item))
+		}
+		return list
+	default:
+		return Literal(fmt.Sprint(value))
+	}
+}
+
+// formatDate formats the time as a JQL date, with the minutes unless it is midnight.
+func formatDate(t time.Time) string {
+
+	if t.Hour() == 0 && t.Minute() == 0 {
+		return t.Format("2006-01-02")
+	}
+
+	return t.Format("2006-01-02 15:04")
+}
+
+// quote returns the word as is, or between double quotes when it is a reserved word or holds a special character.
+func quote(word string) string {
+
+	if wordPattern.MatchString(word) && !reservedWords[strings.ToLower(word)] {
+		return word
+	}
+
+	var builder strings.Builder
+	builder.WriteByte('"')
+
+	for _, char := range word {
+
+		switch char {
+		case '"', '\\':
+			builder.WriteByte('\\')
+			builder.WriteRune(char)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			builder.WriteRune(char)
+		}
+	}
+
+	builder.WriteByte('"')
+
+	return builder.String()
+}
@@ -0,0 +1,385 @@
+package jql
+
+import "strings"
+
+// predicateOperators are the predicates accepted by the history operators.
+var predicateOperators = []PredicateOperator{PredicateAfter, PredicateBefore, PredicateBy, PredicateDuring, PredicateOn, PredicateFrom, PredicateTo}
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+// Parse parses the query locally, without calling Jira, so it only checks its syntax: the fields, the values and
+// the functions are not resolved. The keywords are case-insensitive, and &&, ||, & and | are accepted for AND and OR,
+// ! for NOT. The String method of the query returns it in the canonical form, e.g.
+// project=KP and status in (Done,'Closed') order by created desc is returned as
+// project = KP AND status IN (Done, Closed) ORDER BY created DESC.
+// The errors are *SyntaxError, they match models.ErrInvalidJQL.
+func Parse(source string) (*Query, error) {
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	query := &Query{}
+
+	if !p.peek().is("ORDER") && p.peek().kind != tokenEOF {
+		if query.Where, err = p.or(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().is("ORDER") {
+		if query.Order, err = p.orderBy(); err != nil {
+			return nil, err
+		}
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "expected AND, OR or ORDER BY")
+	}
+
+	return query, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+
+	current := p.tokens[p.index]
+	if current.kind != tokenEOF {
+		p.index++
+	}
+
+	return current
+}
+
+func (p *parser) errorf(found token, expected string) error {
+	return &SyntaxError{Position: found.position, Message: expected + ", found " + found.describe()}
+}
+
+func (p *parser) or() (Clause, error) {
+	return p.compound("OR", "||", "|", p.and)
+}
+
+func (p *parser) and() (Clause, error) {
+	return p.compound("AND", "&&", "&", p.not)
+}
+
+// compound parses the operands joined by the keyword, or its symbols.
+func (p *parser) compound(keyword, symbol, short string, operand func() (Clause, error)) (Clause, error) {
+
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	clauses := []Clause{first}
+
+	for {
+
+		next := p.peek()
+		if !next.is(keyword) && !(next.kind == tokenOperator && (next.text == symbol || next.text == short)) {
+			break
+		}
+
+		p.next()
+
+		clause, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		clauses = append(clauses, clause)
+	}
+
+	return compound(keyword, clauses), nil
+}
+
+func (p *parser) not() (Clause, error) {
+
+	next := p.peek()
+
+	switch {
+	case next.is("NOT") || next.kind == tokenOperator && next.text == "!":
+
+		p.next()
+
+		clause, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not(clause), nil
+
+	case next.kind == tokenOpen:
+
+		p.next()
+
+		clause, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokenClose {
+			return nil, p.errorf(closing, "expected ')'")
+		}
+
+		return clause, nil
+	}
+
+	return p.condition()
+}
+
+// field parses a field name, a keyword is only a field name when it is quoted, e.g. "order".
+func (p *parser) field() (Field, error) {
+
+	next := p.next()
+
+	if next.kind == tokenString || next.kind == tokenWord && !isKeyword(next.text) {
+		return Field(next.text), nil
+	}
+
+	return "", p.errorf(next, "expected a field")
+}
+
+// isKeyword reports if the word is a keyword of the JQL grammar.
+func isKeyword(word string) bool {
+
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "NOT", "IN", "IS", "WAS", "CHANGED", "EMPTY", "NULL", "ORDER", "BY":
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) condition() (Clause, error) {
+
+	field, err := p.field()
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := p.operator()
+	if err != nil {
+		return nil, err
+	}
+
+	condition := &Condition{Field: field, Operator: operator}
+
+	if operator != Changed {
+		if condition.Operand, err = p.operand(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch operator {
+	case Was, WasNot, WasIn, WasNotIn, Changed:
+	default:
+		return condition, nil
+	}
+
+	for {
+
+		predicate := p.predicateOperator()
+		if predicate == "" {
+			return condition, nil
+		}
+
+		p.next()
+
+		operand, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		condition.Predicates = append(condition.Predicates, &Predicate{Operator: predicate, Operand: operand})
+	}
+}
+
+func (p *parser) predicateOperator() PredicateOperator {
+
+	for _, predicate := range predicateOperators {
+		if p.peek().is(string(predicate)) {
+			return predicate
+		}
+	}
+
+	return ""
+}
+
+// operator parses an operator, the symbols, e.g. !=, and the keywords, e.g. WAS NOT IN.
+func (p *parser) operator() (Operator, error) {
+
+	next := p.next()
+
+	if next.kind == tokenOperator {
+		switch operator := Operator(next.text); operator {
+		case Equals, NotEquals, GreaterThan, GreaterThanEqual, LessThan, LessThanEqual, Contains, NotContains:
+			return operator, nil
+		}
+	}
+
+	switch {
+	case next.is("IN"):
+		return In, nil
+	case next.is("CHANGED"):
+		return Changed, nil
+	case next.is("NOT") && p.peek().is("IN"):
+		p.next()
+		return NotIn, nil
+	case next.is("IS"):
+		if p.peek().is("NOT") {
+			p.next()
+			return IsNot, nil
+		}
+		return Is, nil
+	case next.is("WAS"):
+
+		operator := Was
+		if p.peek().is("NOT") {
+			p.next()
+			operator = WasNot
+		}
+
+		if p.peek().is("IN") {
+			p.next()
+			if operator == Was {
+				return WasIn, nil
+			}
+			return WasNotIn, nil
+		}
+
+		return operator, nil
+	}
+
+	return "", p.errorf(next, "expected an operator")
+}
+
+// operand parses a value, a list of values, EMPTY, NULL or a function call.
+func (p *parser) operand() (Operand, error) {
+
+	next := p.next()
+
+	switch {
+	case next.kind == tokenString:
+		return Literal(next.text), nil
+
+	case next.is("EMPTY") || next.is("NULL"):
+		return Keyword(strings.ToUpper(next.text)), nil
+
+	case next.kind == tokenWord && !isKeyword(next.text):
+
+		if p.peek().kind != tokenOpen {
+			return Literal(next.text), nil
+		}
+
+		p.next()
+
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Function{Name: next.text, Args: args}, nil
+
+	case next.kind == tokenOpen:
+
+		var list List
+		for {
+
+			operand, err := p.operand()
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, operand)
+
+			switch separator := p.next(); separator.kind {
+			case tokenComma:
+				continue
+			case tokenClose:
+				return list, nil
+			default:
+				return nil, p.errorf(separator, "expected ',' or ')'")
+			}
+		}
+	}
+
+	return nil, p.errorf(next, "expected a value")
+}
+
+// arguments parses the arguments of a function, following its opening parenthesis.
+func (p *parser) arguments() ([]string, error) {
+
+	var args []string
+
+	if p.peek().kind == tokenClose {
+		p.next()
+		return args, nil
+	}
+
+	for {
+
+		next := p.next()
+		if next.kind != tokenString && next.kind != tokenWord {
+			return nil, p.errorf(next, "expected an argument")
+		}
+
+		args = append(args, next.text)
+
+		switch separator := p.next(); separator.kind {
+		case tokenComma:
+			continue
+		case tokenClose:
+			return args, nil
+		default:
+			return nil, p.errorf(separator, "expected ',' or ')'")
+		}
+	}
+}
+
+// orderBy parses the ORDER BY clause, the fields are separated by commas and optionally followed by ASC or DESC.
+func (p *parser) orderBy() ([]*OrderField, error) {
+
+	p.next()
+
+	if next := p.next(); !next.is("BY") {
+		return nil, p.errorf(next, "expected BY")
+	}
+
+	var fields []*OrderField
+
+	for {
+
+		field, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+
+		order := &OrderField{Field: field}
+
+		switch {
+		case p.peek().is("ASC"):
+			p.next()
+			order.Direction = Asc
+		case p.peek().is("DESC"):
+			p.next()
+			order.Direction = Desc
+		}
+
+		fields = append(fields, order)
+
+		if p.peek().kind != tokenComma {
+			return fields, nil
+		}
+
+		p.next()
+	}
+}
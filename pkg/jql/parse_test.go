@@ -0,0 +1,97 @@
+package jql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestParse(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "when the query is not canonical",
+			query: "project=KP and status in (Done,'Closed') order by created desc, key",
+			want:  "project = KP AND status IN (Done, Closed) ORDER BY created DESC, key",
+		},
+		{
+			name:  "when the query has symbols and nested clauses",
+			query: `(project = KP && (priority = High || priority=Highest)) | !(status = "Done" or resolution is not empty) AND labels not in (a, "b c")`,
+			want:  `(project = KP AND (priority = High OR priority = Highest)) OR (NOT (status = Done OR resolution IS NOT EMPTY) AND labels NOT IN ("a", "b c"))`,
+		},
+		{
+			name:  "when the query has functions and custom fields",
+			query: `assignee = currentUser() AND sprint in openSprints() AND cf[10010] = Platform AND "Story Points" >= 3 AND created > startOfWeek("-1w") AND issue.property[support].level = 2`,
+			want:  `assignee = currentUser() AND sprint IN openSprints() AND cf[10010] = Platform AND "Story Points" >= 3 AND created > startOfWeek(-1w) AND issue.property[support].level = 2`,
+		},
+		{
+			name:  "when the query has history operators",
+			query: `status was "In Progress" by currentUser() during ("2024-01-01", '2024-02-01') and assignee changed from a to b after -1w and status was not in (Done) and status WAS NOT Open`,
+			want:  `status WAS "In Progress" BY currentUser() DURING (2024-01-01, 2024-02-01) AND assignee CHANGED FROM "a" TO b AFTER -1w AND status WAS NOT IN (Done) AND status WAS NOT Open`,
+		},
+		{
+			name:  "when the strings have escapes",
+			query: `summary ~ "can't \"login\" \\ é" and text ~ 'it\'s'`,
+			want:  `summary ~ "can't \"login\" \\ é" AND text ~ "it's"`,
+		},
+		{
+			name:  "when the query is only ordered",
+			query: "ORDER BY Rank ASC",
+			want:  "ORDER BY Rank ASC",
+		},
+		{
+			name:  "when the query is empty",
+			query: "  ",
+			want:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			query, err := Parse(testCase.query)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.want, query.String())
+
+			// The canonical form is parsed to the same query.
+			reparsed, err := Parse(query.String())
+			require.NoError(t, err)
+			assert.Equal(t, query, reparsed)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+
+	testCases := []struct {
+		query string
+		want  string
+	}{
+		{query: "project KP", want: "jql: expected an operator, found 'KP' at position 8"},
+		{query: "project = ", want: "jql: expected a value, found the end of the query at position 10"},
+		{query: "project = KP status = Done", want: "jql: expected AND, OR or ORDER BY, found 'status' at position 13"},
+		{query: "(project = KP", want: "jql: expected ')', found the end of the query at position 13"},
+		{query: "status in (Done Closed)", want: "jql: expected ',' or ')', found 'Closed' at position 16"},
+		{query: `summary ~ "login`, want: "jql: unterminated string at position 10"},
+		{query: "order = 1", want: "jql: expected BY, found '=' at position 6"},
+		{query: "project = KP ORDER BY", want: "jql: expected a field, found the end of the query at position 21"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.query, func(t *testing.T) {
+
+			_, err := Parse(testCase.query)
+
+			assert.EqualError(t, err, testCase.want)
+			assert.ErrorIs(t, err, model.ErrInvalidJQL)
+		})
+	}
+}
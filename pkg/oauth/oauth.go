@@ -0,0 +1,204 @@
+// Package oauth implements the OAuth 2.0 authorization code grant (3LO) of the Atlassian cloud products.
+//
+// The flow is made of three steps:
+//
+//  1. The user is redirected to Config.AuthCodeURL and consents to the scopes of the app.
+//  2. The authorization code sent back to the redirect URL is exchanged with Config.Exchange.
+//  3. The token is wrapped in a TokenSource and set on the clients, which renews it before it expires
+//     and routes the requests through https://api.atlassian.com/ex/{product}/{cloudId}/.
+//
+// The same token source can be shared by the Jira, Agile, Service Management and Confluence clients of a site:
+//
+//	token, err := config.Exchange(ctx, code)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client, err := v3.New(nil, "https://your-domain.atlassian.net", nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client.Auth.(common.TokenSourceAuthentication).SetTokenSource(oauth.NewTokenSource(config, token, store))
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// The Atlassian OAuth 2.0 endpoints, used when the Config does not override them.
+const (
+	DefaultAuthURL      = "https://auth.atlassian.com/authorize"
+	DefaultTokenURL     = "https://auth.atlassian.com/oauth/token"
+	DefaultResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+)
+
+// Config describes an OAuth 2.0 (3LO) app registered in the Atlassian developer console.
+type Config struct {
+	ClientID     string   // The client ID of the app.
+	ClientSecret string   // The secret of the app.
+	RedirectURL  string   // The callback URL of the app, receiving the authorization code.
+	Scopes       []string // The scopes requested, add offline_access to receive a refresh token.
+
+	HTTPClient   common.HTTPClient // The client sending the token and resource requests, http.DefaultClient when nil.
+	AuthURL      string            // The authorization URL, DefaultAuthURL when empty.
+	TokenURL     string            // The token URL, DefaultTokenURL when empty.
+	ResourcesURL string            // The accessible resources URL, DefaultResourcesURL when empty.
+}
+
+// AuthCodeURL returns the URL of the consent screen, state is sent back with the code and must be checked by the caller.
+func (c *Config) AuthCodeURL(state string) string {
+
+	params := url.Values{}
+	params.Add("audience", "api.atlassian.com")
+	params.Add("client_id", c.ClientID)
+	params.Add("scope", strings.Join(c.Scopes, " "))
+	params.Add("redirect_uri", c.RedirectURL)
+	params.Add("state", state)
+	params.Add("response_type", "code")
+	params.Add("prompt", "consent")
+
+	return fmt.Sprintf("%v?%v", defaultString(c.AuthURL, DefaultAuthURL), params.Encode())
+}
+
+// Exchange exchanges the authorization code received on the redirect URL for a token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+
+	if code == "" {
+		return nil, model.ErrNoAuthorizationCode
+	}
+
+	return c.retrieveToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh renews the access token with the refresh token.
+//
+// Atlassian rotates the refresh tokens, the refresh token of the returned token replaces the one used.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+
+	if refreshToken == "" {
+		return nil, model.ErrNoRefreshToken
+	}
+
+	token, err := c.retrieveToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The refresh token is only returned when rotated, the current one stays valid otherwise.
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	return token, nil
+}
+
+func (c *Config) retrieveToken(ctx context.Context, payload map[string]string) (*Token, error) {
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, defaultString(c.TokenURL, DefaultTokenURL), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	token := new(Token)
+	if err = c.do(request, token); err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, model.ErrNoAccessToken
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+func (c *Config) do(request *http.Request, structure interface{}) error {
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+
+		oauthErr := &Error{StatusCode: response.StatusCode}
+		if err = json.Unmarshal(content, oauthErr); err != nil || oauthErr.Code == "" {
+			oauthErr.Code = http.StatusText(response.StatusCode)
+		}
+
+		return oauthErr
+	}
+
+	return json.Unmarshal(content, structure)
+}
+
+// Error is returned when the authorization server rejects a token or resource request.
+type Error struct {
+	StatusCode  int    `json:"-"`                 // The HTTP status code of the response.
+	Code        string `json:"error"`             // The OAuth error code, e.g. invalid_grant.
+	Description string `json:"error_description"` // The description of the error, when provided.
+}
+
+// Error returns the OAuth error code followed by its description.
+func (e *Error) Error() string {
+
+	if e.Description == "" {
+		return fmt.Sprintf("oauth: %v (status %v)", e.Code, e.StatusCode)
+	}
+
+	return fmt.Sprintf("oauth: %v: %v (status %v)", e.Code, e.Description, e.StatusCode)
+}
+
+func defaultString(value, fallback string) string {
+
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
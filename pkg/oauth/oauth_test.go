@@ -0,0 +1,225 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func newTestConfig(server *httptest.Server) *Config {
+	return &Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+		Scopes:       []string{"read:jira-work", "offline_access"},
+		HTTPClient:   server.Client(),
+		TokenURL:     server.URL + "/oauth/token",
+		ResourcesURL: server.URL + "/oauth/token/accessible-resources",
+	}
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+
+	config := &Config{ClientID: "client-id", RedirectURL: "https://example.com/callback", Scopes: []string{"read:jira-work", "offline_access"}}
+
+	got, err := url.Parse(config.AuthCodeURL("state-value"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "auth.atlassian.com", got.Host)
+	assert.Equal(t, "/authorize", got.Path)
+	assert.Equal(t, "api.atlassian.com", got.Query().Get("audience"))
+	assert.Equal(t, "client-id", got.Query().Get("client_id"))
+	assert.Equal(t, "read:jira-work offline_access", got.Query().Get("scope"))
+	assert.Equal(t, "https://example.com/callback", got.Query().Get("redirect_uri"))
+	assert.Equal(t, "state-value", got.Query().Get("state"))
+	assert.Equal(t, "code", got.Query().Get("response_type"))
+	assert.Equal(t, "consent", got.Query().Get("prompt"))
+}
+
+func TestConfig_Exchange(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, map[string]string{
+			"grant_type":    "authorization_code",
+			"client_id":     "client-id",
+			"client_secret": "client-secret",
+			"code":          "code-value",
+			"redirect_uri":  "https://example.com/callback",
+		}, payload)
+
+		_, _ = w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "expires_in": 3600, "token_type": "Bearer", "scope": "read:jira-work"}`))
+	}))
+	defer server.Close()
+
+	token, err := newTestConfig(server).Exchange(context.Background(), "code-value")
+	require.NoError(t, err)
+
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+	assert.True(t, token.Valid(DefaultExpiryDelta))
+
+	_, err = newTestConfig(server).Exchange(context.Background(), "")
+	assert.ErrorIs(t, err, model.ErrNoAuthorizationCode)
+}
+
+func TestConfig_Exchange_Error(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Invalid authorization code"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestConfig(server).Exchange(context.Background(), "code-value")
+
+	var oauthErr *Error
+	require.True(t, errors.As(err, &oauthErr))
+
+	assert.Equal(t, http.StatusForbidden, oauthErr.StatusCode)
+	assert.Equal(t, "invalid_grant", oauthErr.Code)
+	assert.EqualError(t, err, "oauth: invalid_grant: Invalid authorization code (status 403)")
+}
+
+func TestConfig_Refresh(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		assert.Equal(t, "refresh_token", payload["grant_type"])
+		assert.Equal(t, "refresh", payload["refresh_token"])
+
+		// The refresh token is not rotated, the current one must be kept.
+		_, _ = w.Write([]byte(`{"access_token": "renewed", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	token, err := newTestConfig(server).Refresh(context.Background(), "refresh")
+	require.NoError(t, err)
+
+	assert.Equal(t, "renewed", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+
+	_, err = newTestConfig(server).Refresh(context.Background(), "")
+	assert.ErrorIs(t, err, model.ErrNoRefreshToken)
+}
+
+func TestTokenSource_Token(t *testing.T) {
+
+	var refreshes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshes.Add(1)
+		_, _ = w.Write([]byte(`{"access_token": "renewed", "refresh_token": "rotated", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	var saved []*Token
+	store := TokenStoreFunc(func(ctx context.Context, token *Token) error {
+		saved = append(saved, token)
+		return nil
+	})
+
+	expired := &Token{AccessToken: "expired", RefreshToken: "refresh", Expiry: time.Now().Add(30 * time.Second)}
+	source := NewTokenSource(newTestConfig(server), expired, store)
+
+	// The token expires within the expiry delta, it is renewed once whatever the number of concurrent callers.
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			accessToken, err := source.BearerToken(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "renewed", accessToken)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refreshes.Load())
+	require.Len(t, saved, 1)
+	assert.Equal(t, "rotated", saved[0].RefreshToken)
+}
+
+func TestTokenSource_Token_StoreError(t *testing.T) {
+
+	var refreshes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshes.Add(1)
+		_, _ = w.Write([]byte(`{"access_token": "renewed", "refresh_token": "rotated", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	errStore := errors.New("store unavailable")
+	store := TokenStoreFunc(func(ctx context.Context, token *Token) error {
+		return errStore
+	})
+
+	source := NewTokenSource(newTestConfig(server), &Token{RefreshToken: "refresh"}, store)
+
+	_, err := source.Token(context.Background())
+	assert.EqualError(t, err, "oauth: the renewed token cannot be saved: store unavailable")
+	assert.ErrorIs(t, err, errStore)
+
+	// The rotated token is kept, the source is not refreshed again with the invalidated refresh token.
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "renewed", token.AccessToken)
+	assert.Equal(t, "rotated", token.RefreshToken)
+	assert.Equal(t, int32(1), refreshes.Load())
+
+	_, err = NewTokenSource(newTestConfig(server), nil, nil).Token(context.Background())
+	assert.ErrorIs(t, err, model.ErrNoOAuthToken)
+}
+
+func TestTokenSource_CloudID(t *testing.T) {
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		calls.Add(1)
+
+		assert.Equal(t, "/oauth/token/accessible-resources", r.URL.Path)
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+
+		_, _ = w.Write([]byte(`[
+			{"id": "cloud-a", "url": "https://site-a.atlassian.net", "name": "site-a", "scopes": ["read:jira-work"]},
+			{"id": "cloud-b", "url": "https://site-b.atlassian.net", "name": "site-b", "scopes": ["read:jira-work"]}
+		]`))
+	}))
+	defer server.Close()
+
+	source := NewTokenSource(newTestConfig(server), &Token{AccessToken: "access"}, nil)
+
+	for range 2 {
+		cloudID, err := source.CloudID(context.Background(), &url.URL{Scheme: "https", Host: "Site-B.atlassian.net"})
+		require.NoError(t, err)
+		assert.Equal(t, "cloud-b", cloudID)
+	}
+
+	// The cloud ID of a site is only discovered once.
+	assert.Equal(t, int32(1), calls.Load())
+
+	_, err := source.CloudID(context.Background(), &url.URL{Scheme: "https", Host: "unknown.atlassian.net"})
+	assert.ErrorIs(t, err, model.ErrNoCloudResource)
+}
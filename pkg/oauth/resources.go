@@ -0,0 +1,85 @@
+package oauth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Resource is a cloud site the token grants access to, with the scopes granted on it.
+type Resource struct {
+	ID        string   `json:"id"`        // The cloud ID of the site.
+	URL       string   `json:"url"`       // The URL of the site, e.g. https://your-domain.atlassian.net.
+	Name      string   `json:"name"`      // The name of the site.
+	Scopes    []string `json:"scopes"`    // The scopes granted on the site.
+	AvatarURL string   `json:"avatarUrl"` // The avatar of the site.
+}
+
+// AccessibleResources returns the cloud sites the access token grants access to.
+func (c *Config) AccessibleResources(ctx context.Context, accessToken string) ([]*Resource, error) {
+
+	if accessToken == "" {
+		return nil, model.ErrNoAccessToken
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, defaultString(c.ResourcesURL, DefaultResourcesURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	var resources []*Resource
+	if err = c.do(request, &resources); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// AccessibleResources returns the cloud sites the current access token grants access to.
+func (s *TokenSource) AccessibleResources(ctx context.Context) ([]*Resource, error) {
+
+	accessToken, err := s.BearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.config.AccessibleResources(ctx, accessToken)
+}
+
+// CloudID returns the cloud ID of the site, discovered from the accessible resources on the first call.
+func (s *TokenSource) CloudID(ctx context.Context, site *url.URL) (string, error) {
+
+	s.cloudMu.Lock()
+	defer s.cloudMu.Unlock()
+
+	host := strings.ToLower(site.Host)
+	if cloudID, ok := s.cloudIDs[host]; ok {
+		return cloudID, nil
+	}
+
+	resources, err := s.AccessibleResources(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, resource := range resources {
+
+		resourceURL, err := url.Parse(resource.URL)
+		if err != nil {
+			continue
+		}
+
+		if strings.EqualFold(resourceURL.Host, host) {
+			s.cloudIDs[host] = resource.ID
+			return resource.ID, nil
+		}
+	}
+
+	return "", model.ErrNoCloudResource
+}
@@ -0,0 +1,119 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DefaultExpiryDelta is how long before its expiry an access token is renewed,
+// so a request is never sent with a token expiring on the way.
+const DefaultExpiryDelta = time.Minute
+
+// Token is an OAuth 2.0 access token and the refresh token renewing it.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresIn    int       `json:"expires_in,omitempty"` // The lifetime of the access token in seconds, as returned by the server.
+	Expiry       time.Time `json:"expiry,omitempty"`     // The expiry of the access token computed on receipt, zero when unknown.
+}
+
+// Valid reports whether the access token is set and is not expiring within delta.
+func (t *Token) Valid(delta time.Duration) bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(delta).Before(t.Expiry))
+}
+
+// TokenStore persists the tokens renewed by a TokenSource.
+//
+// Atlassian rotates the refresh tokens and invalidates the previous ones, a renewed token
+// must be stored before the process exits or the user has to consent again.
+type TokenStore interface {
+	Save(ctx context.Context, token *Token) error
+}
+
+// TokenStoreFunc adapts a function to the TokenStore interface.
+type TokenStoreFunc func(ctx context.Context, token *Token) error
+
+// Save calls f(ctx, token).
+func (f TokenStoreFunc) Save(ctx context.Context, token *Token) error {
+	return f(ctx, token)
+}
+
+// NewTokenSource returns a TokenSource renewing token with the refresh token when it expires.
+//
+// The store is optional, the renewed tokens are saved in it before being used.
+func NewTokenSource(config *Config, token *Token, store TokenStore) *TokenSource {
+	return &TokenSource{
+		ExpiryDelta: DefaultExpiryDelta,
+		config:      config,
+		token:       token,
+		store:       store,
+		cloudIDs:    make(map[string]string),
+	}
+}
+
+// TokenSource supplies an access token renewed before it expires, it is safe for concurrent use.
+//
+// It implements common.TokenSource and common.CloudRouter: set on the Auth of a client,
+// the requests are authorized with the access token and routed through the API gateway.
+type TokenSource struct {
+	// ExpiryDelta is how long before its expiry the access token is renewed.
+	ExpiryDelta time.Duration
+
+	config *Config
+	store  TokenStore
+
+	mu    sync.Mutex
+	token *Token
+
+	cloudMu  sync.Mutex
+	cloudIDs map[string]string
+}
+
+// Token returns the current token, renewed first when it is expired or expiring within ExpiryDelta.
+func (s *TokenSource) Token(ctx context.Context) (*Token, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid(s.ExpiryDelta) {
+		return s.token, nil
+	}
+
+	if s.token == nil {
+		return nil, model.ErrNoOAuthToken
+	}
+
+	token, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The refresh token was rotated, the previous one is no longer valid and the renewed token is kept
+	// even when it cannot be stored.
+	s.token = token
+
+	if s.store != nil {
+		if err = s.store.Save(ctx, token); err != nil {
+			return nil, fmt.Errorf("oauth: the renewed token cannot be saved: %w", err)
+		}
+	}
+
+	return token, nil
+}
+
+// BearerToken returns the current access token, renewed first when needed.
+func (s *TokenSource) BearerToken(ctx context.Context) (string, error) {
+
+	token, err := s.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
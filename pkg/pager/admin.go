@@ -0,0 +1,32 @@
+package pager
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/admin"
+)
+
+// OrganizationUsers iterates over the managed users of an organization.
+//
+// The endpoint does not accept a page size, so Options.PageSize is ignored.
+//
+// GET /admin/v1/orgs/{orgId}/users
+func OrganizationUsers(ctx context.Context, organization admin.OrganizationConnector, organizationID string, options *Options) iter.Seq2[*model.AdminOrganizationUserScheme, error] {
+
+	return Cursor(ctx, func(ctx context.Context, cursor string, _ int) (*Page[*model.AdminOrganizationUserScheme], error) {
+
+		page, _, err := organization.Users(ctx, organizationID, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &Page[*model.AdminOrganizationUserScheme]{Values: page.Data, Total: page.Meta.Total}
+		if page.Links != nil {
+			result.Next = CursorFromLink(page.Links.Next)
+		}
+
+		return result, nil
+	}, options)
+}
@@ -0,0 +1,42 @@
+package pager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/admin"
+)
+
+type fakeOrganizationConnector struct {
+	admin.OrganizationConnector
+	pages map[string]*model.OrganizationUserPageScheme
+}
+
+func (f *fakeOrganizationConnector) Users(_ context.Context, _, cursor string) (*model.OrganizationUserPageScheme, *model.ResponseScheme, error) {
+	return f.pages[cursor], nil, nil
+}
+
+func TestOrganizationUsers(t *testing.T) {
+
+	connector := &fakeOrganizationConnector{pages: map[string]*model.OrganizationUserPageScheme{
+		"": {
+			Data:  []*model.AdminOrganizationUserScheme{{AccountID: "1"}, {AccountID: "2"}},
+			Links: &model.LinkPageModelScheme{Next: "/admin/v1/orgs/org-id/users?cursor=page-2"},
+		},
+		"page-2": {
+			Data:  []*model.AdminOrganizationUserScheme{{AccountID: "3"}},
+			Links: &model.LinkPageModelScheme{},
+		},
+	}}
+
+	var accountIDs []string
+	for user, err := range OrganizationUsers(context.Background(), connector, "org-id", nil) {
+		assert.NoError(t, err)
+		accountIDs = append(accountIDs, user.AccountID)
+	}
+
+	assert.Equal(t, []string{"1", "2", "3"}, accountIDs)
+}
@@ -0,0 +1,25 @@
+package pager
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/agile"
+)
+
+// BoardIssues iterates over the issues of a board.
+//
+// GET /rest/agile/1.0/board/{boardID}/issue
+func BoardIssues(ctx context.Context, board agile.BoardConnector, boardID int, opts *model.IssueOptionScheme, options *Options) iter.Seq2[*model.IssueSchemeV2, error] {
+
+	return Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*Page[*model.IssueSchemeV2], error) {
+
+		page, _, err := board.Issues(ctx, boardID, opts, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[*model.IssueSchemeV2]{Values: page.Issues, Total: page.Total}, nil
+	}, options)
+}
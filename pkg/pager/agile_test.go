@@ -0,0 +1,40 @@
+package pager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/agile"
+)
+
+type fakeBoardConnector struct {
+	agile.BoardConnector
+	issues []*model.IssueSchemeV2
+}
+
+func (f *fakeBoardConnector) Issues(_ context.Context, _ int, _ *model.IssueOptionScheme, startAt, maxResults int) (*model.BoardIssuePageScheme, *model.ResponseScheme, error) {
+
+	end := min(startAt+maxResults, len(f.issues))
+
+	return &model.BoardIssuePageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(f.issues),
+		Issues:     f.issues[startAt:end],
+	}, nil, nil
+}
+
+func TestBoardIssues(t *testing.T) {
+
+	connector := &fakeBoardConnector{issues: []*model.IssueSchemeV2{
+		{Key: "KP-1"}, {Key: "KP-2"}, {Key: "KP-3"}, {Key: "KP-4"}, {Key: "KP-5"},
+	}}
+
+	got, err := Collect(BoardIssues(context.Background(), connector, 4, nil, &Options{PageSize: 2, Concurrency: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, connector.issues, got)
+}
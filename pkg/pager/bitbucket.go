@@ -0,0 +1,43 @@
+package pager
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// Repositories iterates over the repositories of a workspace, q filters them using the Bitbucket query language.
+//
+// The Bitbucket pages are numbered, the page number is carried as the cursor of the next page. The page length stays
+// at the page size for the page numbers to line up, the items past MaxItems are dropped by the iterator.
+//
+// GET /2.0/repositories/{workspace}
+func Repositories(ctx context.Context, repository bitbucket.RepositoryConnector, workspace, q string, options *Options) iter.Seq2[*model.RepositoryScheme, error] {
+
+	return Cursor(ctx, func(ctx context.Context, cursor string, _ int) (*Page[*model.RepositoryScheme], error) {
+
+		number := 1
+		if cursor != "" {
+
+			var err error
+			if number, err = strconv.Atoi(cursor); err != nil {
+				return nil, err
+			}
+		}
+
+		page, _, err := repository.List(ctx, workspace, &model.PageOptions{Page: number, PageLen: options.pageSize(), Q: q})
+		if err != nil {
+			return nil, err
+		}
+
+		result := &Page[*model.RepositoryScheme]{Values: page.Values, Total: page.Size}
+		if page.Next != "" {
+			result.Next = strconv.Itoa(number + 1)
+		}
+
+		return result, nil
+	}, options)
+}
@@ -0,0 +1,72 @@
+package pager
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+type fakeRepositoryConnector struct {
+	bitbucket.RepositoryConnector
+	repositories []*model.RepositoryScheme
+	options      []model.PageOptions
+}
+
+func (f *fakeRepositoryConnector) List(_ context.Context, _ string, opts *model.PageOptions) (*model.RepositoryPageScheme, *model.ResponseScheme, error) {
+
+	f.options = append(f.options, *opts)
+
+	start := (opts.Page - 1) * opts.PageLen
+	end := min(start+opts.PageLen, len(f.repositories))
+
+	page := &model.RepositoryPageScheme{
+		Size:    len(f.repositories),
+		Page:    opts.Page,
+		Pagelen: opts.PageLen,
+		Values:  f.repositories[start:end],
+	}
+
+	if end < len(f.repositories) {
+		page.Next = "https://api.bitbucket.org/2.0/repositories/ctreminiom?page=next"
+	}
+
+	return page, nil, nil
+}
+
+func TestRepositories(t *testing.T) {
+
+	connector := &fakeRepositoryConnector{repositories: []*model.RepositoryScheme{
+		{Slug: "go-atlassian"}, {Slug: "docs"}, {Slug: "examples"},
+	}}
+
+	got, err := Collect(Repositories(context.Background(), connector, "ctreminiom", `is_private = true`, &Options{PageSize: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, connector.repositories, got)
+	assert.Equal(t, []model.PageOptions{
+		{Page: 1, PageLen: 2, Q: `is_private = true`},
+		{Page: 2, PageLen: 2, Q: `is_private = true`},
+	}, connector.options)
+}
+
+func TestRepositories_MaxItems(t *testing.T) {
+
+	connector := &fakeRepositoryConnector{}
+	for index := 0; index < 7; index++ {
+		connector.repositories = append(connector.repositories, &model.RepositoryScheme{Slug: strconv.Itoa(index)})
+	}
+
+	got, err := Collect(Repositories(context.Background(), connector, "ctreminiom", "", &Options{PageSize: 3, MaxItems: 5}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, connector.repositories[:5], got)
+	assert.Equal(t, []model.PageOptions{
+		{Page: 1, PageLen: 3},
+		{Page: 2, PageLen: 3},
+	}, connector.options)
+}
@@ -0,0 +1,30 @@
+package pager
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// Pages iterates over the Confluence pages matching the filtering options.
+//
+// GET /wiki/api/v2/pages
+func Pages(ctx context.Context, page confluence.PageConnector, opts *model.PageOptionsScheme, options *Options) iter.Seq2[*model.PageScheme, error] {
+
+	return Cursor(ctx, func(ctx context.Context, cursor string, limit int) (*Page[*model.PageScheme], error) {
+
+		chunk, _, err := page.Gets(ctx, opts, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &Page[*model.PageScheme]{Values: chunk.Results}
+		if chunk.Links != nil {
+			result.Next = CursorFromLink(chunk.Links.Next)
+		}
+
+		return result, nil
+	}, options)
+}
@@ -0,0 +1,41 @@
+package pager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+type fakePageConnector struct {
+	confluence.PageConnector
+	pages  map[string]*model.PageChunkScheme
+	limits []int
+}
+
+func (f *fakePageConnector) Gets(_ context.Context, _ *model.PageOptionsScheme, cursor string, limit int) (*model.PageChunkScheme, *model.ResponseScheme, error) {
+	f.limits = append(f.limits, limit)
+	return f.pages[cursor], nil, nil
+}
+
+func TestPages(t *testing.T) {
+
+	connector := &fakePageConnector{pages: map[string]*model.PageChunkScheme{
+		"": {
+			Results: []*model.PageScheme{{ID: "1"}, {ID: "2"}},
+			Links:   &model.PageChunkLinksScheme{Next: "/wiki/api/v2/pages?cursor=eyJpZCI6IjIifQ&limit=2"},
+		},
+		"eyJpZCI6IjIifQ": {
+			Results: []*model.PageScheme{{ID: "3"}},
+		},
+	}}
+
+	got, err := Collect(Pages(context.Background(), connector, nil, &Options{PageSize: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.PageScheme{{ID: "1"}, {ID: "2"}, {ID: "3"}}, got)
+	assert.Equal(t, []int{2, 2}, connector.limits)
+}
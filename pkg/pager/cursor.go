@@ -0,0 +1,51 @@
+package pager
+
+import (
+	"context"
+	"iter"
+)
+
+// CursorFunc fetches the page identified by cursor with up to limit items, the first page is requested with an empty cursor.
+type CursorFunc[T any] func(ctx context.Context, cursor string, limit int) (*Page[T], error)
+
+// TokenFunc fetches the page identified by token with up to maxResults items, the first page is requested with an empty token.
+type TokenFunc[T any] func(ctx context.Context, token string, maxResults int) (*Page[T], error)
+
+// Cursor returns an iterator over the items of a cursor paginated endpoint.
+//
+// The pages are requested sequentially, following Page.Next until it is empty or the page is flagged as the last one.
+// Options.Concurrency is ignored, as every cursor is only known once the previous page is fetched.
+func Cursor[T any](ctx context.Context, fetch CursorFunc[T], options *Options) iter.Seq2[T, error] {
+
+	return func(yield func(T, error) bool) {
+
+		e := &emitter[T]{yield: yield, max: options.maxItems()}
+
+		for cursor := ""; ; {
+
+			page, err := fetch(ctx, cursor, e.limit(options.pageSize()))
+			if err != nil {
+				e.fail(err)
+				return
+			}
+
+			if !e.emit(page.Values) {
+				return
+			}
+
+			// A cursor pointing to itself would never end the iteration.
+			if page.IsLast || page.Next == "" || page.Next == cursor {
+				return
+			}
+
+			cursor = page.Next
+		}
+	}
+}
+
+// Token returns an iterator over the items of a token paginated endpoint, such as the nextPageToken based Jira search.
+//
+// It behaves as Cursor, with the token of the next page carried by Page.Next.
+func Token[T any](ctx context.Context, fetch TokenFunc[T], options *Options) iter.Seq2[T, error] {
+	return Cursor(ctx, CursorFunc[T](fetch), options)
+}
@@ -0,0 +1,119 @@
+package pager
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cursorSource simulates a cursor paginated endpoint over the numbers from zero to total.
+type cursorSource struct {
+	total    int
+	failAt   int
+	limits   []int
+	selfLoop bool
+}
+
+func (s *cursorSource) fetch(_ context.Context, cursor string, limit int) (*Page[int], error) {
+
+	s.limits = append(s.limits, limit)
+
+	startAt := 0
+	if cursor != "" {
+		startAt, _ = strconv.Atoi(cursor)
+	}
+
+	if s.failAt > 0 && startAt >= s.failAt {
+		return nil, errors.New("client: atlassian internal error")
+	}
+
+	page := &Page[int]{}
+	for value := startAt; value < startAt+limit && value < s.total; value++ {
+		page.Values = append(page.Values, value)
+	}
+
+	switch {
+	case s.selfLoop:
+		page.Next = cursor
+	case startAt+limit < s.total:
+		page.Next = strconv.Itoa(startAt + limit)
+	}
+
+	return page, nil
+}
+
+func TestCursor(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		source     *cursorSource
+		options    *Options
+		want       []int
+		wantErr    string
+		wantLimits []int
+	}{
+		{
+			name:       "when the cursors are followed until the last page",
+			source:     &cursorSource{total: 25},
+			options:    &Options{PageSize: 10},
+			want:       sequence(0, 25),
+			wantLimits: []int{10, 10, 10},
+		},
+		{
+			name:       "when the max items shrink the last page",
+			source:     &cursorSource{total: 100},
+			options:    &Options{PageSize: 10, MaxItems: 15},
+			want:       sequence(0, 15),
+			wantLimits: []int{10, 5},
+		},
+		{
+			name:       "when the endpoint returns the same cursor",
+			source:     &cursorSource{total: 100, selfLoop: true},
+			options:    &Options{PageSize: 10},
+			want:       sequence(0, 10),
+			wantLimits: []int{10},
+		},
+		{
+			name:       "when a page cannot be fetched",
+			source:     &cursorSource{total: 100, failAt: 10},
+			options:    &Options{PageSize: 10},
+			want:       sequence(0, 10),
+			wantErr:    "client: atlassian internal error",
+			wantLimits: []int{10, 10},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := Collect(Cursor(context.Background(), testCase.source.fetch, testCase.options))
+
+			if testCase.wantErr != "" {
+				assert.EqualError(t, err, testCase.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.want, got)
+			assert.Equal(t, testCase.wantLimits, testCase.source.limits)
+		})
+	}
+}
+
+func TestToken(t *testing.T) {
+
+	tokens := map[string]*Page[string]{
+		"":       {Values: []string{"KP-1", "KP-2"}, Next: "page-2"},
+		"page-2": {Values: []string{"KP-3"}, Next: "page-3", IsLast: true},
+	}
+
+	got, err := Collect(Token(context.Background(), func(_ context.Context, token string, _ int) (*Page[string], error) {
+		return tokens[token], nil
+	}, nil))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, got)
+}
@@ -0,0 +1,75 @@
+package pager
+
+import (
+	"context"
+	"iter"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// GroupMembers iterates over the members of a group.
+//
+// GET /rest/api/{2-3}/group/member
+func GroupMembers(ctx context.Context, group jira.GroupConnector, groupName string, inactive bool, options *Options) iter.Seq2[*model.GroupUserDetailScheme, error] {
+
+	return Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*Page[*model.GroupUserDetailScheme], error) {
+
+		page, _, err := group.Members(ctx, groupName, inactive, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[*model.GroupUserDetailScheme]{Values: page.Values, Total: page.Total, IsLast: page.IsLast}, nil
+	}, options)
+}
+
+// Projects iterates over the projects matching the search options.
+//
+// GET /rest/api/{2-3}/project/search
+func Projects(ctx context.Context, project jira.ProjectConnector, opts *model.ProjectSearchOptionsScheme, options *Options) iter.Seq2[*model.ProjectScheme, error] {
+
+	return Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*Page[*model.ProjectScheme], error) {
+
+		page, _, err := project.Search(ctx, opts, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[*model.ProjectScheme]{Values: page.Values, Total: page.Total, IsLast: page.IsLast}, nil
+	}, options)
+}
+
+// IssueChangelogs iterates over the complete changelog of an issue, starting from the oldest history.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+func IssueChangelogs(ctx context.Context, changelog jira.ChangelogConnector, issueKeyOrID string, options *Options) iter.Seq2[*model.IssueChangelogHistoryScheme, error] {
+
+	return Offset(ctx, func(ctx context.Context, startAt, maxResults int) (*Page[*model.IssueChangelogHistoryScheme], error) {
+
+		page, _, err := changelog.Gets(ctx, issueKeyOrID, startAt, maxResults)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[*model.IssueChangelogHistoryScheme]{Values: page.Values, Total: page.Total, IsLast: page.IsLast}, nil
+	}, options)
+}
+
+// IssuesByJQL iterates over the issues matching the JQL query, following the nextPageToken of the enhanced search.
+//
+// The endpoint does not accept a page size, so Options.PageSize is ignored.
+//
+// GET /rest/api/3/search/jql
+func IssuesByJQL(ctx context.Context, search jira.SearchADFConnector, jql, fields string, failFast bool, options *Options) iter.Seq2[*model.IssueScheme, error] {
+
+	return Token(ctx, func(ctx context.Context, token string, _ int) (*Page[*model.IssueScheme], error) {
+
+		page, _, err := search.GetByJQLSearch(ctx, jql, fields, failFast, token)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[*model.IssueScheme]{Values: page.Issues, IsLast: page.IsLast, Next: page.NextPageToken}, nil
+	}, options)
+}
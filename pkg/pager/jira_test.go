@@ -0,0 +1,96 @@
+package pager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+type fakeGroupConnector struct {
+	jira.GroupConnector
+	members []*model.GroupUserDetailScheme
+}
+
+func (f *fakeGroupConnector) Members(_ context.Context, _ string, _ bool, startAt, maxResults int) (*model.GroupMemberPageScheme, *model.ResponseScheme, error) {
+
+	end := min(startAt+maxResults, len(f.members))
+
+	return &model.GroupMemberPageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(f.members),
+		IsLast:     end == len(f.members),
+		Values:     f.members[startAt:end],
+	}, nil, nil
+}
+
+type fakeChangelogConnector struct {
+	jira.ChangelogConnector
+	histories []*model.IssueChangelogHistoryScheme
+}
+
+func (f *fakeChangelogConnector) Gets(_ context.Context, _ string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	end := min(startAt+maxResults, len(f.histories))
+
+	return &model.IssueChangelogPageScheme{
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      len(f.histories),
+		IsLast:     end == len(f.histories),
+		Values:     f.histories[startAt:end],
+	}, nil, nil
+}
+
+type fakeSearchADFConnector struct {
+	jira.SearchADFConnector
+	pages map[string]*model.IssueSearchJQLScheme
+}
+
+func (f *fakeSearchADFConnector) GetByJQLSearch(_ context.Context, _, _ string, _ bool, nextPageToken string) (*model.IssueSearchJQLScheme, *model.ResponseScheme, error) {
+	return f.pages[nextPageToken], nil, nil
+}
+
+func TestGroupMembers(t *testing.T) {
+
+	connector := &fakeGroupConnector{members: []*model.GroupUserDetailScheme{
+		{AccountID: "1"}, {AccountID: "2"}, {AccountID: "3"}, {AccountID: "4"}, {AccountID: "5"},
+	}}
+
+	got, err := Collect(GroupMembers(context.Background(), connector, "jira-users", false, &Options{PageSize: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, connector.members, got)
+}
+
+func TestIssueChangelogs(t *testing.T) {
+
+	connector := &fakeChangelogConnector{histories: []*model.IssueChangelogHistoryScheme{
+		{ID: "10001"}, {ID: "10002"}, {ID: "10003"},
+	}}
+
+	got, err := Collect(IssueChangelogs(context.Background(), connector, "KP-1", &Options{PageSize: 2}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, connector.histories, got)
+}
+
+func TestIssuesByJQL(t *testing.T) {
+
+	connector := &fakeSearchADFConnector{pages: map[string]*model.IssueSearchJQLScheme{
+		"":         {Issues: []*model.IssueScheme{{Key: "KP-1"}, {Key: "KP-2"}}, NextPageToken: "CAEaAggD"},
+		"CAEaAggD": {Issues: []*model.IssueScheme{{Key: "KP-3"}}, IsLast: true},
+	}}
+
+	var keys []string
+	for issue, err := range IssuesByJQL(context.Background(), connector, "project = KP", "summary", false, nil) {
+		assert.NoError(t, err)
+		keys = append(keys, issue.Key)
+	}
+
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+}
@@ -0,0 +1,147 @@
+package pager
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// OffsetFunc fetches the page starting at startAt with up to maxResults items.
+type OffsetFunc[T any] func(ctx context.Context, startAt, maxResults int) (*Page[T], error)
+
+// Offset returns an iterator over the items of an offset paginated endpoint.
+//
+// The pages are requested sequentially until one of them is flagged as the last one, is empty or reaches the total.
+// When Options.Concurrency is greater than one and the first page reports the total,
+// the remaining pages are fetched in parallel and yielded in order.
+func Offset[T any](ctx context.Context, fetch OffsetFunc[T], options *Options) iter.Seq2[T, error] {
+
+	return func(yield func(T, error) bool) {
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		e := &emitter[T]{yield: yield, max: options.maxItems()}
+
+		first, err := fetch(ctx, 0, e.limit(options.pageSize()))
+		if err != nil {
+			e.fail(err)
+			return
+		}
+
+		if !e.emit(first.Values) || isLastOffsetPage(first, len(first.Values)) {
+			return
+		}
+
+		if options.concurrency() > 1 && first.Total > 0 {
+			offsetConcurrently(ctx, fetch, first, options, e)
+			return
+		}
+
+		for startAt := len(first.Values); ; {
+
+			page, err := fetch(ctx, startAt, e.limit(options.pageSize()))
+			if err != nil {
+				e.fail(err)
+				return
+			}
+
+			if !e.emit(page.Values) {
+				return
+			}
+
+			startAt += len(page.Values)
+
+			if isLastOffsetPage(page, startAt) {
+				return
+			}
+		}
+	}
+}
+
+// isLastOffsetPage reports whether no page follows the page, next being the offset of the following page.
+func isLastOffsetPage[T any](page *Page[T], next int) bool {
+	return page.IsLast || len(page.Values) == 0 || (page.Total > 0 && next >= page.Total)
+}
+
+type offsetResult[T any] struct {
+	page *Page[T]
+	err  error
+}
+
+// offsetConcurrently fetches the pages following the first one in parallel.
+//
+// The pages are aligned on the number of items returned by the first page, as the endpoints can cap the page size.
+// At most Options.Concurrency pages are in flight or waiting to be yielded at any time.
+func offsetConcurrently[T any](ctx context.Context, fetch OffsetFunc[T], first *Page[T], options *Options, e *emitter[T]) {
+
+	stride, total := len(first.Values), first.Total
+	if remaining := e.remaining(); remaining >= 0 && stride+remaining < total {
+		total = stride + remaining
+	}
+
+	var offsets []int
+	for startAt := stride; startAt < total; startAt += stride {
+		offsets = append(offsets, startAt)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	results := make([]chan offsetResult[T], len(offsets))
+	for index := range results {
+		results[index] = make(chan offsetResult[T], 1)
+	}
+
+	slots := make(chan struct{}, options.concurrency())
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for index, startAt := range offsets {
+
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func(result chan<- offsetResult[T], startAt int) {
+				defer wg.Done()
+
+				page, err := fetch(ctx, startAt, stride)
+				result <- offsetResult[T]{page: page, err: err}
+			}(results[index], startAt)
+		}
+	}()
+
+	for index := range offsets {
+
+		var result offsetResult[T]
+
+		select {
+		case result = <-results[index]:
+		case <-ctx.Done():
+			e.fail(ctx.Err())
+			return
+		}
+
+		<-slots
+
+		if result.err != nil {
+			e.fail(result.err)
+			return
+		}
+
+		if !e.emit(result.page.Values) || result.page.IsLast || len(result.page.Values) == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package pager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// offsetSource simulates an offset paginated endpoint over the numbers from zero to total, capping the page size.
+type offsetSource struct {
+	total   int
+	maxSize int
+	failAt  int
+
+	mu       sync.Mutex
+	requests []int
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (s *offsetSource) fetch(ctx context.Context, startAt, maxResults int) (*Page[int], error) {
+
+	current := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+
+	for {
+		peak := s.peak.Load()
+		if current <= peak || s.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, startAt)
+	s.mu.Unlock()
+
+	if s.failAt > 0 && startAt >= s.failAt {
+		return nil, errors.New("client: atlassian internal error")
+	}
+
+	if s.maxSize > 0 && maxResults > s.maxSize {
+		maxResults = s.maxSize
+	}
+
+	page := &Page[int]{Total: s.total}
+	for value := startAt; value < startAt+maxResults && value < s.total; value++ {
+		page.Values = append(page.Values, value)
+	}
+
+	return page, nil
+}
+
+func sequence(from, to int) []int {
+
+	var values []int
+	for value := from; value < to; value++ {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func TestOffset(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		source       *offsetSource
+		options      *Options
+		want         []int
+		wantErr      string
+		wantRequests int
+	}{
+		{
+			name:         "when the pages are fetched sequentially",
+			source:       &offsetSource{total: 23},
+			options:      &Options{PageSize: 10},
+			want:         sequence(0, 23),
+			wantRequests: 3,
+		},
+		{
+			name:         "when the endpoint caps the page size",
+			source:       &offsetSource{total: 23, maxSize: 5},
+			options:      &Options{PageSize: 10},
+			want:         sequence(0, 23),
+			wantRequests: 5,
+		},
+		{
+			name:         "when the options are not provided",
+			source:       &offsetSource{total: 120},
+			want:         sequence(0, 120),
+			wantRequests: 3,
+		},
+		{
+			name:         "when the max items are reached",
+			source:       &offsetSource{total: 100},
+			options:      &Options{PageSize: 10, MaxItems: 15},
+			want:         sequence(0, 15),
+			wantRequests: 2,
+		},
+		{
+			name:         "when the pages are fetched concurrently",
+			source:       &offsetSource{total: 95, maxSize: 10},
+			options:      &Options{PageSize: 20, Concurrency: 4},
+			want:         sequence(0, 95),
+			wantRequests: 10,
+		},
+		{
+			name:         "when the concurrent pages are limited by the max items",
+			source:       &offsetSource{total: 95},
+			options:      &Options{PageSize: 10, Concurrency: 3, MaxItems: 35},
+			want:         sequence(0, 35),
+			wantRequests: 4,
+		},
+		{
+			name:         "when the endpoint is empty",
+			source:       &offsetSource{},
+			options:      &Options{PageSize: 10},
+			wantRequests: 1,
+		},
+		{
+			name:         "when a page cannot be fetched",
+			source:       &offsetSource{total: 50, failAt: 20},
+			options:      &Options{PageSize: 10},
+			want:         sequence(0, 20),
+			wantErr:      "client: atlassian internal error",
+			wantRequests: 3,
+		},
+		{
+			name:    "when a concurrent page cannot be fetched",
+			source:  &offsetSource{total: 50, failAt: 20},
+			options: &Options{PageSize: 10, Concurrency: 2},
+			want:    sequence(0, 20),
+			wantErr: "client: atlassian internal error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := Collect(Offset(context.Background(), testCase.source.fetch, testCase.options))
+
+			if testCase.wantErr != "" {
+				assert.EqualError(t, err, testCase.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.want, got)
+
+			if testCase.wantRequests > 0 {
+				assert.Len(t, testCase.source.requests, testCase.wantRequests)
+			}
+
+			if concurrency := testCase.options.concurrency(); concurrency > 1 {
+				assert.LessOrEqual(t, int(testCase.source.peak.Load()), concurrency)
+			}
+		})
+	}
+}
+
+func TestOffset_Break(t *testing.T) {
+
+	source := &offsetSource{total: 1000}
+
+	var got []int
+	for value, err := range Offset(context.Background(), source.fetch, &Options{PageSize: 10, Concurrency: 4}) {
+
+		assert.NoError(t, err)
+		got = append(got, value)
+
+		if value == 24 {
+			break
+		}
+	}
+
+	assert.Equal(t, sequence(0, 25), got)
+	assert.Equal(t, int32(0), source.inFlight.Load())
+}
@@ -0,0 +1,164 @@
+// Package pager turns the paginated Atlassian endpoints into iter.Seq2 iterators.
+//
+// Three pagination styles are supported:
+//
+//  1. Offset pagination, driven by startAt and maxResults, used by most of the Jira and Agile endpoints.
+//  2. Cursor pagination, driven by an opaque cursor, used by Confluence v2 and the Admin APIs.
+//  3. Token pagination, driven by a nextPageToken, used by the enhanced Jira search.
+//
+// The iterators fetch the pages lazily, stop on the first error and honor the page size,
+// max items and concurrency limits provided through Options.
+//
+//	for issue, err := range pager.IssuesByJQL(ctx, client.Issue.Search, "project = KP", "summary", false, nil) {
+//		if err != nil {
+//			return err
+//		}
+//
+//		fmt.Println(issue.Key)
+//	}
+package pager
+
+import (
+	"iter"
+	"net/url"
+	"strings"
+)
+
+// DefaultPageSize is the number of items requested per page when Options.PageSize is not set.
+const DefaultPageSize = 50
+
+// Options configures the pagination of an iterator.
+type Options struct {
+	// PageSize is the number of items requested per page, DefaultPageSize is used when zero.
+	// The endpoints can return fewer items than requested, the iterators adapt to it.
+	PageSize int
+
+	// MaxItems stops the iteration once the number of items is yielded, zero means no limit.
+	MaxItems int
+
+	// Concurrency is the number of pages fetched in parallel.
+	// It only applies to the offset pagination once the first page reports the total,
+	// the items are still yielded in order. Values lower than two fetch the pages sequentially.
+	Concurrency int
+}
+
+// Page represents a page of items returned by a paginated endpoint.
+type Page[T any] struct {
+	Values []T    // The items of the page.
+	Total  int    // The total number of items, zero when the endpoint does not report it.
+	IsLast bool   // Whether the page is the last one.
+	Next   string // The cursor or token of the next page, empty on the last page.
+}
+
+func (o *Options) pageSize() int {
+
+	if o == nil || o.PageSize <= 0 {
+		return DefaultPageSize
+	}
+
+	return o.PageSize
+}
+
+func (o *Options) maxItems() int {
+
+	if o == nil || o.MaxItems < 0 {
+		return 0
+	}
+
+	return o.MaxItems
+}
+
+func (o *Options) concurrency() int {
+
+	if o == nil || o.Concurrency < 1 {
+		return 1
+	}
+
+	return o.Concurrency
+}
+
+// emitter yields the items of the pages while keeping track of the MaxItems limit.
+type emitter[T any] struct {
+	yield   func(T, error) bool
+	max     int
+	yielded int
+}
+
+// emit yields the values, it returns false when the iteration must stop.
+func (e *emitter[T]) emit(values []T) bool {
+
+	for _, value := range values {
+
+		if !e.yield(value, nil) {
+			return false
+		}
+
+		e.yielded++
+
+		if e.max > 0 && e.yielded >= e.max {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fail yields the error with the zero value of T.
+func (e *emitter[T]) fail(err error) {
+	var zero T
+	e.yield(zero, err)
+}
+
+// remaining returns the number of items left before reaching MaxItems, or -1 when there is no limit.
+func (e *emitter[T]) remaining() int {
+
+	if e.max == 0 {
+		return -1
+	}
+
+	return e.max - e.yielded
+}
+
+// limit returns the page size to request, reduced to the remaining items when MaxItems is close.
+func (e *emitter[T]) limit(pageSize int) int {
+
+	if remaining := e.remaining(); remaining >= 0 && remaining < pageSize {
+		return remaining
+	}
+
+	return pageSize
+}
+
+// Collect drains the iterator into a slice, returning the items gathered before the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+
+	var values []T
+	for value, err := range seq {
+
+		if err != nil {
+			return values, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// CursorFromLink extracts the cursor of the next page from a pagination link.
+//
+// The Confluence v2 and Admin APIs return the next page as a relative link carrying a cursor query parameter,
+// some endpoints return the bare cursor instead, in which case the link is returned as is.
+func CursorFromLink(link string) string {
+
+	if link == "" || !strings.Contains(link, "?") {
+		return link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+
+	return u.Query().Get("cursor")
+}
@@ -0,0 +1,28 @@
+package pager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorFromLink(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		link string
+		want string
+	}{
+		{name: "when the link is a relative url", link: "/wiki/api/v2/pages?limit=25&cursor=eyJpZCI6IjEwIn0", want: "eyJpZCI6IjEwIn0"},
+		{name: "when the link is an absolute url", link: "https://api.atlassian.com/admin/v1/orgs/org-id/users?cursor=abc%3D", want: "abc="},
+		{name: "when the link is a bare cursor", link: "eyJpZCI6IjEwIn0", want: "eyJpZCI6IjEwIn0"},
+		{name: "when the link has no cursor", link: "/wiki/api/v2/pages?limit=25", want: ""},
+		{name: "when the link is empty", link: "", want: ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, CursorFromLink(testCase.link))
+		})
+	}
+}
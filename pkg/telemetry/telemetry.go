@@ -0,0 +1,184 @@
+// Package telemetry instruments the API calls of the clients with OpenTelemetry.
+//
+// Every call sent through a client gets a span and feeds the request, error, 429 and latency metrics.
+// The endpoints are recorded as route templates, e.g. rest/api/3/issue/{issueIdOrKey},
+// so the cardinality of the span names and metric attributes stays bounded.
+//
+// The instrumentation is opt-in, it is enabled by setting it on a client:
+//
+//	client, err := v3.New(nil, "https://ctreminiom.atlassian.net", nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client.Instrumentation = telemetry.New()
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// ScopeName is the instrumentation scope of the tracer and the meter.
+const ScopeName = "github.com/ctreminiom/go-atlassian/v2"
+
+// The attributes recorded on the spans and the metrics.
+const (
+	ProductKey       = attribute.Key("atlassian.product")
+	ServiceKey       = attribute.Key("atlassian.service")
+	RateLimitWaitKey = attribute.Key("atlassian.rate_limit.wait")
+	MethodKey        = attribute.Key("http.request.method")
+	RouteKey         = attribute.Key("http.route")
+	StatusCodeKey    = attribute.Key("http.response.status_code")
+	ResendCountKey   = attribute.Key("http.request.resend_count")
+)
+
+// Option configures the Instrumentation.
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the provider of the tracer, the global one is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the provider of the meter, the global one is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = provider
+	}
+}
+
+// Instrumentation records a span and the metrics of every API call, it implements common.Instrumentation.
+type Instrumentation struct {
+	tracer trace.Tracer
+
+	requests      metric.Int64Counter
+	errors        metric.Int64Counter
+	rateLimited   metric.Int64Counter
+	duration      metric.Float64Histogram
+	rateLimitWait metric.Float64Histogram
+}
+
+var _ common.Instrumentation = (*Instrumentation)(nil)
+
+// New creates an Instrumentation, it can be shared by several clients.
+//
+// The errors raised while creating the instruments are reported to the global OpenTelemetry error handler.
+func New(opts ...Option) *Instrumentation {
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.tracerProvider == nil {
+		o.tracerProvider = otel.GetTracerProvider()
+	}
+
+	if o.meterProvider == nil {
+		o.meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := o.meterProvider.Meter(ScopeName)
+
+	return &Instrumentation{
+		tracer: o.tracerProvider.Tracer(ScopeName),
+		requests: handle(meter.Int64Counter("atlassian.client.requests",
+			metric.WithDescription("The number of API calls sent."),
+			metric.WithUnit("{request}"))),
+		errors: handle(meter.Int64Counter("atlassian.client.errors",
+			metric.WithDescription("The number of API calls that failed or returned an error status code."),
+			metric.WithUnit("{request}"))),
+		rateLimited: handle(meter.Int64Counter("atlassian.client.rate_limited",
+			metric.WithDescription("The number of 429 responses received, retries included."),
+			metric.WithUnit("{response}"))),
+		duration: handle(meter.Float64Histogram("atlassian.client.request.duration",
+			metric.WithDescription("The duration of the API calls, retries and waits included."),
+			metric.WithUnit("s"))),
+		rateLimitWait: handle(meter.Float64Histogram("atlassian.client.rate_limit.wait",
+			metric.WithDescription("The time the API calls waited for the client-side rate limiter."),
+			metric.WithUnit("s"))),
+	}
+}
+
+// StartCall starts the span of the call, the returned function ends it and records the metrics.
+func (i *Instrumentation) StartCall(ctx context.Context, call *common.CallInfo) (context.Context, func(*common.CallResult)) {
+
+	attributes := []attribute.KeyValue{
+		ProductKey.String(call.Product),
+		ServiceKey.String(call.Service),
+		MethodKey.String(call.Request.Method),
+		RouteKey.String(call.Route),
+	}
+
+	ctx, span := i.tracer.Start(ctx, fmt.Sprintf("%v %v", call.Request.Method, call.Route),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...))
+
+	return ctx, func(result *common.CallResult) {
+
+		failed := result.Err != nil
+
+		if result.Response != nil {
+			status := StatusCodeKey.Int(result.Response.StatusCode)
+
+			span.SetAttributes(status)
+			attributes = append(attributes, status)
+			failed = failed || result.Response.StatusCode >= 400
+		}
+
+		span.SetAttributes(
+			ResendCountKey.Int(result.Retries),
+			RateLimitWaitKey.Float64(result.RateLimitWait.Seconds()),
+		)
+
+		switch {
+		case result.Err != nil:
+			span.RecordError(result.Err)
+			span.SetStatus(codes.Error, result.Err.Error())
+		case failed:
+			span.SetStatus(codes.Error, result.Response.Status)
+		}
+
+		span.End()
+
+		set := metric.WithAttributes(attributes...)
+
+		i.requests.Add(ctx, 1, set)
+		i.duration.Record(ctx, result.Duration.Seconds(), set)
+		i.rateLimitWait.Record(ctx, result.RateLimitWait.Seconds(), set)
+
+		if failed {
+			i.errors.Add(ctx, 1, set)
+		}
+
+		if result.RateLimited > 0 {
+			i.rateLimited.Add(ctx, int64(result.RateLimited), set)
+		}
+	}
+}
+
+// handle reports the error of an instrument creation, the instrument returned along with it is still usable.
+func handle[T any](instrument T, err error) T {
+
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return instrument
+}
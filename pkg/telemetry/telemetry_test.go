@@ -0,0 +1,150 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+func newTestInstrumentation() (*Instrumentation, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	instrumentation := New(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+
+	return instrumentation, spans, reader
+}
+
+func newTestCall(t *testing.T) *common.CallInfo {
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", nil)
+	require.NoError(t, err)
+
+	return &common.CallInfo{
+		Product: "jira",
+		Service: "issue",
+		Route:   "rest/api/3/issue/{issueIdOrKey}",
+		Request: request,
+	}
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	return metrics
+}
+
+func sum(t *testing.T, aggregation metricdata.Aggregation) int64 {
+
+	data, ok := aggregation.(metricdata.Sum[int64])
+	require.True(t, ok)
+
+	var total int64
+	for _, point := range data.DataPoints {
+		total += point.Value
+	}
+
+	return total
+}
+
+func TestInstrumentation_StartCall(t *testing.T) {
+
+	instrumentation, spans, reader := newTestInstrumentation()
+
+	ctx, end := instrumentation.StartCall(context.Background(), newTestCall(t))
+	assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+
+	end(&common.CallResult{
+		Response:      &http.Response{StatusCode: http.StatusOK, Status: "200 OK"},
+		Duration:      250 * time.Millisecond,
+		Retries:       1,
+		RateLimited:   1,
+		RateLimitWait: 2 * time.Second,
+	})
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+
+	span := ended[0]
+	assert.Equal(t, "GET rest/api/3/issue/{issueIdOrKey}", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	assert.ElementsMatch(t, span.Attributes(), []any{
+		ProductKey.String("jira"),
+		ServiceKey.String("issue"),
+		MethodKey.String(http.MethodGet),
+		RouteKey.String("rest/api/3/issue/{issueIdOrKey}"),
+		StatusCodeKey.Int(http.StatusOK),
+		ResendCountKey.Int(1),
+		RateLimitWaitKey.Float64(2),
+	})
+
+	metrics := collect(t, reader)
+	assert.Equal(t, int64(1), sum(t, metrics["atlassian.client.requests"]))
+	assert.Equal(t, int64(1), sum(t, metrics["atlassian.client.rate_limited"]))
+	assert.NotContains(t, metrics, "atlassian.client.errors")
+
+	duration, ok := metrics["atlassian.client.request.duration"].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, 0.25, duration.DataPoints[0].Sum)
+}
+
+func TestInstrumentation_StartCall_Errors(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		result *common.CallResult
+	}{
+		{
+			name:   "when the call fails",
+			result: &common.CallResult{Err: errors.New("connection reset by peer")},
+		},
+		{
+			name:   "when the call returns an error status code",
+			result: &common.CallResult{Response: &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			instrumentation, spans, reader := newTestInstrumentation()
+
+			_, end := instrumentation.StartCall(context.Background(), newTestCall(t))
+			end(testCase.result)
+
+			require.Len(t, spans.Ended(), 1)
+			assert.Equal(t, codes.Error, spans.Ended()[0].Status().Code)
+
+			metrics := collect(t, reader)
+			assert.Equal(t, int64(1), sum(t, metrics["atlassian.client.errors"]))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// CommitConnector represents the Bitbucket Cloud repository commits.
+type CommitConnector interface {
+
+	// Gets returns a paginated list of the commits of the repository, in reverse chronological order.
+	//
+	// The commits can be filtered by the references they are reachable from, or not, and by path.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commits
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.CommitListOptionsScheme, opts *models.PageOptions) (*models.CommitPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified commit.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/commit/{commit}
+	Get(ctx context.Context, workspace, repoSlug, commit string) (*models.CommitScheme, *models.ResponseScheme, error)
+
+	// Diff returns the raw diff between two revisions, in the unified diff format.
+	//
+	// The spec is either a single commit, diffed against its first parent, or two revisions separated by "..".
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/diff/{spec}
+	Diff(ctx context.Context, workspace, repoSlug, spec string, options *models.DiffOptionsScheme) (string, *models.ResponseScheme, error)
+
+	// DiffStat returns a paginated list of the files changed between two revisions.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/diffstat/{spec}
+	DiffStat(ctx context.Context, workspace, repoSlug, spec string, opts *models.PageOptions) (*models.DiffStatPageScheme, *models.ResponseScheme, error)
+
+	// Patch returns the raw patch between two revisions, as a series of git format-patch messages.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/patch/{spec}
+	Patch(ctx context.Context, workspace, repoSlug, spec string) (string, *models.ResponseScheme, error)
+}
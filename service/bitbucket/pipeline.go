@@ -0,0 +1,154 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// PipelineConnector represents the Bitbucket Cloud pipelines.
+type PipelineConnector interface {
+
+	// Gets returns a paginated list of the pipelines of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.RepositoryPipelineRunsPageScheme, *models.ResponseScheme, error)
+
+	// Trigger runs a new pipeline on the target of the payload: a branch, a tag, a commit or a custom pipeline of either.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines
+	Trigger(ctx context.Context, workspace, repoSlug string, payload *models.PipelineTriggerPayloadScheme) (*models.RepositoryPipelineRun, *models.ResponseScheme, error)
+
+	// Get returns the specified pipeline.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}
+	Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*models.RepositoryPipelineRun, *models.ResponseScheme, error)
+
+	// Stop signals the specified pipeline to stop.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/stopPipeline
+	Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (*models.ResponseScheme, error)
+
+	// Step returns the specified step of a pipeline.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}
+	Step(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string) (*models.RepositoryPipelineRunStep, *models.ResponseScheme, error)
+
+	// Log returns the log of the specified step starting at offset, as a byte range of the whole log.
+	//
+	// An empty log is returned when the log has no byte past offset yet.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines/{pipeline_uuid}/steps/{step_uuid}/log
+	Log(ctx context.Context, workspace, repoSlug, pipelineUUID, stepUUID string, offset int64) ([]byte, *models.ResponseScheme, error)
+}
+
+// PipelineVariableConnector represents the Bitbucket Cloud repository pipeline variables.
+type PipelineVariableConnector interface {
+
+	// Gets returns a paginated list of the pipeline variables of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.RepositoryPipelineVariablesPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified repository pipeline variable.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Get(ctx context.Context, workspace, repoSlug, variableUUID string) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Create creates a repository pipeline variable, the value of a secured variable cannot be read back.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Update updates the specified repository pipeline variable.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, repoSlug, variableUUID string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Delete deletes the specified repository pipeline variable.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, variableUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineDeploymentVariableConnector represents the Bitbucket Cloud deployment environment variables.
+type PipelineDeploymentVariableConnector interface {
+
+	// Gets returns a paginated list of the variables of the deployment environment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+	Gets(ctx context.Context, workspace, repoSlug, environmentUUID string, opts *models.PageOptions) (*models.RepositoryPipelineVariablesPageScheme, *models.ResponseScheme, error)
+
+	// Create creates a variable on the deployment environment, the value of a secured variable cannot be read back.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables
+	Create(ctx context.Context, workspace, repoSlug, environmentUUID string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Update updates the specified variable of the deployment environment.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Delete deletes the specified variable of the deployment environment.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/deployments_config/environments/{environment_uuid}/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, environmentUUID, variableUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineWorkspaceVariableConnector represents the Bitbucket Cloud workspace pipeline variables.
+type PipelineWorkspaceVariableConnector interface {
+
+	// Gets returns a paginated list of the pipeline variables of the workspace.
+	//
+	// GET /2.0/workspaces/{workspace}/pipelines-config/variables
+	Gets(ctx context.Context, workspace string, opts *models.PageOptions) (*models.RepositoryPipelineVariablesPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified workspace pipeline variable.
+	//
+	// GET /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Get(ctx context.Context, workspace, variableUUID string) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Create creates a workspace pipeline variable, the value of a secured variable cannot be read back.
+	//
+	// POST /2.0/workspaces/{workspace}/pipelines-config/variables
+	Create(ctx context.Context, workspace string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Update updates the specified workspace pipeline variable.
+	//
+	// PUT /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Update(ctx context.Context, workspace, variableUUID string, payload *models.PipelineVariablePayloadScheme) (*models.RepositoryPipelineVariable, *models.ResponseScheme, error)
+
+	// Delete deletes the specified workspace pipeline variable.
+	//
+	// DELETE /2.0/workspaces/{workspace}/pipelines-config/variables/{variable_uuid}
+	Delete(ctx context.Context, workspace, variableUUID string) (*models.ResponseScheme, error)
+}
+
+// PipelineScheduleConnector represents the Bitbucket Cloud pipeline schedules.
+type PipelineScheduleConnector interface {
+
+	// Gets returns a paginated list of the pipeline schedules of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.PipelineSchedulePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pipeline schedule.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+	Get(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*models.PipelineScheduleScheme, *models.ResponseScheme, error)
+
+	// Create creates a pipeline schedule running the target of the payload following its cron pattern.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.PipelineSchedulePayloadScheme) (*models.PipelineScheduleScheme, *models.ResponseScheme, error)
+
+	// Update enables or disables the specified pipeline schedule.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+	Update(ctx context.Context, workspace, repoSlug, scheduleUUID string, enabled bool) (*models.PipelineScheduleScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified pipeline schedule.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pipelines_config/schedules/{schedule_uuid}
+	Delete(ctx context.Context, workspace, repoSlug, scheduleUUID string) (*models.ResponseScheme, error)
+}
@@ -0,0 +1,112 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// PullRequestConnector represents the Bitbucket Cloud pull requests.
+type PullRequestConnector interface {
+
+	// Gets returns a paginated list of the pull requests of the repository,
+	// filtered by states (OPEN, MERGED, DECLINED or SUPERSEDED), only the open ones are returned when no state is provided.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+	Gets(ctx context.Context, workspace, repoSlug string, states []string, opts *models.PageOptions) (*models.PullRequestsResponse, *models.ResponseScheme, error)
+
+	// Create creates a new pull request, the source branch is required.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.PullRequestPayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified open pull request.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestPayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Decline declines the specified pull request.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline
+	Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Merge merges the specified pull request.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge
+	Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestMergePayloadScheme) (*models.PullRequestScheme, *models.ResponseScheme, error)
+
+	// Approve approves the specified pull request as the authenticated user.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+	Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestParticipantScheme, *models.ResponseScheme, error)
+
+	// Unapprove removes the approval of the authenticated user from the specified pull request.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/approve
+	Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.ResponseScheme, error)
+
+	// RequestChanges requests changes on the specified pull request as the authenticated user.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/request-changes
+	RequestChanges(ctx context.Context, workspace, repoSlug string, pullRequestID int) (*models.PullRequestParticipantScheme, *models.ResponseScheme, error)
+
+	// Activity returns a paginated list of the activity of the specified pull request:
+	// the updates, approvals, change requests and comments.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/activity
+	Activity(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *models.PageOptions) (*models.PullRequestActivityPageScheme, *models.ResponseScheme, error)
+
+	// Commits returns a paginated list of the commits of the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/commits
+	Commits(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *models.PageOptions) (*models.CommitPageScheme, *models.ResponseScheme, error)
+
+	// Statuses returns a paginated list of the statuses of the last commit of the specified pull request.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/statuses
+	Statuses(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *models.PageOptions) (*models.CommitStatusPageScheme, *models.ResponseScheme, error)
+
+	// Diff returns the diff of the specified pull request, in the unified diff format.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+	Diff(ctx context.Context, workspace, repoSlug string, pullRequestID int) (string, *models.ResponseScheme, error)
+
+	// DiffStat returns a paginated list of the files changed by the specified pull request, with the number of lines added and removed.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+	DiffStat(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *models.PageOptions) (*models.DiffStatPageScheme, *models.ResponseScheme, error)
+}
+
+// PullRequestCommentConnector represents the Bitbucket Cloud pull request comments.
+type PullRequestCommentConnector interface {
+
+	// Gets returns a paginated list of the comments of the specified pull request, including the inline comments.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	Gets(ctx context.Context, workspace, repoSlug string, pullRequestID int, opts *models.PageOptions) (*models.PullRequestCommentPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified pull request comment.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	Get(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.PullRequestCommentScheme, *models.ResponseScheme, error)
+
+	// Add adds a comment to the specified pull request, the comment is anchored to a file and line when the payload is inline.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	Add(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *models.PullRequestCommentPayloadScheme) (*models.PullRequestCommentScheme, *models.ResponseScheme, error)
+
+	// Resolve resolves the specified comment thread.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+	Resolve(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.PullRequestCommentResolutionScheme, *models.ResponseScheme, error)
+
+	// Reopen reopens the specified resolved comment thread.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+	Reopen(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (*models.ResponseScheme, error)
+}
@@ -0,0 +1,55 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// BranchConnector represents the Bitbucket Cloud repository branches.
+type BranchConnector interface {
+
+	// Gets returns a paginated list of the branches of the repository, ordered by sort, e.g. -target.date.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+	Gets(ctx context.Context, workspace, repoSlug, sort string, opts *models.PageOptions) (*models.RefPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified branch.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	Get(ctx context.Context, workspace, repoSlug, name string) (*models.RefScheme, *models.ResponseScheme, error)
+
+	// Create creates a new branch pointing to the target of the payload.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.RefPayloadScheme) (*models.RefScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified branch, the main branch cannot be deleted.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	Delete(ctx context.Context, workspace, repoSlug, name string) (*models.ResponseScheme, error)
+}
+
+// TagConnector represents the Bitbucket Cloud repository tags.
+type TagConnector interface {
+
+	// Gets returns a paginated list of the tags of the repository, ordered by sort, e.g. -target.date.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+	Gets(ctx context.Context, workspace, repoSlug, sort string, opts *models.PageOptions) (*models.RefPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the specified tag.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	Get(ctx context.Context, workspace, repoSlug, name string) (*models.RefScheme, *models.ResponseScheme, error)
+
+	// Create creates a new tag pointing to the target of the payload, the tag is annotated when the payload has a message.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/refs/tags
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.RefPayloadScheme) (*models.RefScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified tag.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	Delete(ctx context.Context, workspace, repoSlug, name string) (*models.ResponseScheme, error)
+}
@@ -55,38 +55,113 @@ type RepositoryConnector interface {
 
 // RepositoryForkConnector represents the Bitbucket Cloud repository forks.
 type RepositoryForkConnector interface {
-	Gets(ctx context.Context)
-	Execute(ctx context.Context)
+
+	// Gets returns a paginated list of all the forks of the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/forks
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.RepositoryPageScheme, *models.ResponseScheme, error)
+
+	// Execute creates a new fork of the specified repository.
+	//
+	// The fork is created in the workspace of the payload, or in the workspace of the authenticated user when it is not set.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/forks
+	Execute(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryForkPayloadScheme) (*models.RepositoryScheme, *models.ResponseScheme, error)
 }
 
 // RepositoryWebhookConnector represents the Bitbucket Cloud repository webhooks.
 type RepositoryWebhookConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Create(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
+
+	// Gets returns a paginated list of webhooks installed on this repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/hooks
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.WebhookSubscriptionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the webhook with the specified id installed on the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Get(ctx context.Context, workspace, repoSlug, webhookID string) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Create creates a new webhook on the specified repository.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/hooks
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.WebhookSubscriptionPayloadScheme) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Update updates the specified webhook subscription.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Update(ctx context.Context, workspace, repoSlug, webhookID string, payload *models.WebhookSubscriptionPayloadScheme) (*models.WebhookSubscriptionScheme, *models.ResponseScheme, error)
+
+	// Delete deletes the specified webhook subscription from the given repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/hooks/{uid}
+	Delete(ctx context.Context, workspace, repoSlug, webhookID string) (*models.ResponseScheme, error)
 }
 
 // RepositorySettingConnector represents the Bitbucket Cloud repository settings.
 type RepositorySettingConnector interface {
-	Gets(ctx context.Context)
-	Set(ctx context.Context)
+
+	// Gets returns the settings inheritance state of the repository,
+	// i.e. whether it overrides the merge strategy and branching model of its project.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/override-settings
+	Gets(ctx context.Context, workspace, repoSlug string) (*models.RepositoryInheritanceStateScheme, *models.ResponseScheme, error)
+
+	// Set updates the settings inheritance state of the repository.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/override-settings
+	Set(ctx context.Context, workspace, repoSlug string, payload *models.RepositoryInheritanceStateScheme) (*models.ResponseScheme, error)
 }
 
 // RepositoryGroupPermissionConnector represents the Bitbucket Cloud repository group permissions.
 type RepositoryGroupPermissionConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
+
+	// Gets returns a paginated list of the explicit group permissions of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.RepositoryGroupPermissionsPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the group on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Get(ctx context.Context, workspace, repoSlug, groupSlug string) (*models.RepositoryGroupPermissionsScheme, *models.ResponseScheme, error)
+
+	// Update sets the explicit permission of the group on the repository, the permission can be read, write or admin.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Update(ctx context.Context, workspace, repoSlug, groupSlug, permission string) (*models.RepositoryGroupPermissionsScheme, *models.ResponseScheme, error)
+
+	// Delete removes the explicit permission of the group on the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	Delete(ctx context.Context, workspace, repoSlug, groupSlug string) (*models.ResponseScheme, error)
 }
 
 // RepositoryUserPermissionConnector represents the Bitbucket Cloud repository user permissions.
 type RepositoryUserPermissionConnector interface {
-	Gets(ctx context.Context)
-	Get(ctx context.Context)
-	Update(ctx context.Context)
-	Delete(ctx context.Context)
-	Check(ctx context.Context)
+
+	// Gets returns a paginated list of the explicit user permissions of the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users
+	Gets(ctx context.Context, workspace, repoSlug string, opts *models.PageOptions) (*models.RepositoryPermissionPageScheme, *models.ResponseScheme, error)
+
+	// Get returns the explicit permission of the user on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Get(ctx context.Context, workspace, repoSlug, userID string) (*models.RepositoryPermissionScheme, *models.ResponseScheme, error)
+
+	// Update sets the explicit permission of the user on the repository, the permission can be read, write or admin.
+	//
+	// PUT /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Update(ctx context.Context, workspace, repoSlug, userID, permission string) (*models.RepositoryPermissionScheme, *models.ResponseScheme, error)
+
+	// Delete removes the explicit permission of the user on the repository.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	Delete(ctx context.Context, workspace, repoSlug, userID string) (*models.ResponseScheme, error)
+
+	// Check returns the permission the authenticated user has on the repository.
+	//
+	// GET /2.0/user/permissions/repositories
+	Check(ctx context.Context, workspace, repoSlug string) (*models.RepositoryPermissionPageScheme, *models.ResponseScheme, error)
 }
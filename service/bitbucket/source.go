@@ -0,0 +1,33 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// SourceConnector represents the Bitbucket Cloud repository source browsing.
+type SourceConnector interface {
+
+	// Gets returns a paginated listing of the directory at the given commit, branch or tag.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+	Gets(ctx context.Context, workspace, repoSlug, commit, path string, opts *models.PageOptions) (*models.SourcePageScheme, *models.ResponseScheme, error)
+
+	// Get returns the raw content of the file at the given commit, branch or tag.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+	Get(ctx context.Context, workspace, repoSlug, commit, path string) ([]byte, *models.ResponseScheme, error)
+
+	// Metadata returns the metadata of the file or directory at the given commit, branch or tag.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+	Metadata(ctx context.Context, workspace, repoSlug, commit, path string) (*models.SourceScheme, *models.ResponseScheme, error)
+
+	// Commit creates a new commit adding, updating and deleting the files of the payload.
+	//
+	// The files are posted as multipart/form-data, the new commit is referenced by the Location header of the response.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/src
+	Commit(ctx context.Context, workspace, repoSlug string, payload *models.SourceCommitPayloadScheme) (*models.ResponseScheme, error)
+}
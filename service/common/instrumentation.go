@@ -0,0 +1,32 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Instrumentation observes the API calls sent through a Transport, e.g. to trace them or record metrics.
+type Instrumentation interface {
+	// StartCall is called before the first attempt of a call, the attempts are sent with the returned context.
+	// The returned function is called once the call is done, with its outcome.
+	StartCall(ctx context.Context, call *CallInfo) (context.Context, func(*CallResult))
+}
+
+// CallInfo describes an API call to the Instrumentation.
+type CallInfo struct {
+	Product string        // The product of the client sending the call, e.g. jira or bitbucket.
+	Service string        // The service the call belongs to, e.g. issue, see RouteTemplate.
+	Route   string        // The templated route of the call, e.g. rest/api/3/issue/{issueIdOrKey}.
+	Request *http.Request // The request of the first attempt.
+}
+
+// CallResult is the outcome of an API call.
+type CallResult struct {
+	Response      *http.Response // The response of the last attempt, nil when it failed.
+	Err           error          // The error of the last attempt.
+	Duration      time.Duration  // The duration of the call, retries and waits included.
+	Retries       int            // The number of attempts after the first one.
+	RateLimited   int            // The number of 429 responses received.
+	RateLimitWait time.Duration  // The time spent waiting for the RateLimiter.
+}
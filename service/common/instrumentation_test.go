@@ -0,0 +1,70 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+type contextKey struct{}
+
+// recorder is an Instrumentation keeping the calls it observes.
+type recorder struct {
+	calls   []*CallInfo
+	results []*CallResult
+}
+
+func (r *recorder) StartCall(ctx context.Context, call *CallInfo) (context.Context, func(*CallResult)) {
+
+	r.calls = append(r.calls, call)
+
+	return context.WithValue(ctx, contextKey{}, call.Route), func(result *CallResult) {
+		r.results = append(r.results, result)
+	}
+}
+
+func TestTransport_Do_Instrumentation(t *testing.T) {
+
+	client := mocks.NewHTTPClient(t)
+	client.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Context().Value(contextKey{}) == "rest/api/3/issue/{issueIdOrKey}"
+	})).
+		Return(newTestResponse(http.StatusTooManyRequests, "", nil), nil).
+		Once()
+	client.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(newTestResponse(http.StatusOK, "{}", nil), nil).
+		Once()
+
+	recorder := &recorder{}
+
+	transport := NewTransport(client, &DefaultRetryPolicy{MaxRetries: 2, InitialDelay: time.Microsecond, MaxDelay: time.Millisecond})
+	transport.Limiter = NewRateLimiter(0, 1)
+	transport.Instrumentation = recorder
+	transport.Product = "jira"
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1", nil)
+	require.NoError(t, err)
+
+	response, err := transport.Do(request)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	require.Len(t, recorder.calls, 1)
+	assert.Equal(t, "jira", recorder.calls[0].Product)
+	assert.Equal(t, "issue", recorder.calls[0].Service)
+	assert.Equal(t, "rest/api/3/issue/{issueIdOrKey}", recorder.calls[0].Route)
+
+	require.Len(t, recorder.results, 1)
+	assert.Same(t, response, recorder.results[0].Response)
+	assert.NoError(t, recorder.results[0].Err)
+	assert.Equal(t, 1, recorder.results[0].Retries)
+	assert.Equal(t, 1, recorder.results[0].RateLimited)
+	assert.Positive(t, recorder.results[0].Duration)
+}
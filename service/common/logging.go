@@ -0,0 +1,86 @@
+package common
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces the values of the credentials in the logs.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are the headers carrying credentials, they are never logged.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveParameters are the fragments of the query parameter names carrying credentials, e.g. jwt or access_token.
+var sensitiveParameters = []string{"token", "jwt", "secret", "password"}
+
+// discardHandler is a slog.Handler dropping every record.
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+// DiscardLogger is the logger used by the clients when none is configured.
+var DiscardLogger = slog.New(discardHandler{})
+
+// RedactHeader returns a copy of the header with the values of the credential headers redacted.
+func RedactHeader(header http.Header) http.Header {
+
+	clone := header.Clone()
+
+	for _, name := range sensitiveHeaders {
+		if _, ok := clone[name]; ok {
+			clone[name] = []string{redacted}
+		}
+	}
+
+	return clone
+}
+
+// RedactURL returns the URL as a string, with the values of the credential query parameters redacted.
+func RedactURL(u *url.URL) string {
+
+	if u == nil {
+		return ""
+	}
+
+	if u.RawQuery == "" && u.User == nil {
+		return u.String()
+	}
+
+	clone := *u
+	clone.User = nil
+
+	query := clone.Query()
+	for name := range query {
+
+		lower := strings.ToLower(name)
+
+		for _, fragment := range sensitiveParameters {
+			if strings.Contains(lower, fragment) {
+				query[name] = []string{redacted}
+				break
+			}
+		}
+	}
+
+	clone.RawQuery = query.Encode()
+
+	return clone.String()
+}
+
+// headerAttr groups the header values as a log attribute.
+func headerAttr(header http.Header) slog.Attr {
+
+	attrs := make([]any, 0, len(header))
+	for name, values := range RedactHeader(header) {
+		attrs = append(attrs, slog.String(name, strings.Join(values, ", ")))
+	}
+
+	return slog.Group("header", attrs...)
+}
@@ -0,0 +1,324 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// RateLimiter paces the requests of the clients before Atlassian rejects them.
+//
+// It combines two budgets:
+//
+//  1. A token bucket refilled at Rate requests per second, holding up to Burst requests.
+//  2. The budget reported by the server through the X-RateLimit-Remaining and X-RateLimit-Reset headers,
+//     and the Retry-After header of the 429 responses, pausing every request until the window resets.
+//
+// The requests waiting for the server window are released one after the other once it resets, spaced by 1/Rate,
+// or by 100ms without a token bucket, instead of all at once.
+//
+// A RateLimiter is safe for concurrent use, it is shared by every service of a client,
+// and by several clients when set on each of them or obtained with SharedRateLimiter.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	limit       int
+	remaining   int
+	reset       time.Time
+	pausedUntil time.Time
+	queued      time.Time // The time the last request waiting for the server window is released at.
+
+	now func() time.Time
+}
+
+// rateLimitSpacing spaces the requests released after the server window when the token bucket is disabled.
+const rateLimitSpacing = 100 * time.Millisecond
+
+// RateLimitBudget is a snapshot of the budget of a RateLimiter, schedulers use it to back off early.
+type RateLimitBudget struct {
+	Rate        float64       // The requests per second allowed by the token bucket, zero when only the server budget applies.
+	Burst       int           // The capacity of the token bucket.
+	Tokens      float64       // The requests available in the token bucket, negative when requests are waiting.
+	Limit       int           // The limit last reported by the server, -1 when unknown.
+	Remaining   int           // The requests left in the server window, estimated since the last response, -1 when unknown.
+	Reset       time.Time     // The end of the server window, zero when unknown.
+	PausedUntil time.Time     // The time every request waits for after a 429 response, zero when not paused.
+	Delay       time.Duration // How long a request sent now would wait.
+}
+
+// NewRateLimiter returns a RateLimiter allowing rate requests per second with bursts of burst requests.
+//
+// A zero rate disables the token bucket, the requests are then only paced by the budget reported by the server.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:      rate,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		limit:     -1,
+		remaining: -1,
+		now:       time.Now,
+	}
+}
+
+var sharedRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]*RateLimiter
+}{limiters: make(map[string]*RateLimiter)}
+
+// SharedRateLimiter returns the RateLimiter shared by the clients of the site, created with rate and burst on the first call.
+func SharedRateLimiter(site *url.URL, rate float64, burst int) *RateLimiter {
+
+	sharedRateLimiters.Lock()
+	defer sharedRateLimiters.Unlock()
+
+	key := strings.ToLower(site.Host)
+	if limiter, ok := sharedRateLimiters.limiters[key]; ok {
+		return limiter
+	}
+
+	limiter := NewRateLimiter(rate, burst)
+	sharedRateLimiters.limiters[key] = limiter
+
+	return limiter
+}
+
+// RateLimiterFromConfig returns the RateLimiter configured by config for a client of the site,
+// nil when the client-side rate limiting is not enabled.
+func RateLimiterFromConfig(site *url.URL, config *models.ClientConfig) *RateLimiter {
+
+	if config == nil || (config.RateLimit <= 0 && !config.ShareRateLimit) {
+		return nil
+	}
+
+	if config.ShareRateLimit {
+		return SharedRateLimiter(site, config.RateLimit, config.RateLimitBurst)
+	}
+
+	return NewRateLimiter(config.RateLimit, config.RateLimitBurst)
+}
+
+// Wait blocks until a request can be sent and returns how long it waited, or the context error if it is done first.
+func (l *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		l.release()
+		return 0, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// Update adjusts the server budget from the rate limit headers of the response.
+func (l *RateLimiter) Update(response *http.Response) {
+
+	if response == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	header := response.Header
+
+	if limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit")); err == nil {
+		l.limit = limit
+	}
+
+	if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+
+		l.remaining = remaining
+		l.reset = time.Time{}
+
+		if reset, ok := parseRateLimitReset(header.Get("X-RateLimit-Reset"), now); ok {
+			l.reset = reset
+		}
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+
+		pause, ok := ParseRetryAfter(header)
+		if !ok && l.reset.After(now) {
+			pause, ok = l.reset.Sub(now), true
+		}
+
+		if ok && now.Add(pause).After(l.pausedUntil) {
+			l.pausedUntil = now.Add(pause)
+		}
+	}
+}
+
+// Budget returns the current budget of the limiter.
+func (l *RateLimiter) Budget() RateLimitBudget {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	delay, _ := l.queue(now)
+
+	return RateLimitBudget{
+		Rate:        l.rate,
+		Burst:       int(l.burst),
+		Tokens:      l.tokens,
+		Limit:       l.limit,
+		Remaining:   l.remaining,
+		Reset:       l.reset,
+		PausedUntil: l.pausedUntil,
+		Delay:       delay,
+	}
+}
+
+// reserve takes a request from the budgets and returns how long to wait before sending it.
+func (l *RateLimiter) reserve() time.Duration {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	delay, queued := l.queue(now)
+	if queued {
+		l.queued = now.Add(delay)
+	}
+
+	if l.rate > 0 {
+		l.tokens--
+	}
+
+	if l.remaining > 0 {
+		l.remaining--
+	}
+
+	return delay
+}
+
+// release gives back the request taken by a canceled reservation.
+func (l *RateLimiter) release() {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens = min(l.tokens+1, l.burst)
+	}
+}
+
+// queue returns how long a request sent now waits, and whether it waits behind the requests held by the server window,
+// the lock must be held.
+func (l *RateLimiter) queue(now time.Time) (time.Duration, bool) {
+
+	delay := l.delay(now, 1)
+
+	if l.serverDelay(now) == 0 && !l.queued.After(now) {
+		return delay, false
+	}
+
+	return max(delay, l.queued.Add(l.spacing()).Sub(now)), true
+}
+
+// delay returns how long a request waits for the budgets to hold n requests, the lock must be held.
+func (l *RateLimiter) delay(now time.Time, n float64) time.Duration {
+
+	delay := l.serverDelay(now)
+
+	if l.rate > 0 && l.tokens < n {
+		delay = max(delay, time.Duration((n-l.tokens)/l.rate*float64(time.Second)))
+	}
+
+	return delay
+}
+
+// serverDelay returns how long the requests are held by a 429 response or an exhausted window, the lock must be held.
+func (l *RateLimiter) serverDelay(now time.Time) time.Duration {
+
+	var delay time.Duration
+
+	if l.pausedUntil.After(now) {
+		delay = l.pausedUntil.Sub(now)
+	}
+
+	if l.remaining == 0 && l.reset.After(now) {
+		delay = max(delay, l.reset.Sub(now))
+	}
+
+	return delay
+}
+
+// spacing returns the time between two requests released after the server window.
+func (l *RateLimiter) spacing() time.Duration {
+
+	if l.rate > 0 {
+		return time.Duration(float64(time.Second) / l.rate)
+	}
+
+	return rateLimitSpacing
+}
+
+// refill adds the tokens earned since the last call and forgets the server window once reset, the lock must be held.
+func (l *RateLimiter) refill(now time.Time) {
+
+	if l.rate > 0 && !l.last.IsZero() && now.After(l.last) {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	}
+
+	l.last = now
+
+	if !l.reset.IsZero() && !now.Before(l.reset) {
+		l.remaining = -1
+		l.reset = time.Time{}
+	}
+}
+
+// parseRateLimitReset parses the X-RateLimit-Reset header, either an RFC 3339 timestamp as sent by Jira,
+// or a number of seconds, since the epoch when large enough and relative to now otherwise.
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	if reset, err := time.Parse(time.RFC3339, value); err == nil {
+		return reset, true
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return time.Time{}, false
+	}
+
+	// Anything past a year of seconds is an epoch timestamp.
+	if seconds > 365*24*60*60 {
+		return time.Unix(seconds, 0), true
+	}
+
+	return now.Add(time.Duration(seconds) * time.Second), true
+}
@@ -0,0 +1,248 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+// newTestRateLimiter returns a limiter whose clock only moves when the returned function is called.
+func newTestRateLimiter(rate float64, burst int) (*RateLimiter, func(time.Duration)) {
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	limiter := NewRateLimiter(rate, burst)
+	limiter.now = func() time.Time { return now }
+
+	return limiter, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestRateLimiter_TokenBucket(t *testing.T) {
+
+	limiter, advance := newTestRateLimiter(10, 2)
+
+	// The burst is let through, the next requests are spaced by 1/rate.
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve())
+	assert.Equal(t, 200*time.Millisecond, limiter.reserve())
+
+	advance(time.Second)
+
+	budget := limiter.Budget()
+	assert.InDelta(t, 2, budget.Tokens, 0.001)
+	assert.Equal(t, time.Duration(0), budget.Delay)
+}
+
+func TestRateLimiter_ServerBudget(t *testing.T) {
+
+	limiter, advance := newTestRateLimiter(0, 1)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	header.Set("X-RateLimit-Remaining", "1")
+	header.Set("X-RateLimit-Reset", "2025-01-01T00:00:30Z")
+
+	limiter.Update(&http.Response{StatusCode: http.StatusOK, Header: header})
+
+	// The last request of the window goes through, the next ones wait for the window to reset.
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+	assert.Equal(t, 30*time.Second, limiter.reserve())
+
+	budget := limiter.Budget()
+	assert.Equal(t, 100, budget.Limit)
+	assert.Equal(t, 0, budget.Remaining)
+	assert.Equal(t, 30*time.Second+rateLimitSpacing, budget.Delay)
+
+	advance(30 * time.Second)
+
+	budget = limiter.Budget()
+	assert.Equal(t, -1, budget.Remaining)
+	assert.Equal(t, time.Duration(0), budget.Delay)
+}
+
+func TestRateLimiter_TooManyRequests(t *testing.T) {
+
+	limiter, advance := newTestRateLimiter(0, 1)
+
+	header := http.Header{}
+	header.Set("Retry-After", "5")
+
+	limiter.Update(&http.Response{StatusCode: http.StatusTooManyRequests, Header: header})
+
+	// Every request is paused, not only the one rejected.
+	assert.Equal(t, 5*time.Second, limiter.reserve())
+	assert.Equal(t, 5*time.Second+rateLimitSpacing, limiter.reserve())
+
+	advance(6 * time.Second)
+	assert.Equal(t, time.Duration(0), limiter.reserve())
+}
+
+func TestRateLimiter_Release(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		rate    float64
+		spacing time.Duration
+	}{
+		{name: "when the token bucket is disabled", rate: 0, spacing: rateLimitSpacing},
+		{name: "when the token bucket is enabled", rate: 20, spacing: 50 * time.Millisecond},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			limiter, _ := newTestRateLimiter(testCase.rate, 100)
+
+			header := http.Header{}
+			header.Set("X-RateLimit-Remaining", "0")
+			header.Set("X-RateLimit-Reset", "10")
+
+			limiter.Update(&http.Response{StatusCode: http.StatusOK, Header: header})
+
+			var (
+				wg     sync.WaitGroup
+				delays = make(chan time.Duration, 50)
+			)
+
+			for range 50 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					delays <- limiter.reserve()
+				}()
+			}
+
+			wg.Wait()
+			close(delays)
+
+			// The waiters are released one after the other from the reset, not all at once.
+			released := make(map[time.Duration]bool)
+			for delay := range delays {
+				assert.False(t, released[delay], "two waiters are released %v from now", delay)
+				released[delay] = true
+			}
+
+			for index := range 50 {
+				assert.True(t, released[10*time.Second+time.Duration(index)*testCase.spacing])
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+
+	limiter := NewRateLimiter(1, 1)
+
+	waited, err := limiter.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), waited)
+
+	// The bucket is empty for a second, the context is done first and the token is given back.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.InDelta(t, 0, limiter.Budget().Tokens, 0.1)
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		value  string
+		want   time.Time
+		wantOK bool
+	}{
+		{value: "2025-01-01T00:01:00Z", want: now.Add(time.Minute), wantOK: true},
+		{value: "30", want: now.Add(30 * time.Second), wantOK: true},
+		{value: "1735689660", want: time.Unix(1735689660, 0), wantOK: true},
+		{value: ""},
+		{value: "soon"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+
+			got, ok := parseRateLimitReset(testCase.value, now)
+
+			assert.Equal(t, testCase.wantOK, ok)
+			assert.True(t, testCase.want.Equal(got))
+		})
+	}
+}
+
+func TestRateLimiterFromConfig(t *testing.T) {
+
+	site, err := url.Parse("https://ctreminiom.atlassian.net/")
+	require.NoError(t, err)
+
+	assert.Nil(t, RateLimiterFromConfig(site, nil))
+	assert.Nil(t, RateLimiterFromConfig(site, &models.ClientConfig{}))
+
+	config := &models.ClientConfig{RateLimit: 5, RateLimitBurst: 10}
+	assert.NotSame(t, RateLimiterFromConfig(site, config), RateLimiterFromConfig(site, config))
+
+	config.ShareRateLimit = true
+	shared := RateLimiterFromConfig(site, config)
+
+	assert.Same(t, shared, RateLimiterFromConfig(site, config))
+	assert.Same(t, shared, SharedRateLimiter(&url.URL{Scheme: "https", Host: "CTREMINIOM.atlassian.net"}, 1, 1))
+	assert.Equal(t, RateLimitBudget{Rate: 5, Burst: 10, Tokens: 10, Limit: -1, Remaining: -1}, shared.Budget())
+}
+
+func TestTransport_Do_RateLimiter(t *testing.T) {
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "0")
+	header.Set("X-RateLimit-Reset", "60")
+
+	client := mocks.NewHTTPClient(t)
+	client.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(newTestResponse(http.StatusOK, "{}", header), nil).
+		Once()
+
+	limiter := NewRateLimiter(0, 1)
+
+	transport := NewTransport(client, NoRetry)
+	transport.Limiter = limiter
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	require.NoError(t, err)
+
+	response, err := transport.Do(request)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	// The server reported an exhausted window, the concurrent requests wait for it instead of being rejected.
+	budget := limiter.Budget()
+	assert.Equal(t, 0, budget.Remaining)
+	assert.InDelta(t, time.Minute, budget.Delay, float64(time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := transport.Do(request.WithContext(ctx))
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		}()
+	}
+	wg.Wait()
+}
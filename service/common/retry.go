@@ -0,0 +1,210 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryJitter is the fraction of the backoff delay added at random by the policies built with NewRetryPolicy.
+const DefaultRetryJitter = 0.2
+
+// RetryPolicy decides whether a request sent through a Transport is attempted again.
+//
+// Retry is called after every attempt with the zero-based attempt number, the request that was sent
+// and either the response received or the error returned by the HTTPClient.
+// It returns how long to wait before the next attempt and whether the request should be retried at all.
+type RetryPolicy interface {
+	Retry(attempt int, request *http.Request, response *http.Response, err error) (time.Duration, bool)
+}
+
+// NoRetry is a RetryPolicy that never retries a request.
+var NoRetry RetryPolicy = noRetryPolicy{}
+
+type noRetryPolicy struct{}
+
+func (noRetryPolicy) Retry(int, *http.Request, *http.Response, error) (time.Duration, bool) {
+	return 0, false
+}
+
+// NewRetryPolicy returns a DefaultRetryPolicy using the retry settings shared by the product clients.
+func NewRetryPolicy(maxRetries int, initialDelay, maxDelay time.Duration) *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		MaxRetries:   maxRetries,
+		InitialDelay: initialDelay,
+		MaxDelay:     maxDelay,
+		Jitter:       DefaultRetryJitter,
+	}
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by the product clients.
+//
+// It retries 429 responses for every method, since Atlassian rejects rate limited requests before processing them.
+//
+// 503 responses and transient network errors are only retried for idempotent requests: GET, HEAD, OPTIONS, PUT and DELETE,
+// requests carrying an Idempotency-Key header, or any request when RetryNonIdempotent is set.
+//
+// The delay grows exponentially from InitialDelay up to MaxDelay, unless the response carries a Retry-After header.
+type DefaultRetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// InitialDelay is the delay before the first retry.
+	InitialDelay time.Duration
+	// MaxDelay caps the exponential backoff, zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, added at random to spread concurrent retries.
+	Jitter float64
+	// RetryNonIdempotent allows POST and PATCH requests to be retried on 503 responses and network errors.
+	RetryNonIdempotent bool
+}
+
+// Retry implements RetryPolicy.
+func (p *DefaultRetryPolicy) Retry(attempt int, request *http.Request, response *http.Response, err error) (time.Duration, bool) {
+
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	switch {
+	case err != nil:
+		if !IsTransientError(err) || !p.idempotent(request) {
+			return 0, false
+		}
+
+	case response.StatusCode == http.StatusTooManyRequests:
+
+	case response.StatusCode == http.StatusServiceUnavailable:
+		if !p.idempotent(request) {
+			return 0, false
+		}
+
+	default:
+		return 0, false
+	}
+
+	if response != nil {
+		if delay, ok := ParseRetryAfter(response.Header); ok {
+			return delay, true
+		}
+	}
+
+	return p.jitter(p.backoff(attempt)), true
+}
+
+func (p *DefaultRetryPolicy) backoff(attempt int) time.Duration {
+
+	delay := p.InitialDelay
+	for i := 0; i < attempt; i++ {
+
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay
+}
+
+func (p *DefaultRetryPolicy) jitter(delay time.Duration) time.Duration {
+
+	if p.Jitter <= 0 || delay <= 0 {
+		return delay
+	}
+
+	return delay + time.Duration(rand.Float64()*p.Jitter*float64(delay))
+}
+
+func (p *DefaultRetryPolicy) idempotent(request *http.Request) bool {
+
+	if p.RetryNonIdempotent || request == nil {
+		return true
+	}
+
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return request.Header.Get("Idempotency-Key") != "" || request.Header.Get("X-Idempotency-Key") != ""
+}
+
+// ParseRetryAfter reads the Retry-After header, expressed either in seconds or as an HTTP date.
+func ParseRetryAfter(header http.Header) (time.Duration, bool) {
+
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := time.Until(date)
+	if delay <= 0 {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// IsTransientError reports whether err is a network failure worth retrying,
+// such as a timeout, a reset connection or a connection closed before the response was read.
+//
+// Errors caused by the request context being cancelled or expiring are never transient.
+func IsTransientError(err error) bool {
+
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+type retryPolicyContextKey struct{}
+
+// WithRetryPolicy returns a copy of ctx that makes the Transport use policy for the requests created with it,
+// instead of the policy configured on the client.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the RetryPolicy stored in ctx by WithRetryPolicy, if any.
+func RetryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy)
+	return policy, ok && policy != nil
+}
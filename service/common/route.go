@@ -0,0 +1,204 @@
+package common
+
+import (
+	"strings"
+	"unicode"
+)
+
+// routePrefixes are the API prefixes of the products.
+// A "*" segment matches any segment and is kept, e.g. the API version, a "{name}" one matches any segment and is replaced.
+var routePrefixes = [][]string{
+	{"rest", "api", "*"},
+	{"rest", "agile", "*"},
+	{"rest", "servicedeskapi"},
+	{"wiki", "rest", "api"},
+	{"wiki", "api", "v2"},
+	{"jsm", "assets", "workspace", "{workspaceId}", "v1"},
+	{"admin", "*"},
+	{"scim", "directory", "{directoryId}"},
+	{"2.0"},
+}
+
+// routeParameters names the identifiers following a resource, the other ones are named {id}.
+var routeParameters = map[string]string{
+	"issue":        "issueIdOrKey",
+	"project":      "projectIdOrKey",
+	"epic":         "epicIdOrKey",
+	"board":        "boardId",
+	"sprint":       "sprintId",
+	"space":        "spaceKey",
+	"content":      "contentId",
+	"pages":        "pageId",
+	"servicedesk":  "serviceDeskId",
+	"request":      "issueIdOrKey",
+	"orgs":         "orgId",
+	"directory":    "directoryId",
+	"workspaces":   "workspace",
+	"repositories": "workspace",
+	"users":        "selected_user",
+	"properties":   "propertyKey",
+	"property":     "key",
+	"field":        "fieldId",
+	"status":       "idOrName",
+	"label":        "label",
+	"pullrequests": "pull_request_id",
+	"pipelines":    "pipeline_uuid",
+	"steps":        "step_uuid",
+	"commit":       "commit",
+	"diff":         "spec",
+	"diffstat":     "spec",
+	"patch":        "spec",
+}
+
+// routeCollections are the resources always followed by an identifier, whatever its shape, e.g. a lower case space
+// key or an entity property key, unless the segment following them is one of routeResources.
+var routeCollections = map[string]bool{
+	"space":        true,
+	"users":        true,
+	"properties":   true,
+	"property":     true,
+	"workspaces":   true,
+	"repositories": true,
+	"field":        true,
+	"status":       true,
+	"label":        true,
+	"commit":       true,
+}
+
+// routeResources are the resource names following a collection, e.g. rest/api/3/users/search.
+var routeResources = map[string]bool{
+	"search":   true,
+	"bulk":     true,
+	"multi":    true,
+	"picker":   true,
+	"_private": true,
+}
+
+// routeTrailing are the Bitbucket resources whose identifier can contain slashes, e.g. a branch name or a file path,
+// every segment following them belongs to the identifier.
+var routeTrailing = map[string]string{
+	"branches": "name",
+	"tags":     "name",
+	"src":      "commit}/{path",
+}
+
+// RouteTemplate returns the templated route of the path of an API request and the service it belongs to.
+//
+// The identifiers of the path are replaced by placeholders so the routes keep a bounded cardinality,
+// e.g. /rest/api/3/issue/KP-1/comment gives rest/api/3/issue/{issueIdOrKey}/comment and the issue service.
+// A segment is an identifier when it follows a collection, e.g. space/ or properties/, or when it contains a digit,
+// an upper case key, or one of the ~, {, :, @ characters.
+func RouteTemplate(path string) (route, service string) {
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	// The requests routed through the API gateway start with ex/{product}/{cloudId}.
+	if len(segments) > 3 && segments[0] == "ex" {
+		segments = segments[3:]
+	}
+
+	prefix := routePrefix(segments)
+	route = strings.Join(segments[:prefix], "/")
+
+	for i := prefix; i < len(segments); i++ {
+
+		segment, previous := segments[i], ""
+		if i > prefix {
+			previous = segments[i-1]
+		}
+
+		switch {
+
+		case previous == "repositories" && i+1 < len(segments):
+			// Bitbucket repositories are identified by their workspace and slug.
+			segment = "{workspace}/{repo_slug}"
+			i++
+
+		case routeTrailing[previous] != "" && routeIdentifier(segment, previous):
+			segment = "{" + routeTrailing[previous] + "}"
+			i = len(segments)
+
+		case routeIdentifier(segment, previous):
+
+			name := routeParameters[previous]
+			if name == "" {
+				name = "id"
+			}
+
+			segment = "{" + name + "}"
+
+		case service == "" || strings.HasSuffix(route, "/{repo_slug}"):
+			// The service of a Bitbucket repository route is the resource following the repository.
+			service = segment
+		}
+
+		route = strings.TrimPrefix(route+"/"+segment, "/")
+	}
+
+	return route, service
+}
+
+// routePrefix returns the number of segments of the API prefix of the path, replacing its identifiers by placeholders.
+func routePrefix(segments []string) int {
+
+	for _, prefix := range routePrefixes {
+
+		if len(segments) < len(prefix) {
+			continue
+		}
+
+		matched := true
+		for i, segment := range prefix {
+			if segment != "*" && !strings.HasPrefix(segment, "{") && segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+
+		if !matched {
+			continue
+		}
+
+		for i, segment := range prefix {
+			if strings.HasPrefix(segment, "{") {
+				segments[i] = segment
+			}
+		}
+
+		return len(prefix)
+	}
+
+	return 0
+}
+
+// routeIdentifier reports whether the segment is an identifier rather than a resource name.
+func routeIdentifier(segment, previous string) bool {
+
+	if segment == "" {
+		return false
+	}
+
+	// The identifiers are templated by their position, e.g. the Bitbucket workspaces, the names of branches and tags
+	// and the property keys are plain words.
+	if routeCollections[previous] && !routeResources[segment] || routeTrailing[previous] != "" {
+		return true
+	}
+
+	if strings.ContainsAny(segment, "~{}:@") {
+		return true
+	}
+
+	upper := true
+	for _, r := range segment {
+
+		if unicode.IsDigit(r) {
+			return true
+		}
+
+		if unicode.IsLower(r) {
+			upper = false
+		}
+	}
+
+	return upper
+}
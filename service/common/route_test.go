@@ -0,0 +1,50 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteTemplate(t *testing.T) {
+
+	testCases := []struct {
+		path        string
+		wantRoute   string
+		wantService string
+	}{
+		{"/rest/api/3/issue/KP-1", "rest/api/3/issue/{issueIdOrKey}", "issue"},
+		{"/rest/api/3/issue/KP-1/comment/10001", "rest/api/3/issue/{issueIdOrKey}/comment/{id}", "issue"},
+		{"/rest/api/2/project/KP/version", "rest/api/2/project/{projectIdOrKey}/version", "project"},
+		{"/rest/api/3/myself", "rest/api/3/myself", "myself"},
+		{"/ex/jira/3a9f/rest/api/3/issue/10001", "rest/api/3/issue/{issueIdOrKey}", "issue"},
+		{"/rest/agile/1.0/board/10/sprint", "rest/agile/1.0/board/{boardId}/sprint", "board"},
+		{"/wiki/api/v2/pages/65538/children", "wiki/api/v2/pages/{pageId}/children", "pages"},
+		{"2.0/repositories/ctreminiom/go-atlassian/pullrequests/12",
+			"2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}", "pullrequests"},
+		{"2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/login",
+			"2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}", "refs"},
+		{"2.0/workspaces/ctreminiom/hooks", "2.0/workspaces/{workspace}/hooks", "workspaces"},
+		{"wiki/rest/api/space/myspace", "wiki/rest/api/space/{spaceKey}", "space"},
+		{"wiki/rest/api/space/_private", "wiki/rest/api/space/_private", "space"},
+		{"2.0/users/someuser", "2.0/users/{selected_user}", "users"},
+		{"2.0/repositories/ctreminiom", "2.0/repositories/{workspace}", "repositories"},
+		{"rest/api/3/users/search", "rest/api/3/users/search", "users"},
+		{"rest/api/3/issue/KP-1/properties/my.prop", "rest/api/3/issue/{issueIdOrKey}/properties/{propertyKey}", "issue"},
+		{"rest/api/3/dashboard/10000/items/10001/properties/mykey",
+			"rest/api/3/dashboard/{id}/items/{id}/properties/{propertyKey}", "dashboard"},
+		{"rest/api/3/field/labels/context", "rest/api/3/field/{fieldId}/context", "field"},
+		{"rest/api/3/field/search", "rest/api/3/field/search", "field"},
+		{"/", "", ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+
+			route, service := RouteTemplate(testCase.path)
+
+			assert.Equal(t, testCase.wantRoute, route)
+			assert.Equal(t, testCase.wantService, service)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestSigner signs the requests once built, e.g. with an Atlassian Connect JWT
+// depending on the method and URL of the request.
+type RequestSigner interface {
+	SignRequest(ctx context.Context, request *http.Request) error
+}
+
+// RequestSignerAuthentication is implemented by the Authentication supporting a request signer, as the ones of the clients.
+// Like TokenSourceAuthentication, it is optional so the existing implementations of Authentication are not broken.
+type RequestSignerAuthentication interface {
+	SetRequestSigner(signer RequestSigner)
+	GetRequestSigner() RequestSigner
+}
+
+// SignRequest signs the request with the request signer of auth, if any.
+//
+// The Transport calls it before every attempt, after AuthorizeRequest: the signature takes precedence over the other
+// credentials set on auth.
+func SignRequest(ctx context.Context, auth Authentication, request *http.Request) error {
+
+	holder, ok := auth.(RequestSignerAuthentication)
+	if !ok || holder.GetRequestSigner() == nil {
+		return nil
+	}
+
+	return holder.GetRequestSigner().SignRequest(ctx, request)
+}
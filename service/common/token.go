@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GatewayHost is the host of the API gateway, routing the OAuth 2.0 requests to the cloud sites.
+const GatewayHost = "api.atlassian.com"
+
+// TokenSource supplies the bearer token of the requests, e.g. an OAuth 2.0 access token renewed when it expires.
+type TokenSource interface {
+	BearerToken(ctx context.Context) (string, error)
+}
+
+// TokenSourceAuthentication is implemented by the Authentication supporting a token source, as the ones of the clients:
+//
+//	client.Auth.(common.TokenSourceAuthentication).SetTokenSource(source)
+//
+// It is not part of Authentication so the implementations written before the token sources keep satisfying it.
+type TokenSourceAuthentication interface {
+	SetTokenSource(source TokenSource)
+	GetTokenSource() TokenSource
+}
+
+// CloudRouter is implemented by the token sources whose requests must go through the API gateway.
+//
+// The OAuth 2.0 (3LO) access tokens are not accepted by the sites, the requests are sent to
+// https://api.atlassian.com/ex/{product}/{cloudId}/ instead, with the cloud ID of the site.
+type CloudRouter interface {
+	CloudID(ctx context.Context, site *url.URL) (string, error)
+}
+
+// GatewayURL returns the base URL of the requests to the product of a cloud site through the API gateway,
+// the product being jira or confluence.
+func GatewayURL(product, cloudID string) string {
+	return fmt.Sprintf("https://%v/ex/%v/%v/", GatewayHost, product, cloudID)
+}
+
+// ResolveSite returns the URL the requests of a client are resolved against.
+//
+// It is the gateway URL of the site when the token source of auth is a CloudRouter,
+// and the site itself otherwise or when the site already is a gateway URL.
+func ResolveSite(ctx context.Context, auth Authentication, site *url.URL, product string) (*url.URL, error) {
+
+	if auth == nil || site.Host == GatewayHost {
+		return site, nil
+	}
+
+	router, ok := tokenSource(auth).(CloudRouter)
+	if !ok {
+		return site, nil
+	}
+
+	cloudID, err := router.CloudID(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Parse(GatewayURL(product, cloudID))
+}
+
+// AuthorizeRequest sets the bearer token of the token source of auth on the request, if any.
+//
+// The Transport calls it before every attempt. The token source takes precedence over the static credentials set on auth.
+func AuthorizeRequest(ctx context.Context, auth Authentication, request *http.Request) error {
+
+	source := tokenSource(auth)
+	if source == nil {
+		return nil
+	}
+
+	token, err := source.BearerToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	return nil
+}
+
+// tokenSource returns the token source of auth, nil when auth does not implement TokenSourceAuthentication.
+func tokenSource(auth Authentication) TokenSource {
+
+	holder, ok := auth.(TokenSourceAuthentication)
+	if !ok {
+		return nil
+	}
+
+	return holder.GetTokenSource()
+}
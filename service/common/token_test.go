@@ -0,0 +1,149 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticTokenSource string
+
+func (s staticTokenSource) BearerToken(context.Context) (string, error) {
+	return string(s), nil
+}
+
+type routedTokenSource struct {
+	staticTokenSource
+	cloudID string
+	err     error
+}
+
+func (s routedTokenSource) CloudID(context.Context, *url.URL) (string, error) {
+	return s.cloudID, s.err
+}
+
+// authStub is the subset of an Authentication needed by the token and signer helpers.
+type authStub struct {
+	Authentication
+	source TokenSource
+	signer RequestSigner
+}
+
+func (a *authStub) SetTokenSource(source TokenSource) {
+	a.source = source
+}
+
+func (a *authStub) GetTokenSource() TokenSource {
+	return a.source
+}
+
+func (a *authStub) SetRequestSigner(signer RequestSigner) {
+	a.signer = signer
+}
+
+func (a *authStub) GetRequestSigner() RequestSigner {
+	return a.signer
+}
+
+// basicAuthStub is an Authentication without a token source nor a request signer, as the ones written before them.
+type basicAuthStub struct {
+	Authentication
+}
+
+func TestResolveSite(t *testing.T) {
+
+	site, err := url.Parse("https://your-domain.atlassian.net/")
+	require.NoError(t, err)
+
+	gateway, err := url.Parse(GatewayURL("jira", "cloud-id"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		auth    Authentication
+		site    *url.URL
+		want    string
+		wantErr error
+	}{
+		{
+			name: "when no token source is set",
+			auth: &authStub{},
+			site: site,
+			want: "https://your-domain.atlassian.net/",
+		},
+
+		{
+			name: "when the authentication does not support the token sources",
+			auth: &basicAuthStub{},
+			site: site,
+			want: "https://your-domain.atlassian.net/",
+		},
+
+		{
+			name: "when the token source does not route the requests",
+			auth: &authStub{source: staticTokenSource("token")},
+			site: site,
+			want: "https://your-domain.atlassian.net/",
+		},
+
+		{
+			name: "when the token source routes the requests",
+			auth: &authStub{source: routedTokenSource{cloudID: "cloud-id"}},
+			site: site,
+			want: "https://api.atlassian.com/ex/jira/cloud-id/",
+		},
+
+		{
+			name: "when the site already is a gateway url",
+			auth: &authStub{source: routedTokenSource{err: errors.New("must not be called")}},
+			site: gateway,
+			want: "https://api.atlassian.com/ex/jira/cloud-id/",
+		},
+
+		{
+			name:    "when the cloud id cannot be discovered",
+			auth:    &authStub{source: routedTokenSource{err: errors.New("no resource")}},
+			site:    site,
+			wantErr: errors.New("no resource"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ResolveSite(context.Background(), testCase.auth, testCase.site, "jira")
+
+			if testCase.wantErr != nil {
+				assert.EqualError(t, err, testCase.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, got.String())
+			assert.Equal(t, testCase.want+"rest/api/3/myself", got.ResolveReference(&url.URL{Path: "rest/api/3/myself"}).String())
+		})
+	}
+}
+
+func TestAuthorizeRequest(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodGet, "https://your-domain.atlassian.net/", nil)
+	require.NoError(t, err)
+
+	request.SetBasicAuth("mail", "token")
+
+	require.NoError(t, AuthorizeRequest(context.Background(), &authStub{}, request))
+	assert.Contains(t, request.Header.Get("Authorization"), "Basic ")
+
+	require.NoError(t, AuthorizeRequest(context.Background(), &basicAuthStub{}, request))
+	require.NoError(t, SignRequest(context.Background(), &basicAuthStub{}, request))
+	assert.Contains(t, request.Header.Get("Authorization"), "Basic ")
+
+	require.NoError(t, AuthorizeRequest(context.Background(), &authStub{source: staticTokenSource("access")}, request))
+	assert.Equal(t, "Bearer access", request.Header.Get("Authorization"))
+}
@@ -0,0 +1,323 @@
+package common
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// maxDrainBytes limits how much of a discarded response body is read before retrying,
+// so the underlying connection can be reused without reading huge error pages.
+const maxDrainBytes = 64 << 10
+
+// Transport is the HTTP layer shared by every product client.
+//
+// It sends the requests through the HTTPClient and retries them according to the RetryPolicy.
+type Transport struct {
+	// HTTP is the client used to send the requests.
+	HTTP HTTPClient
+	// RetryPolicy decides when a request is retried, NoRetry is used when nil.
+	// A policy stored in the request context with WithRetryPolicy takes precedence.
+	RetryPolicy RetryPolicy
+	// Limiter paces every attempt and is updated from the rate limit headers of the responses, no pacing happens when nil.
+	Limiter *RateLimiter
+	// Instrumentation observes every call, together with Product naming the product of the client.
+	Instrumentation Instrumentation
+	Product         string
+	// Logger receives the retries and, when Debug is set, a summary of every request and response at the debug level.
+	// The credentials are redacted from the summaries, nothing is logged when nil.
+	Logger *slog.Logger
+	Debug  bool
+	// Auth supplies the token source and the request signer applied to every attempt, so a retry sent after a long
+	// backoff carries a renewed access token or a freshly signed JWT instead of the one current when the request was built.
+	Auth Authentication
+}
+
+// NewTransport creates a Transport sending the requests through httpClient and retrying them following policy.
+func NewTransport(httpClient HTTPClient, policy RetryPolicy) *Transport {
+	return &Transport{HTTP: httpClient, RetryPolicy: policy}
+}
+
+// Do sends the request, retrying it while the retry policy allows it.
+//
+// Requests with a body are only retried when the body can be rewound through http.Request.GetBody,
+// which is always the case for the requests built by the product clients.
+//
+// The response of the last attempt is returned, it is up to the caller to close its body.
+func (t *Transport) Do(request *http.Request) (*http.Response, error) {
+
+	// Nothing can be retried without a request, let the HTTPClient report it.
+	if request == nil {
+		return t.HTTP.Do(request)
+	}
+
+	if t.Instrumentation == nil {
+		return t.do(request, new(CallResult))
+	}
+
+	route, service := RouteTemplate(request.URL.Path)
+	ctx, end := t.Instrumentation.StartCall(request.Context(), &CallInfo{
+		Product: t.Product,
+		Service: service,
+		Route:   route,
+		Request: request,
+	})
+
+	start := time.Now()
+	result := new(CallResult)
+
+	response, err := t.do(request.WithContext(ctx), result)
+
+	result.Response, result.Err, result.Duration = response, err, time.Since(start)
+	end(result)
+
+	return response, err
+}
+
+// do sends the request like Do, recording the retries and the rate limiting of the call in result.
+func (t *Transport) do(request *http.Request, result *CallResult) (*http.Response, error) {
+
+	ctx := request.Context()
+	policy := t.policy(request)
+
+	for attempt := 0; ; attempt++ {
+
+		result.Retries = attempt
+
+		// The first attempt leaves the HTTPClient to honor the context, the retries stop as soon as it is done.
+		if attempt > 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		attemptRequest, err := rewind(request, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Limiter != nil {
+
+			waited, err := t.Limiter.Wait(ctx)
+			result.RateLimitWait += waited
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := AuthorizeRequest(ctx, t.Auth, attemptRequest); err != nil {
+			return nil, err
+		}
+
+		if err := SignRequest(ctx, t.Auth, attemptRequest); err != nil {
+			return nil, err
+		}
+
+		t.logRequest(attemptRequest, attempt)
+
+		sent := time.Now()
+		response, err := t.HTTP.Do(attemptRequest)
+
+		t.logResponse(attemptRequest, response, err, time.Since(sent))
+
+		if t.Limiter != nil {
+			t.Limiter.Update(response)
+		}
+
+		if response != nil && response.StatusCode == http.StatusTooManyRequests {
+			result.RateLimited++
+		}
+
+		delay, retry := policy.Retry(attempt, attemptRequest, response, err)
+		if !retry || !rewindable(request) {
+			return response, err
+		}
+
+		t.logRetry(request, response, err, attempt, delay)
+
+		if response != nil {
+			drain(response)
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *Transport) logger() *slog.Logger {
+
+	if t.Logger == nil {
+		return DiscardLogger
+	}
+
+	return t.Logger
+}
+
+// logRetry warns about the retry of the request, with the rate limit headers explaining it.
+func (t *Transport) logRetry(request *http.Request, response *http.Response, err error, attempt int, delay time.Duration) {
+
+	attrs := []slog.Attr{
+		slog.String("method", request.Method),
+		slog.String("url", RedactURL(request.URL)),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("delay", delay),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	if response != nil {
+		h := response.Header
+		attrs = append(attrs,
+			slog.Int("status", response.StatusCode),
+			slog.String("retry_after", h.Get("Retry-After")),
+			slog.String("rate_limit_limit", h.Get("X-RateLimit-Limit")),
+			slog.String("rate_limit_remaining", h.Get("X-RateLimit-Remaining")),
+			slog.String("rate_limit_reset", h.Get("X-RateLimit-Reset")),
+			slog.String("rate_limit_reason", h.Get("RateLimit-Reason")),
+		)
+	}
+
+	t.logger().LogAttrs(request.Context(), slog.LevelWarn, "retrying request", attrs...)
+}
+
+// logRequest logs the summary of an attempt in debug mode.
+func (t *Transport) logRequest(request *http.Request, attempt int) {
+
+	if !t.Debug {
+		return
+	}
+
+	t.logger().LogAttrs(request.Context(), slog.LevelDebug, "sending request",
+		slog.String("method", request.Method),
+		slog.String("url", RedactURL(request.URL)),
+		slog.Int("attempt", attempt+1),
+		slog.Int64("content_length", request.ContentLength),
+		headerAttr(request.Header),
+	)
+}
+
+// logResponse logs the summary of the outcome of an attempt in debug mode.
+func (t *Transport) logResponse(request *http.Request, response *http.Response, err error, elapsed time.Duration) {
+
+	if !t.Debug {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", request.Method),
+		slog.String("url", RedactURL(request.URL)),
+		slog.Duration("elapsed", elapsed),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	if response != nil {
+		attrs = append(attrs,
+			slog.Int("status", response.StatusCode),
+			slog.Int64("content_length", response.ContentLength),
+			headerAttr(response.Header),
+		)
+	}
+
+	t.logger().LogAttrs(request.Context(), slog.LevelDebug, "received response", attrs...)
+}
+
+func (t *Transport) policy(request *http.Request) RetryPolicy {
+
+	if policy, ok := RetryPolicyFromContext(request.Context()); ok {
+		return policy
+	}
+
+	if t.RetryPolicy != nil {
+		return t.RetryPolicy
+	}
+
+	return NoRetry
+}
+
+// rewindable reports whether the request body can be sent again.
+func rewindable(request *http.Request) bool {
+	return request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
+}
+
+// rewind returns the request to send on the given attempt, the retries are sent as clones carrying a fresh copy of the
+// body and their own headers.
+func rewind(request *http.Request, attempt int) (*http.Request, error) {
+
+	if attempt == 0 {
+		return request, nil
+	}
+
+	clone := request.Clone(request.Context())
+
+	if request.Body != nil && request.Body != http.NoBody {
+
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		clone.Body = body
+	}
+
+	return clone, nil
+}
+
+func drain(response *http.Response) {
+
+	if response.Body == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxDrainBytes))
+	_ = response.Body.Close()
+}
+
+// ProcessResponse reads and closes the response body and decodes the successful responses into structure when it is not nil.
+//
+// The unsuccessful responses are reported as a *models.APIError wrapping the models error matching the status code.
+func ProcessResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
+
+	defer response.Body.Close()
+
+	res := &models.ResponseScheme{
+		Response: response,
+		Code:     response.StatusCode,
+		Endpoint: response.Request.URL.String(),
+		Method:   response.Request.Method,
+	}
+
+	responseAsBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return res, err
+	}
+
+	res.Bytes.Write(responseAsBytes)
+
+	wasSuccess := response.StatusCode >= 200 && response.StatusCode < 300
+
+	if !wasSuccess {
+		return res, models.NewAPIError(res)
+	}
+
+	if structure != nil {
+		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
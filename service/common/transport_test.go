@@ -0,0 +1,353 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func newTestResponse(status int, body string, header http.Header) *http.Response {
+
+	if header == nil {
+		header = http.Header{}
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net", nil)
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    request,
+	}
+}
+
+func TestTransport_Do(t *testing.T) {
+
+	policy := &DefaultRetryPolicy{MaxRetries: 2, InitialDelay: time.Microsecond, MaxDelay: time.Millisecond}
+
+	testCases := []struct {
+		name       string
+		method     string
+		body       io.Reader
+		ctx        context.Context
+		policy     RetryPolicy
+		on         func(*mocks.HTTPClient)
+		wantStatus int
+		wantErr    error
+	}{
+		{
+			name:   "when the first attempt succeeds",
+			method: http.MethodGet,
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusOK, "{}", nil), nil).
+					Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "when the request is rate limited and the retry succeeds",
+			method: http.MethodPost,
+			body:   strings.NewReader(`{"summary":"retry"}`),
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusTooManyRequests, "", nil), nil).
+					Once()
+
+				client.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+					body, _ := io.ReadAll(request.Body)
+					return string(body) == `{"summary":"retry"}`
+				})).
+					Return(newTestResponse(http.StatusCreated, "{}", nil), nil).
+					Once()
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "when the retries are exhausted",
+			method: http.MethodGet,
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(func(*http.Request) *http.Response {
+						return newTestResponse(http.StatusServiceUnavailable, "", nil)
+					}, nil).
+					Times(3)
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "when a non idempotent request receives a service unavailable",
+			method: http.MethodPost,
+			body:   strings.NewReader(`{}`),
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusServiceUnavailable, "", nil), nil).
+					Once()
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "when a transient network error is retried",
+			method: http.MethodDelete,
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(nil, syscall.ECONNRESET).
+					Once()
+
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusNoContent, "", nil), nil).
+					Once()
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:   "when the error is not transient",
+			method: http.MethodGet,
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(nil, errors.New("unsupported protocol scheme")).
+					Once()
+			},
+			wantErr: errors.New("unsupported protocol scheme"),
+		},
+		{
+			name:   "when the context overrides the retry policy",
+			method: http.MethodGet,
+			ctx:    WithRetryPolicy(context.Background(), NoRetry),
+			policy: policy,
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusTooManyRequests, "", nil), nil).
+					Once()
+			},
+			wantStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:   "when the context is cancelled while waiting",
+			method: http.MethodGet,
+			policy: &DefaultRetryPolicy{MaxRetries: 2, InitialDelay: time.Hour},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				t.Cleanup(cancel)
+				return ctx
+			}(),
+			on: func(client *mocks.HTTPClient) {
+				client.On("Do", mock.AnythingOfType("*http.Request")).
+					Return(newTestResponse(http.StatusTooManyRequests, "", nil), nil).
+					Once()
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewHTTPClient(t)
+			testCase.on(client)
+
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			var body io.Reader
+			if testCase.body != nil {
+				raw, _ := io.ReadAll(testCase.body)
+				body = bytes.NewBuffer(raw)
+			}
+
+			request, err := http.NewRequestWithContext(ctx, testCase.method, "https://ctreminiom.atlassian.net/rest/api/3/myself", body)
+			assert.NoError(t, err)
+
+			response, err := NewTransport(client, testCase.policy).Do(request)
+
+			if testCase.wantErr != nil {
+				assert.EqualError(t, err, testCase.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, response.StatusCode)
+		})
+	}
+}
+
+// rotatingTokenSource returns the next token on every call, like a source renewing an expired access token.
+type rotatingTokenSource struct {
+	tokens []string
+}
+
+func (s *rotatingTokenSource) BearerToken(context.Context) (string, error) {
+
+	token := s.tokens[0]
+	s.tokens = s.tokens[1:]
+
+	return token, nil
+}
+
+func TestTransport_Do_Authorize(t *testing.T) {
+
+	client := mocks.NewHTTPClient(t)
+
+	client.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("Authorization") == "Bearer expired"
+	})).
+		Return(newTestResponse(http.StatusServiceUnavailable, "", nil), nil).
+		Once()
+
+	client.On("Do", mock.MatchedBy(func(request *http.Request) bool {
+		return request.Header.Get("Authorization") == "Bearer renewed"
+	})).
+		Return(newTestResponse(http.StatusOK, "{}", nil), nil).
+		Once()
+
+	transport := NewTransport(client, &DefaultRetryPolicy{MaxRetries: 1, InitialDelay: time.Microsecond, MaxDelay: time.Millisecond})
+	transport.Auth = &authStub{source: &rotatingTokenSource{tokens: []string{"expired", "renewed"}}}
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	response, err := transport.Do(request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestDefaultRetryPolicy_Retry(t *testing.T) {
+
+	policy := &DefaultRetryPolicy{MaxRetries: 5, InitialDelay: time.Second, MaxDelay: 4 * time.Second}
+	request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net", nil)
+
+	t.Run("when the backoff grows exponentially up to the max delay", func(t *testing.T) {
+
+		var delays []time.Duration
+		for attempt := 0; attempt < 4; attempt++ {
+			delay, ok := policy.Retry(attempt, request, newTestResponse(http.StatusTooManyRequests, "", nil), nil)
+			assert.True(t, ok)
+			delays = append(delays, delay)
+		}
+
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}, delays)
+	})
+
+	t.Run("when the response carries a Retry-After header", func(t *testing.T) {
+
+		delay, ok := policy.Retry(0, request, newTestResponse(http.StatusTooManyRequests, "", http.Header{"Retry-After": {"30"}}), nil)
+		assert.True(t, ok)
+		assert.Equal(t, 30*time.Second, delay)
+	})
+
+	t.Run("when the jitter is applied", func(t *testing.T) {
+
+		jittered := &DefaultRetryPolicy{MaxRetries: 1, InitialDelay: time.Second, Jitter: 0.5}
+
+		delay, ok := jittered.Retry(0, request, newTestResponse(http.StatusTooManyRequests, "", nil), nil)
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, delay, time.Second)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	})
+
+	t.Run("when the request carries an idempotency key", func(t *testing.T) {
+
+		post, _ := http.NewRequest(http.MethodPost, "https://ctreminiom.atlassian.net", nil)
+
+		_, ok := policy.Retry(0, post, newTestResponse(http.StatusServiceUnavailable, "", nil), nil)
+		assert.False(t, ok)
+
+		post.Header.Set("Idempotency-Key", "6f1c3f8e")
+
+		_, ok = policy.Retry(0, post, newTestResponse(http.StatusServiceUnavailable, "", nil), nil)
+		assert.True(t, ok)
+	})
+
+	t.Run("when the status code is not retryable", func(t *testing.T) {
+
+		_, ok := policy.Retry(0, request, newTestResponse(http.StatusInternalServerError, "", nil), nil)
+		assert.False(t, ok)
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+
+	assert.True(t, IsTransientError(io.ErrUnexpectedEOF))
+	assert.True(t, IsTransientError(syscall.ECONNRESET))
+	assert.False(t, IsTransientError(context.Canceled))
+	assert.False(t, IsTransientError(errors.New("unsupported protocol scheme")))
+	assert.False(t, IsTransientError(nil))
+}
+
+func TestProcessResponse(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "when the response is successful", status: http.StatusOK, body: `{"name":"KP"}`},
+		{name: "when the response is rate limited", status: http.StatusTooManyRequests, wantErr: models.ErrRateLimited},
+		{name: "when the response is not found", status: http.StatusNotFound, wantErr: models.ErrNotFound},
+		{name: "when the response status is not mapped", status: http.StatusTeapot, wantErr: models.ErrInvalidStatusCode},
+		{name: "when the response is forbidden", status: http.StatusForbidden, wantErr: models.ErrForbidden},
+		{name: "when the response is a conflict", status: http.StatusConflict, wantErr: models.ErrConflict},
+		{name: "when the precondition failed", status: http.StatusPreconditionFailed, wantErr: models.ErrPreconditionFailed},
+		{name: "when the entity is unprocessable", status: http.StatusUnprocessableEntity, wantErr: models.ErrUnprocessableEntity},
+		{name: "when the service is unavailable", status: http.StatusServiceUnavailable, wantErr: models.ErrServiceUnavailable},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			structure := new(models.BoardScheme)
+			response, err := ProcessResponse(newTestResponse(testCase.status, testCase.body, nil), structure)
+
+			if testCase.wantErr != nil {
+				assert.ErrorIs(t, err, testCase.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.status, response.Code)
+			assert.Equal(t, "KP", structure.Name)
+		})
+	}
+}
+
+func TestProcessResponse_APIError(t *testing.T) {
+
+	body := `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`
+	header := http.Header{"X-Arequestid": {"a1b2c3"}}
+
+	_, err := ProcessResponse(newTestResponse(http.StatusBadRequest, body, header), nil)
+
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected a *models.APIError, got %T", err)
+	}
+
+	assert.ErrorIs(t, err, models.ErrBadRequest)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, http.MethodGet, apiErr.Method)
+	assert.Equal(t, "a1b2c3", apiErr.RequestID)
+	assert.Equal(t, map[string]string{"summary": "You must specify a summary of the issue."}, apiErr.Errors)
+}
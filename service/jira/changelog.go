@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ChangelogConnector is an interface that defines the methods available from the Issue Changelog API.
+// Use it to read the complete history of the issues, without the limit applied by the changelog expand.
+type ChangelogConnector interface {
+
+	// Gets returns a paginated list of all changelogs for an issue sorted by date, starting from the oldest.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#get-changelogs
+	Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error)
+
+	// List returns the changelogs for an issue specified by a list of changelog IDs.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/changelog/list
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#get-changelogs-by-ids
+	List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (*model.IssueChangelogScheme, *model.ResponseScheme, error)
+
+	// BulkFetch returns a paginated list of the changelogs of multiple issues, optionally filtered by field IDs.
+	//
+	// POST /rest/api/{2-3}/changelog/bulkfetch
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/changelog#bulk-fetch-changelogs
+	BulkFetch(ctx context.Context, payload *model.IssueChangelogBulkFetchPayloadScheme) (*model.IssueChangelogBulkFetchPageScheme, *model.ResponseScheme, error)
+}
@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WebhookConnector is an interface that defines the methods available from the Webhook API.
+// Use it to register, refresh and remove the dynamic webhooks of Connect and OAuth 2.0 apps.
+type WebhookConnector interface {
+
+	// Gets returns a paginated list of the webhooks registered by the calling app.
+	//
+	// GET /rest/api/{2-3}/webhook
+	//
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-get
+	Gets(ctx context.Context, startAt, maxResults int) (*model.WebhookPageScheme, *model.ResponseScheme, error)
+
+	// Register registers webhooks, the result of each registration is returned in the order of the payload webhooks.
+	//
+	// POST /rest/api/{2-3}/webhook
+	//
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-post
+	Register(ctx context.Context, payload *model.WebhookRegistrationPayloadScheme) (*model.WebhookRegistrationResultScheme, *model.ResponseScheme, error)
+
+	// Delete removes webhooks by ID. Only webhooks registered by the calling app are removed.
+	//
+	// DELETE /rest/api/{2-3}/webhook
+	//
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-delete
+	Delete(ctx context.Context, webhookIDs []int) (*model.ResponseScheme, error)
+
+	// Refresh extends the life of webhooks, they are removed 30 days after their registration unless refreshed.
+	//
+	// PUT /rest/api/{2-3}/webhook/refresh
+	//
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-refresh-put
+	Refresh(ctx context.Context, webhookIDs []int) (*model.WebhookExpirationScheme, *model.ResponseScheme, error)
+
+	// Failed returns the webhooks that failed to be delivered in the last 72 hours, sorted by failure time.
+	//
+	// after is the failure time, in epoch milliseconds, after which the failed webhooks are returned, zero means no limit.
+	//
+	// GET /rest/api/{2-3}/webhook/failed
+	//
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-failed-get
+	Failed(ctx context.Context, maxResults int, after int64) (*model.FailedWebhookPageScheme, *model.ResponseScheme, error)
+}